@@ -0,0 +1,204 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+)
+
+// The SDK does not manage users, roles or blueprint permissions, so the
+// functions in this file call the /api/aaa endpoints directly. The payloads
+// follow the Apstra 6.1.0 API (see compatibility.AaaUsersAndRolesOK).
+const (
+	apiUrlAaaUsers                    = "/api/aaa/users"
+	apiUrlAaaUserById                 = apiUrlAaaUsers + "/%s"
+	apiUrlAaaRoles                    = "/api/aaa/roles"
+	apiUrlAaaRoleById                 = apiUrlAaaRoles + "/%s"
+	apiUrlAaaRoleBlueprintPermissions = apiUrlAaaRoleById + "/blueprint-permissions/%s"
+)
+
+// UserData is the JSON representation of a local Apstra user used by the
+// /api/aaa/users endpoints. Password is sent only when it is being set.
+type UserData struct {
+	Id        string   `json:"id,omitempty"`
+	Username  string   `json:"username"`
+	FirstName string   `json:"first_name"`
+	LastName  string   `json:"last_name"`
+	Email     string   `json:"email"`
+	Roles     []string `json:"roles"`
+	Password  string   `json:"password,omitempty"`
+}
+
+// RoleData is the JSON representation of an Apstra role used by the
+// /api/aaa/roles endpoints.
+type RoleData struct {
+	Id          string   `json:"id,omitempty"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// BlueprintPermissionData is the JSON representation of the access granted
+// by a role to a single blueprint.
+type BlueprintPermissionData struct {
+	Permission string `json:"permission"`
+}
+
+func aaaUrl(format string, ids ...string) (*url.URL, error) {
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = url.PathEscape(id)
+	}
+	return url.Parse(fmt.Sprintf(format, args...))
+}
+
+// GetUser fetches the specified user.
+func GetUser(ctx context.Context, client *apstra.Client, id string) (*UserData, error) {
+	u, err := aaaUrl(apiUrlAaaUserById, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var result UserData
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodGet, Url: u}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateUser creates a user and returns its ID.
+func CreateUser(ctx context.Context, client *apstra.Client, in *UserData) (string, error) {
+	u, err := aaaUrl(apiUrlAaaUsers)
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		Id string `json:"id"`
+	}
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPost, Url: u, Payload: in}, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Id, nil
+}
+
+// UpdateUser replaces the specified user. The user's password is changed
+// only when in.Password is not empty.
+func UpdateUser(ctx context.Context, client *apstra.Client, id string, in *UserData) error {
+	u, err := aaaUrl(apiUrlAaaUserById, id)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPut, Url: u, Payload: in}, nil)
+}
+
+// DeleteUser deletes the specified user.
+func DeleteUser(ctx context.Context, client *apstra.Client, id string) error {
+	u, err := aaaUrl(apiUrlAaaUserById, id)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodDelete, Url: u}, nil)
+}
+
+// GetRole fetches the specified role.
+func GetRole(ctx context.Context, client *apstra.Client, id string) (*RoleData, error) {
+	u, err := aaaUrl(apiUrlAaaRoleById, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var result RoleData
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodGet, Url: u}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateRole creates a role and returns its ID.
+func CreateRole(ctx context.Context, client *apstra.Client, in *RoleData) (string, error) {
+	u, err := aaaUrl(apiUrlAaaRoles)
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		Id string `json:"id"`
+	}
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPost, Url: u, Payload: in}, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Id, nil
+}
+
+// UpdateRole replaces the specified role.
+func UpdateRole(ctx context.Context, client *apstra.Client, id string, in *RoleData) error {
+	u, err := aaaUrl(apiUrlAaaRoleById, id)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPut, Url: u, Payload: in}, nil)
+}
+
+// DeleteRole deletes the specified role.
+func DeleteRole(ctx context.Context, client *apstra.Client, id string) error {
+	u, err := aaaUrl(apiUrlAaaRoleById, id)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodDelete, Url: u}, nil)
+}
+
+// GetBlueprintPermission fetches the access granted by the specified role to
+// the specified blueprint.
+func GetBlueprintPermission(ctx context.Context, client *apstra.Client, roleId, blueprintId string) (*BlueprintPermissionData, error) {
+	u, err := aaaUrl(apiUrlAaaRoleBlueprintPermissions, roleId, blueprintId)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BlueprintPermissionData
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodGet, Url: u}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SetBlueprintPermission sets the access granted by the specified role to the
+// specified blueprint.
+func SetBlueprintPermission(ctx context.Context, client *apstra.Client, roleId, blueprintId string, in *BlueprintPermissionData) error {
+	u, err := aaaUrl(apiUrlAaaRoleBlueprintPermissions, roleId, blueprintId)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPut, Url: u, Payload: in}, nil)
+}
+
+// DeleteBlueprintPermission removes the access granted by the specified role
+// to the specified blueprint.
+func DeleteBlueprintPermission(ctx context.Context, client *apstra.Client, roleId, blueprintId string) error {
+	u, err := aaaUrl(apiUrlAaaRoleBlueprintPermissions, roleId, blueprintId)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodDelete, Url: u}, nil)
+}
@@ -0,0 +1,132 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const providerDefaultTimeout = 5
+
+var (
+	_ Provider = &LdapProvider{}
+	_ Provider = &RadiusProvider{}
+	_ Provider = &TacacsProvider{}
+)
+
+// Provider is implemented by the external authentication provider models.
+type Provider interface {
+	ResourceAttributes() map[string]resourceSchema.Attribute
+	Request(ctx context.Context, secret string, diags *diag.Diagnostics) *ProviderData
+	LoadApiData(ctx context.Context, in *ProviderData, diags *diag.Diagnostics)
+}
+
+// providerCommonAttributes returns the schema attributes shared by every
+// external authentication provider resource. secretName is the name of the
+// provider's write-only secret attribute (e.g. "shared_secret").
+func providerCommonAttributes(providerName string, defaultPort int64, secretName, secretDescription string) map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Apstra ID of the %s provider.", providerName),
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"name": resourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("%s provider name displayed in the Apstra web UI.", providerName),
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"enabled": resourceSchema.BoolAttribute{
+			MarkdownDescription: fmt.Sprintf("Indicates whether Apstra should authenticate users with the %s "+
+				"provider. Default: `true`", providerName),
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
+		},
+		"hosts": resourceSchema.ListAttribute{
+			MarkdownDescription: fmt.Sprintf("Hostnames or IP addresses of the %s servers, in the order in "+
+				"which they should be tried.", providerName),
+			Required:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"port": resourceSchema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("Server port number. Default: `%d`", defaultPort),
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultPort),
+			Validators:          []validator.Int64{int64validator.Between(1, 65535)},
+		},
+		"timeout_seconds": resourceSchema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("Server response timeout. Default: `%d`", providerDefaultTimeout),
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(providerDefaultTimeout),
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		secretName + "_wo": resourceSchema.StringAttribute{
+			MarkdownDescription: secretDescription + fmt.Sprintf(" This attribute is write-only: its value is "+
+				"sent to Apstra when the provider is created and whenever `%s_wo_version` changes, but is never "+
+				"saved in the Terraform plan or state. The value may be supplied by an ephemeral resource. "+
+				"Requires Terraform 1.11 or later.", secretName),
+			Required:   true,
+			WriteOnly:  true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		secretName + "_wo_version": resourceSchema.Int64Attribute{
+			MarkdownDescription: utils.WriteOnlyVersionDescription(secretName + "_wo"),
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+	}
+}
+
+// providerRequest wraps the type-specific config in a ProviderData object.
+func providerRequest(name types.String, enabled types.Bool, providerType string, config any, diags *diag.Diagnostics) *ProviderData {
+	rawConfig, err := json.Marshal(config)
+	if err != nil {
+		// don't include err here: the config includes a secret
+		diags.AddError("failed to marshal provider configuration", fmt.Sprintf("%s provider %q", providerType, name.ValueString()))
+		return nil
+	}
+
+	return &ProviderData{
+		Label:        name.ValueString(),
+		ProviderType: providerType,
+		Active:       enabled.ValueBool(),
+		Config:       rawConfig,
+	}
+}
+
+// providerLoadConfig checks the type of the ProviderData object and unpacks
+// its type-specific config into config.
+func providerLoadConfig(_ context.Context, in *ProviderData, providerType string, config any, diags *diag.Diagnostics) {
+	if in.ProviderType != providerType {
+		diags.AddError("unexpected provider type",
+			fmt.Sprintf("expected provider %q to have type %q, got %q", in.Id, providerType, in.ProviderType))
+		return
+	}
+
+	err := json.Unmarshal(in.Config, config)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to unmarshal %s provider configuration", providerType), err.Error())
+		return
+	}
+}
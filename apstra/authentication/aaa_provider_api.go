@@ -0,0 +1,127 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+)
+
+// The SDK does not manage external authentication providers, so the
+// functions in this file call the /api/aaa/providers endpoints directly. The
+// payloads follow the Apstra 6.1.0 API (see compatibility.AaaProvidersOK).
+const (
+	apiUrlAaaProviders           = "/api/aaa/providers"
+	apiUrlAaaProviderById        = apiUrlAaaProviders + "/%s"
+	apiUrlAaaProviderRoleMapping = apiUrlAaaProviderById + "/role-mapping"
+
+	providerTypeLdap   = "ldap"
+	providerTypeRadius = "radius"
+	providerTypeTacacs = "tacacs+"
+)
+
+// ProviderData is the JSON representation of an external authentication
+// provider used by the /api/aaa/providers endpoints. The structure of Config
+// depends on ProviderType. Secrets within Config are sent by the provider, but
+// are never returned by the API.
+type ProviderData struct {
+	Id           string          `json:"id,omitempty"`
+	Label        string          `json:"label"`
+	ProviderType string          `json:"provider_type"`
+	Active       bool            `json:"active"`
+	Config       json.RawMessage `json:"config"`
+}
+
+// ProviderRoleMappingData is the JSON representation of the mapping between
+// the groups reported by an external authentication provider and Apstra roles.
+type ProviderRoleMappingData struct {
+	Mappings []ProviderRoleMappingEntryData `json:"mappings"`
+}
+
+type ProviderRoleMappingEntryData struct {
+	Group string   `json:"group"`
+	Roles []string `json:"roles"`
+}
+
+// GetProvider fetches the specified external authentication provider.
+func GetProvider(ctx context.Context, client *apstra.Client, id string) (*ProviderData, error) {
+	u, err := aaaUrl(apiUrlAaaProviderById, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ProviderData
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodGet, Url: u}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateProvider creates an external authentication provider and returns its ID.
+func CreateProvider(ctx context.Context, client *apstra.Client, in *ProviderData) (string, error) {
+	u, err := aaaUrl(apiUrlAaaProviders)
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		Id string `json:"id"`
+	}
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPost, Url: u, Payload: in}, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Id, nil
+}
+
+// UpdateProvider replaces the specified external authentication provider.
+func UpdateProvider(ctx context.Context, client *apstra.Client, id string, in *ProviderData) error {
+	u, err := aaaUrl(apiUrlAaaProviderById, id)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPut, Url: u, Payload: in}, nil)
+}
+
+// DeleteProvider deletes the specified external authentication provider.
+func DeleteProvider(ctx context.Context, client *apstra.Client, id string) error {
+	u, err := aaaUrl(apiUrlAaaProviderById, id)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodDelete, Url: u}, nil)
+}
+
+// GetProviderRoleMapping fetches the group-to-role mapping of the specified
+// external authentication provider.
+func GetProviderRoleMapping(ctx context.Context, client *apstra.Client, providerId string) (*ProviderRoleMappingData, error) {
+	u, err := aaaUrl(apiUrlAaaProviderRoleMapping, providerId)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ProviderRoleMappingData
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodGet, Url: u}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SetProviderRoleMapping replaces the group-to-role mapping of the specified
+// external authentication provider.
+func SetProviderRoleMapping(ctx context.Context, client *apstra.Client, providerId string, in *ProviderRoleMappingData) error {
+	u, err := aaaUrl(apiUrlAaaProviderRoleMapping, providerId)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPut, Url: u, Payload: in}, nil)
+}
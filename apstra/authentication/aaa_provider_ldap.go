@@ -0,0 +1,181 @@
+package authentication
+
+import (
+	"context"
+
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ldapDefaultPort                 = 389
+	ldapDefaultUserObjectClass      = "inetOrgPerson"
+	ldapDefaultUsernameAttribute    = "uid"
+	ldapDefaultGroupObjectClass     = "groupOfNames"
+	ldapDefaultGroupNameAttribute   = "cn"
+	ldapDefaultGroupMemberAttribute = "member"
+)
+
+type LdapProvider struct {
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	Hosts                 types.List   `tfsdk:"hosts"`
+	Port                  types.Int64  `tfsdk:"port"`
+	TimeoutSeconds        types.Int64  `tfsdk:"timeout_seconds"`
+	UseTls                types.Bool   `tfsdk:"use_tls"`
+	BindDn                types.String `tfsdk:"bind_dn"`
+	BindPasswordWo        types.String `tfsdk:"bind_password_wo"`
+	BindPasswordWoVersion types.Int64  `tfsdk:"bind_password_wo_version"`
+	UserSearchBase        types.String `tfsdk:"user_search_base"`
+	UserObjectClass       types.String `tfsdk:"user_object_class"`
+	UsernameAttribute     types.String `tfsdk:"username_attribute"`
+	GroupSearchBase       types.String `tfsdk:"group_search_base"`
+	GroupObjectClass      types.String `tfsdk:"group_object_class"`
+	GroupNameAttribute    types.String `tfsdk:"group_name_attribute"`
+	GroupMemberAttribute  types.String `tfsdk:"group_member_attribute"`
+}
+
+type ldapConfigData struct {
+	Hostname            []string `json:"hostname"`
+	Port                int64    `json:"port"`
+	Timeout             int64    `json:"timeout"`
+	Ldaps               bool     `json:"ldaps"`
+	BindDn              string   `json:"bind_dn"`
+	Password            string   `json:"password,omitempty"`
+	UsersSearchBase     string   `json:"users_search_base"`
+	UserObjectClass     string   `json:"user_object_class"`
+	UsernameAttr        string   `json:"username_attr"`
+	GroupsSearchBase    string   `json:"groups_search_base"`
+	GroupObjectClass    string   `json:"group_object_class"`
+	GroupNameAttr       string   `json:"group_name_attr"`
+	GroupMembershipAttr string   `json:"group_membership_attr"`
+}
+
+func (o LdapProvider) ResourceAttributes() map[string]resourceSchema.Attribute {
+	result := providerCommonAttributes("LDAP", ldapDefaultPort, "bind_password",
+		"Password used with `bind_dn` to bind to the LDAP server.")
+
+	result["use_tls"] = resourceSchema.BoolAttribute{
+		MarkdownDescription: "Use LDAPS (LDAP over TLS) when connecting to the servers. LDAPS servers " +
+			"typically listen on port `636`. Default: `false`",
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+	result["bind_dn"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Distinguished name used to bind to the LDAP server, e.g. " +
+			"`cn=apstra,ou=services,dc=example,dc=com`.",
+		Required:   true,
+		Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	result["user_search_base"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Distinguished name of the subtree in which users are found, e.g. " +
+			"`ou=people,dc=example,dc=com`.",
+		Required:   true,
+		Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	result["user_object_class"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Object class of user entries. Default: `" + ldapDefaultUserObjectClass + "`",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(ldapDefaultUserObjectClass),
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	result["username_attribute"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "User entry attribute which holds the login name. Default: `" +
+			ldapDefaultUsernameAttribute + "`",
+		Optional:   true,
+		Computed:   true,
+		Default:    stringdefault.StaticString(ldapDefaultUsernameAttribute),
+		Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	result["group_search_base"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Distinguished name of the subtree in which groups are found, e.g. " +
+			"`ou=groups,dc=example,dc=com`.",
+		Required:   true,
+		Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	result["group_object_class"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Object class of group entries. Default: `" + ldapDefaultGroupObjectClass + "`",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(ldapDefaultGroupObjectClass),
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	result["group_name_attribute"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Group entry attribute which holds the group name used by " +
+			"`apstra_aaa_provider_role_mapping`. Default: `" + ldapDefaultGroupNameAttribute + "`",
+		Optional:   true,
+		Computed:   true,
+		Default:    stringdefault.StaticString(ldapDefaultGroupNameAttribute),
+		Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	result["group_member_attribute"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Group entry attribute which identifies the group's members. Default: `" +
+			ldapDefaultGroupMemberAttribute + "`",
+		Optional:   true,
+		Computed:   true,
+		Default:    stringdefault.StaticString(ldapDefaultGroupMemberAttribute),
+		Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+
+	return result
+}
+
+// Request returns the API representation of the provider. The bind password
+// must be supplied by the caller because it is not available in the plan.
+// When secret is empty it is omitted from the request, and Apstra retains the
+// previously configured value.
+func (o *LdapProvider) Request(ctx context.Context, secret string, diags *diag.Diagnostics) *ProviderData {
+	var hosts []string
+	diags.Append(o.Hosts.ElementsAs(ctx, &hosts, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return providerRequest(o.Name, o.Enabled, providerTypeLdap, ldapConfigData{
+		Hostname:            hosts,
+		Port:                o.Port.ValueInt64(),
+		Timeout:             o.TimeoutSeconds.ValueInt64(),
+		Ldaps:               o.UseTls.ValueBool(),
+		BindDn:              o.BindDn.ValueString(),
+		Password:            secret,
+		UsersSearchBase:     o.UserSearchBase.ValueString(),
+		UserObjectClass:     o.UserObjectClass.ValueString(),
+		UsernameAttr:        o.UsernameAttribute.ValueString(),
+		GroupsSearchBase:    o.GroupSearchBase.ValueString(),
+		GroupObjectClass:    o.GroupObjectClass.ValueString(),
+		GroupNameAttr:       o.GroupNameAttribute.ValueString(),
+		GroupMembershipAttr: o.GroupMemberAttribute.ValueString(),
+	}, diags)
+}
+
+func (o *LdapProvider) LoadApiData(ctx context.Context, in *ProviderData, diags *diag.Diagnostics) {
+	var config ldapConfigData
+	providerLoadConfig(ctx, in, providerTypeLdap, &config, diags)
+	if diags.HasError() {
+		return
+	}
+
+	o.Name = types.StringValue(in.Label)
+	o.Enabled = types.BoolValue(in.Active)
+	o.Hosts = value.ListOrNull(ctx, types.StringType, config.Hostname, diags)
+	o.Port = types.Int64Value(config.Port)
+	o.TimeoutSeconds = types.Int64Value(config.Timeout)
+	o.UseTls = types.BoolValue(config.Ldaps)
+	o.BindDn = types.StringValue(config.BindDn)
+	o.UserSearchBase = types.StringValue(config.UsersSearchBase)
+	o.UserObjectClass = types.StringValue(config.UserObjectClass)
+	o.UsernameAttribute = types.StringValue(config.UsernameAttr)
+	o.GroupSearchBase = types.StringValue(config.GroupsSearchBase)
+	o.GroupObjectClass = types.StringValue(config.GroupObjectClass)
+	o.GroupNameAttribute = types.StringValue(config.GroupNameAttr)
+	o.GroupMemberAttribute = types.StringValue(config.GroupMembershipAttr)
+}
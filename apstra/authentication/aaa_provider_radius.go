@@ -0,0 +1,68 @@
+package authentication
+
+import (
+	"context"
+
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const radiusDefaultPort = 1812
+
+type RadiusProvider struct {
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	Hosts                 types.List   `tfsdk:"hosts"`
+	Port                  types.Int64  `tfsdk:"port"`
+	TimeoutSeconds        types.Int64  `tfsdk:"timeout_seconds"`
+	SharedSecretWo        types.String `tfsdk:"shared_secret_wo"`
+	SharedSecretWoVersion types.Int64  `tfsdk:"shared_secret_wo_version"`
+}
+
+type radiusConfigData struct {
+	Hostname []string `json:"hostname"`
+	Port     int64    `json:"port"`
+	Timeout  int64    `json:"timeout"`
+	Key      string   `json:"key,omitempty"`
+}
+
+func (o RadiusProvider) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return providerCommonAttributes("RADIUS", radiusDefaultPort, "shared_secret",
+		"Secret shared by Apstra and the RADIUS servers.")
+}
+
+// Request returns the API representation of the provider. The shared secret
+// must be supplied by the caller because it is not available in the plan.
+// When secret is empty it is omitted from the request, and Apstra retains the
+// previously configured value.
+func (o *RadiusProvider) Request(ctx context.Context, secret string, diags *diag.Diagnostics) *ProviderData {
+	var hosts []string
+	diags.Append(o.Hosts.ElementsAs(ctx, &hosts, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return providerRequest(o.Name, o.Enabled, providerTypeRadius, radiusConfigData{
+		Hostname: hosts,
+		Port:     o.Port.ValueInt64(),
+		Timeout:  o.TimeoutSeconds.ValueInt64(),
+		Key:      secret,
+	}, diags)
+}
+
+func (o *RadiusProvider) LoadApiData(ctx context.Context, in *ProviderData, diags *diag.Diagnostics) {
+	var config radiusConfigData
+	providerLoadConfig(ctx, in, providerTypeRadius, &config, diags)
+	if diags.HasError() {
+		return
+	}
+
+	o.Name = types.StringValue(in.Label)
+	o.Enabled = types.BoolValue(in.Active)
+	o.Hosts = value.ListOrNull(ctx, types.StringType, config.Hostname, diags)
+	o.Port = types.Int64Value(config.Port)
+	o.TimeoutSeconds = types.Int64Value(config.Timeout)
+}
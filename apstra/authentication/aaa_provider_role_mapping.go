@@ -0,0 +1,78 @@
+package authentication
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ProviderRoleMapping struct {
+	ProviderId types.String `tfsdk:"provider_id"`
+	Mappings   types.Map    `tfsdk:"mappings"`
+}
+
+func (o ProviderRoleMapping) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"provider_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the external authentication provider (LDAP, RADIUS or TACACS+).",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
+		"mappings": resourceSchema.MapAttribute{
+			MarkdownDescription: "Map of group names reported by the provider to the set of Apstra Role IDs " +
+				"granted to members of that group.",
+			Required:    true,
+			ElementType: types.SetType{ElemType: types.StringType},
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+				mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+				mapvalidator.ValueSetsAre(
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				),
+			},
+		},
+	}
+}
+
+func (o *ProviderRoleMapping) Request(ctx context.Context, diags *diag.Diagnostics) *ProviderRoleMappingData {
+	var mappings map[string][]string
+	diags.Append(o.Mappings.ElementsAs(ctx, &mappings, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	// sort groups so that the request is deterministic
+	groups := make([]string, 0, len(mappings))
+	for group := range mappings {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	result := ProviderRoleMappingData{Mappings: make([]ProviderRoleMappingEntryData, len(groups))}
+	for i, group := range groups {
+		result.Mappings[i] = ProviderRoleMappingEntryData{Group: group, Roles: mappings[group]}
+	}
+
+	return &result
+}
+
+func (o *ProviderRoleMapping) LoadApiData(ctx context.Context, in *ProviderRoleMappingData, diags *diag.Diagnostics) {
+	mappings := make(map[string][]string, len(in.Mappings))
+	for _, mapping := range in.Mappings {
+		mappings[mapping.Group] = append(mappings[mapping.Group], mapping.Roles...)
+	}
+
+	o.Mappings = value.MapOrNull(ctx, types.SetType{ElemType: types.StringType}, mappings, diags)
+}
@@ -0,0 +1,93 @@
+package authentication
+
+import (
+	"context"
+
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	tacacsDefaultPort     = 49
+	tacacsAuthModeAscii   = "ascii"
+	tacacsAuthModeChap    = "chap"
+	tacacsAuthModePap     = "pap"
+	tacacsDefaultAuthMode = tacacsAuthModePap
+)
+
+type TacacsProvider struct {
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	Hosts                 types.List   `tfsdk:"hosts"`
+	Port                  types.Int64  `tfsdk:"port"`
+	TimeoutSeconds        types.Int64  `tfsdk:"timeout_seconds"`
+	AuthMode              types.String `tfsdk:"auth_mode"`
+	SharedSecretWo        types.String `tfsdk:"shared_secret_wo"`
+	SharedSecretWoVersion types.Int64  `tfsdk:"shared_secret_wo_version"`
+}
+
+type tacacsConfigData struct {
+	Hostname []string `json:"hostname"`
+	Port     int64    `json:"port"`
+	Timeout  int64    `json:"timeout"`
+	AuthMode string   `json:"auth_mode"`
+	Key      string   `json:"key,omitempty"`
+}
+
+func (o TacacsProvider) ResourceAttributes() map[string]resourceSchema.Attribute {
+	result := providerCommonAttributes("TACACS+", tacacsDefaultPort, "shared_secret",
+		"Secret shared by Apstra and the TACACS+ servers.")
+
+	result["auth_mode"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Authentication mode used with the TACACS+ servers. Default: `" + tacacsDefaultAuthMode + "`",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(tacacsDefaultAuthMode),
+		Validators: []validator.String{
+			stringvalidator.OneOf(tacacsAuthModeAscii, tacacsAuthModeChap, tacacsAuthModePap),
+		},
+	}
+
+	return result
+}
+
+// Request returns the API representation of the provider. The shared secret
+// must be supplied by the caller because it is not available in the plan.
+// When secret is empty it is omitted from the request, and Apstra retains the
+// previously configured value.
+func (o *TacacsProvider) Request(ctx context.Context, secret string, diags *diag.Diagnostics) *ProviderData {
+	var hosts []string
+	diags.Append(o.Hosts.ElementsAs(ctx, &hosts, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return providerRequest(o.Name, o.Enabled, providerTypeTacacs, tacacsConfigData{
+		Hostname: hosts,
+		Port:     o.Port.ValueInt64(),
+		Timeout:  o.TimeoutSeconds.ValueInt64(),
+		AuthMode: o.AuthMode.ValueString(),
+		Key:      secret,
+	}, diags)
+}
+
+func (o *TacacsProvider) LoadApiData(ctx context.Context, in *ProviderData, diags *diag.Diagnostics) {
+	var config tacacsConfigData
+	providerLoadConfig(ctx, in, providerTypeTacacs, &config, diags)
+	if diags.HasError() {
+		return
+	}
+
+	o.Name = types.StringValue(in.Label)
+	o.Enabled = types.BoolValue(in.Active)
+	o.Hosts = value.ListOrNull(ctx, types.StringType, config.Hostname, diags)
+	o.Port = types.Int64Value(config.Port)
+	o.TimeoutSeconds = types.Int64Value(config.Timeout)
+	o.AuthMode = types.StringValue(config.AuthMode)
+}
@@ -0,0 +1,59 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	blueprintPermissionReadOnly  = "read_only"
+	blueprintPermissionReadWrite = "read_write"
+)
+
+type BlueprintPermission struct {
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	RoleId      types.String `tfsdk:"role_id"`
+	Permission  types.String `tfsdk:"permission"`
+}
+
+func (o BlueprintPermission) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"blueprint_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the Blueprint.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
+		"role_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the Role to which access is granted.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
+		"permission": resourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Access granted by the Role to the Blueprint. Must be one of `%s` "+
+				"or `%s`.", blueprintPermissionReadOnly, blueprintPermissionReadWrite),
+			Required: true,
+			Validators: []validator.String{stringvalidator.OneOf(
+				blueprintPermissionReadOnly,
+				blueprintPermissionReadWrite,
+			)},
+		},
+	}
+}
+
+func (o *BlueprintPermission) Request(_ context.Context, _ *diag.Diagnostics) *BlueprintPermissionData {
+	return &BlueprintPermissionData{Permission: o.Permission.ValueString()}
+}
+
+func (o *BlueprintPermission) LoadApiData(_ context.Context, in *BlueprintPermissionData, _ *diag.Diagnostics) {
+	o.Permission = types.StringValue(in.Permission)
+}
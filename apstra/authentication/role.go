@@ -0,0 +1,73 @@
+package authentication
+
+import (
+	"context"
+
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Role struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+func (o Role) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the Role.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"name": resourceSchema.StringAttribute{
+			MarkdownDescription: "Role name displayed in the Apstra web UI.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"description": resourceSchema.StringAttribute{
+			MarkdownDescription: "Role description displayed in the Apstra web UI.",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"permissions": resourceSchema.SetAttribute{
+			MarkdownDescription: "Set of global permissions granted by the Role, e.g. `blueprint.view`. The " +
+				"permissions supported by the Apstra server are listed in the web UI's role editor. Access to " +
+				"individual Blueprints is granted with the `apstra_blueprint_permission` resource.",
+			Required:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+	}
+}
+
+func (o *Role) Request(ctx context.Context, diags *diag.Diagnostics) *RoleData {
+	var permissions []string
+	diags.Append(o.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &RoleData{
+		Label:       o.Name.ValueString(),
+		Description: o.Description.ValueString(),
+		Permissions: permissions,
+	}
+}
+
+func (o *Role) LoadApiData(ctx context.Context, in *RoleData, diags *diag.Diagnostics) {
+	o.Name = types.StringValue(in.Label)
+	o.Description = value.StringOrNull(ctx, in.Description, diags)
+	o.Permissions = value.SetOrNull(ctx, types.StringType, in.Permissions, diags)
+}
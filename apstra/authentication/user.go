@@ -0,0 +1,109 @@
+package authentication
+
+import (
+	"context"
+
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type User struct {
+	Id                types.String `tfsdk:"id"`
+	Username          types.String `tfsdk:"username"`
+	FirstName         types.String `tfsdk:"first_name"`
+	LastName          types.String `tfsdk:"last_name"`
+	Email             types.String `tfsdk:"email"`
+	RoleIds           types.Set    `tfsdk:"role_ids"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+func (o User) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the User.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"username": resourceSchema.StringAttribute{
+			MarkdownDescription: "Login name of the User.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
+		"first_name": resourceSchema.StringAttribute{
+			MarkdownDescription: "First name of the User.",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"last_name": resourceSchema.StringAttribute{
+			MarkdownDescription: "Last name of the User.",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"email": resourceSchema.StringAttribute{
+			MarkdownDescription: "Email address of the User.",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"role_ids": resourceSchema.SetAttribute{
+			MarkdownDescription: "IDs of Roles granted to the User, either predefined (e.g. `administrator`) or " +
+				"created by the `apstra_role` resource.",
+			Required:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"password_wo": resourceSchema.StringAttribute{
+			MarkdownDescription: "Password of the User. This attribute is write-only: its value is sent to Apstra " +
+				"when the User is created (and whenever `password_wo_version` changes), but is never saved in the " +
+				"Terraform plan or state. The value may be supplied by an ephemeral resource. Requires Terraform 1.11 " +
+				"or later.",
+			Required:   true,
+			WriteOnly:  true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"password_wo_version": resourceSchema.Int64Attribute{
+			MarkdownDescription: utils.WriteOnlyVersionDescription("password_wo"),
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+	}
+}
+
+// Request returns the API representation of the User. The password is not
+// included because it must be read from the configuration rather than the plan.
+func (o *User) Request(ctx context.Context, diags *diag.Diagnostics) *UserData {
+	var roles []string
+	diags.Append(o.RoleIds.ElementsAs(ctx, &roles, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &UserData{
+		Username:  o.Username.ValueString(),
+		FirstName: o.FirstName.ValueString(),
+		LastName:  o.LastName.ValueString(),
+		Email:     o.Email.ValueString(),
+		Roles:     roles,
+	}
+}
+
+func (o *User) LoadApiData(ctx context.Context, in *UserData, diags *diag.Diagnostics) {
+	o.Username = types.StringValue(in.Username)
+	o.FirstName = value.StringOrNull(ctx, in.FirstName, diags)
+	o.LastName = value.StringOrNull(ctx, in.LastName, diags)
+	o.Email = value.StringOrNull(ctx, in.Email, diags)
+	o.RoleIds = value.SetOrNull(ctx, types.StringType, in.Roles, diags)
+}
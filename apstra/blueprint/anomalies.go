@@ -2,22 +2,38 @@ package blueprint
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
+
 	"github.com/Juniper/apstra-go-sdk/apstra"
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+// anomalyPollInterval is the interval at which anomalies are re-read while
+// waiting for them to clear.
+const anomalyPollInterval = 5 * time.Second
+
 type Anomalies struct {
-	BlueprintId      types.String `tfsdk:"blueprint_id"`
-	Details          types.Set    `tfsdk:"details"`
-	SummaryByNode    types.Set    `tfsdk:"summary_by_node"`
-	SummaryByService types.Set    `tfsdk:"summary_by_service"`
+	BlueprintId        types.String `tfsdk:"blueprint_id"`
+	Filter             types.Object `tfsdk:"filter"`
+	Suppressions       types.List   `tfsdk:"suppressions"`
+	WaitForZeroSeconds types.Int64  `tfsdk:"wait_for_zero_seconds"`
+	Count              types.Int64  `tfsdk:"count"`
+	Details            types.Set    `tfsdk:"details"`
+	SummaryByNode      types.Set    `tfsdk:"summary_by_node"`
+	SummaryByService   types.Set    `tfsdk:"summary_by_service"`
 }
 
 func (o Anomalies) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
@@ -27,20 +43,63 @@ func (o Anomalies) DataSourceAttributes() map[string]dataSourceSchema.Attribute
 			MarkdownDescription: "Apstra Blueprint ID.",
 			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 		},
+		"filter": dataSourceSchema.SingleNestedAttribute{
+			MarkdownDescription: "Criteria used to select Anomalies for inclusion in `details` and `count`. " +
+				"Anomalies must satisfy every specified criterion. The `summary_by_node` and " +
+				"`summary_by_service` attributes are not filtered.",
+			Optional:   true,
+			Attributes: anomalyFilter{}.dataSourceAttributes(),
+			Validators: []validator.Object{
+				apstravalidator.AtLeastNAttributes(
+					1,
+					"severities", "types", "system_ids", "roles", "expected_regex", "actual_regex",
+				),
+			},
+		},
+		"suppressions": dataSourceSchema.ListNestedAttribute{
+			MarkdownDescription: "Rules which acknowledge or suppress known-benign Anomalies in `details` and " +
+				"`count`. Rules are evaluated by the provider only: they are not saved to Apstra, and have no " +
+				"effect on the Apstra Web UI or on `summary_by_node` and `summary_by_service`. The same rules " +
+				"may be supplied to the `anomaly_suppressions` attribute of the `apstra_blueprint_deployment` " +
+				"resource.",
+			Optional: true,
+			NestedObject: dataSourceSchema.NestedAttributeObject{
+				Attributes: anomalySuppression{}.dataSourceAttributes(),
+				Validators: []validator.Object{
+					apstravalidator.AtLeastNAttributes(1, "anomaly_type", "role", "identity"),
+				},
+			},
+			Validators: []validator.List{listvalidator.SizeAtLeast(1)},
+		},
+		"wait_for_zero_seconds": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "When set, the data source polls the Blueprint for up to this many seconds, " +
+				"waiting for `count` to reach zero. An error is produced if matching Anomalies remain when the " +
+				"time has elapsed. This is useful for post-deployment verification.",
+			Optional:   true,
+			Validators: []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"count": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Number of Anomalies in `details` which have not been acknowledged by one of " +
+				"the `suppressions` rules.",
+			Computed: true,
+		},
 		"details": dataSourceSchema.SetNestedAttribute{
-			NestedObject:        dataSourceSchema.NestedAttributeObject{Attributes: anomalyDetail{}.dataSourceAttributes()},
-			Computed:            true,
-			MarkdownDescription: "Each current Anomaly is represented by an object in this set.",
+			NestedObject: dataSourceSchema.NestedAttributeObject{Attributes: anomalyDetail{}.dataSourceAttributes()},
+			Computed:     true,
+			MarkdownDescription: "Each current Anomaly matching `filter` is represented by an object in this set. " +
+				"Anomalies suppressed by one of the `suppressions` rules are omitted.",
 		},
 		"summary_by_node": dataSourceSchema.SetNestedAttribute{
-			NestedObject:        dataSourceSchema.NestedAttributeObject{Attributes: anomalyNodeSummary{}.dataSourceAttributes()},
-			Computed:            true,
-			MarkdownDescription: "Set of Anomaly summaries organized by Node.",
+			NestedObject: dataSourceSchema.NestedAttributeObject{Attributes: anomalyNodeSummary{}.dataSourceAttributes()},
+			Computed:     true,
+			MarkdownDescription: "Set of Anomaly summaries organized by Node, as reported by Apstra. Neither " +
+				"`filter` nor `suppressions` are applied to these summaries.",
 		},
 		"summary_by_service": dataSourceSchema.SetNestedAttribute{
-			NestedObject:        dataSourceSchema.NestedAttributeObject{Attributes: anomalyServiceSummary{}.dataSourceAttributes()},
-			Computed:            true,
-			MarkdownDescription: "Set of Anomaly summaries organized by Fabric Service.",
+			NestedObject: dataSourceSchema.NestedAttributeObject{Attributes: anomalyServiceSummary{}.dataSourceAttributes()},
+			Computed:     true,
+			MarkdownDescription: "Set of Anomaly summaries organized by Fabric Service, as reported by Apstra. " +
+				"Neither `filter` nor `suppressions` are applied to these summaries.",
 		},
 	}
 }
@@ -63,7 +122,36 @@ func (o *Anomalies) ReadFromApi(ctx context.Context, client *apstra.Client, diag
 }
 
 func (o *Anomalies) ReadAnomalyDetailsFromApi(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
-	anomalies, err := client.GetBlueprintAnomalies(ctx, apstra.ObjectId(o.BlueprintId.ValueString()))
+	var matcher *anomalyMatcher
+	if !o.Filter.IsNull() {
+		var filter anomalyFilter
+		diags.Append(o.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return
+		}
+
+		matcher = filter.matcher(ctx, diags)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	rules := anomalySuppressionRules(ctx, o.Suppressions, diags)
+	if diags.HasError() {
+		return
+	}
+
+	bpId := apstra.ObjectId(o.BlueprintId.ValueString())
+
+	var anomalies []apstra.BlueprintAnomaly
+	var acknowledged map[apstra.ObjectId]bool
+	var err error
+	if o.WaitForZeroSeconds.IsNull() {
+		anomalies, acknowledged, err = readAnomalies(ctx, client, bpId, matcher, rules)
+	} else {
+		timeout := time.Duration(o.WaitForZeroSeconds.ValueInt64()) * time.Second
+		anomalies, acknowledged, err = waitForZeroAnomalies(ctx, client, bpId, matcher, rules, timeout)
+	}
 	if err != nil {
 		if utils.IsApstra404(err) {
 			diags.AddAttributeError(
@@ -77,9 +165,12 @@ func (o *Anomalies) ReadAnomalyDetailsFromApi(ctx context.Context, client *apstr
 		return
 	}
 
-	o.Details = newAnomalyDetailSet(ctx, anomalies, diags)
+	o.Count = types.Int64Value(int64(unacknowledgedCount(anomalies, acknowledged)))
+	o.Details = newAnomalyDetailSet(ctx, anomalies, acknowledged, diags)
 }
 
+// ReadAnomalyNodeSummariesFromApi loads the per-node anomaly counts computed
+// by Apstra. Suppression rules are not applied to them.
 func (o *Anomalies) ReadAnomalyNodeSummariesFromApi(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
 	nodeSummaries, err := client.GetBlueprintNodeAnomalyCounts(ctx, apstra.ObjectId(o.BlueprintId.ValueString()))
 	if err != nil {
@@ -98,6 +189,8 @@ func (o *Anomalies) ReadAnomalyNodeSummariesFromApi(ctx context.Context, client
 	o.SummaryByNode = newAnomalyNodeSummarySet(ctx, nodeSummaries, diags)
 }
 
+// ReadAnomalyServiceSummariesFromApi loads the per-service anomaly counts
+// computed by Apstra. Suppression rules are not applied to them.
 func (o *Anomalies) ReadAnomalyServiceSummariesFromApi(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
 	serviceSummaries, err := client.GetBlueprintServiceAnomalyCounts(ctx, apstra.ObjectId(o.BlueprintId.ValueString()))
 	if err != nil {
@@ -115,3 +208,63 @@ func (o *Anomalies) ReadAnomalyServiceSummariesFromApi(ctx context.Context, clie
 
 	o.SummaryByService = newAnomalyServiceSummarySet(ctx, serviceSummaries, diags)
 }
+
+// readAnomalies returns the blueprint's current anomalies which are selected
+// by matcher and which have not been suppressed by rules, along with a map
+// indicating which of them have been acknowledged.
+func readAnomalies(ctx context.Context, client *apstra.Client, bpId apstra.ObjectId, matcher *anomalyMatcher, rules []AnomalySuppressionRule) ([]apstra.BlueprintAnomaly, map[apstra.ObjectId]bool, error) {
+	anomalies, err := client.GetBlueprintAnomalies(ctx, bpId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	anomalies, acknowledged := SuppressAnomalies(anomalies, rules, time.Now())
+	anomalies = slices.DeleteFunc(anomalies, func(anomaly apstra.BlueprintAnomaly) bool {
+		return !matcher.matches(anomaly)
+	})
+
+	return anomalies, acknowledged, nil
+}
+
+// waitForZeroAnomalies polls readAnomalies until no unacknowledged anomalies
+// remain. It returns an error if unacknowledged anomalies remain after timeout
+// has elapsed.
+func waitForZeroAnomalies(ctx context.Context, client *apstra.Client, bpId apstra.ObjectId, matcher *anomalyMatcher, rules []AnomalySuppressionRule, timeout time.Duration) ([]apstra.BlueprintAnomaly, map[apstra.ObjectId]bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(anomalyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		anomalies, acknowledged, err := readAnomalies(ctx, client, bpId, matcher, rules)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, nil, fmt.Errorf("anomalies not cleared after %s", timeout)
+			}
+			return nil, nil, err
+		}
+
+		count := unacknowledgedCount(anomalies, acknowledged)
+		if count == 0 {
+			return anomalies, acknowledged, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf("anomalies not cleared after %s: %d matching anomalies remain", timeout, count)
+		case <-ticker.C:
+		}
+	}
+}
+
+// unacknowledgedCount returns the number of anomalies not found in acknowledged.
+func unacknowledgedCount(anomalies []apstra.BlueprintAnomaly, acknowledged map[apstra.ObjectId]bool) int {
+	var result int
+	for _, anomaly := range anomalies {
+		if !acknowledged[anomaly.Id] {
+			result++
+		}
+	}
+	return result
+}
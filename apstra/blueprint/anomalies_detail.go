@@ -12,26 +12,28 @@ import (
 )
 
 type anomalyDetail struct {
-	AnomalyId   types.String `tfsdk:"anomaly_id"`
-	Severity    types.String `tfsdk:"severity"`
-	AnomalyType types.String `tfsdk:"type"`
-	Expected    types.String `tfsdk:"expected"`
-	Actual      types.String `tfsdk:"actual"`
-	Identity    types.String `tfsdk:"identity"`
-	Role        types.String `tfsdk:"role"`
-	Anomalous   types.String `tfsdk:"anomalous"`
+	AnomalyId    types.String `tfsdk:"anomaly_id"`
+	Severity     types.String `tfsdk:"severity"`
+	AnomalyType  types.String `tfsdk:"type"`
+	Expected     types.String `tfsdk:"expected"`
+	Actual       types.String `tfsdk:"actual"`
+	Identity     types.String `tfsdk:"identity"`
+	Role         types.String `tfsdk:"role"`
+	Anomalous    types.String `tfsdk:"anomalous"`
+	Acknowledged types.Bool   `tfsdk:"acknowledged"`
 }
 
 func (o anomalyDetail) attrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"anomaly_id": types.StringType,
-		"severity":   types.StringType,
-		"type":       types.StringType,
-		"expected":   types.StringType,
-		"actual":     types.StringType,
-		"identity":   types.StringType,
-		"role":       types.StringType,
-		"anomalous":  types.StringType,
+		"anomaly_id":   types.StringType,
+		"severity":     types.StringType,
+		"type":         types.StringType,
+		"expected":     types.StringType,
+		"actual":       types.StringType,
+		"identity":     types.StringType,
+		"role":         types.StringType,
+		"anomalous":    types.StringType,
+		"acknowledged": types.BoolType,
 	}
 }
 
@@ -69,10 +71,15 @@ func (o anomalyDetail) dataSourceAttributes() map[string]dataSourceSchema.Attrib
 			MarkdownDescription: "Extended Anomaly attribute which further contextualizes the Anomaly.",
 			Computed:            true,
 		},
+		"acknowledged": dataSourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether the Anomaly is matched by one of the `suppressions` rules " +
+				"with action `acknowledge`.",
+			Computed: true,
+		},
 	}
 }
 
-func (o *anomalyDetail) loadApiData(ctx context.Context, in *apstra.BlueprintAnomaly, diags *diag.Diagnostics) {
+func (o *anomalyDetail) loadApiData(ctx context.Context, in *apstra.BlueprintAnomaly, acknowledged bool, diags *diag.Diagnostics) {
 	var role string
 	if in.Role != nil {
 		role = *in.Role
@@ -86,12 +93,13 @@ func (o *anomalyDetail) loadApiData(ctx context.Context, in *apstra.BlueprintAno
 	o.Identity = value.StringOrNull(ctx, string(in.Identity), diags)
 	o.Role = value.StringOrNull(ctx, role, diags)
 	o.Anomalous = value.StringOrNull(ctx, string(in.Anomalous), diags)
+	o.Acknowledged = types.BoolValue(acknowledged)
 }
 
-func newAnomalyDetailSet(ctx context.Context, in []apstra.BlueprintAnomaly, diags *diag.Diagnostics) types.Set {
+func newAnomalyDetailSet(ctx context.Context, in []apstra.BlueprintAnomaly, acknowledged map[apstra.ObjectId]bool, diags *diag.Diagnostics) types.Set {
 	anomalyDetails := make([]anomalyDetail, len(in))
 	for i, anomalyDetail := range in {
-		anomalyDetails[i].loadApiData(ctx, &anomalyDetail, diags)
+		anomalyDetails[i].loadApiData(ctx, &anomalyDetail, acknowledged[anomalyDetail.Id], diags)
 	}
 	if diags.HasError() {
 		return types.SetNull(types.ObjectType{AttrTypes: anomalyDetail{}.attrTypes()})
@@ -0,0 +1,166 @@
+package blueprint
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"slices"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type anomalyFilter struct {
+	Severities    types.Set    `tfsdk:"severities"`
+	AnomalyTypes  types.Set    `tfsdk:"types"`
+	SystemIds     types.Set    `tfsdk:"system_ids"`
+	Roles         types.Set    `tfsdk:"roles"`
+	ExpectedRegex types.String `tfsdk:"expected_regex"`
+	ActualRegex   types.String `tfsdk:"actual_regex"`
+}
+
+func (o anomalyFilter) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"severities":     types.SetType{ElemType: types.StringType},
+		"types":          types.SetType{ElemType: types.StringType},
+		"system_ids":     types.SetType{ElemType: types.StringType},
+		"roles":          types.SetType{ElemType: types.StringType},
+		"expected_regex": types.StringType,
+		"actual_regex":   types.StringType,
+	}
+}
+
+func (o anomalyFilter) dataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	stringSetValidators := []validator.Set{
+		setvalidator.SizeAtLeast(1),
+		setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+	}
+
+	return map[string]dataSourceSchema.Attribute{
+		"severities": dataSourceSchema.SetAttribute{
+			MarkdownDescription: "When set, only Anomalies with one of these severities (e.g. `critical`) match.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators:          stringSetValidators,
+		},
+		"types": dataSourceSchema.SetAttribute{
+			MarkdownDescription: "When set, only Anomalies of one of these types (e.g. `bgp`, `cabling`) match.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators:          stringSetValidators,
+		},
+		"system_ids": dataSourceSchema.SetAttribute{
+			MarkdownDescription: "When set, only Anomalies whose `identity` includes a `system_id` found in " +
+				"this set match.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators:  stringSetValidators,
+		},
+		"roles": dataSourceSchema.SetAttribute{
+			MarkdownDescription: "When set, only Anomalies with one of these roles (e.g. `spine_leaf`) match.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators:          stringSetValidators,
+		},
+		"expected_regex": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "When set, only Anomalies whose `expected` value (in JSON format) matches " +
+				"this regular expression match.",
+			Optional:   true,
+			Validators: []validator.String{apstravalidator.ParseRegexp()},
+		},
+		"actual_regex": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "When set, only Anomalies whose `actual` value (in JSON format) matches " +
+				"this regular expression match.",
+			Optional:   true,
+			Validators: []validator.String{apstravalidator.ParseRegexp()},
+		},
+	}
+}
+
+func (o anomalyFilter) matcher(ctx context.Context, diags *diag.Diagnostics) *anomalyMatcher {
+	var result anomalyMatcher
+	diags.Append(o.Severities.ElementsAs(ctx, &result.severities, true)...)
+	diags.Append(o.AnomalyTypes.ElementsAs(ctx, &result.anomalyTypes, true)...)
+	diags.Append(o.SystemIds.ElementsAs(ctx, &result.systemIds, true)...)
+	diags.Append(o.Roles.ElementsAs(ctx, &result.roles, true)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	var err error
+	if !o.ExpectedRegex.IsNull() {
+		result.expected, err = regexp.Compile(o.ExpectedRegex.ValueString())
+		if err != nil {
+			diags.AddError("failed to compile expected_regex", err.Error())
+			return nil
+		}
+	}
+	if !o.ActualRegex.IsNull() {
+		result.actual, err = regexp.Compile(o.ActualRegex.ValueString())
+		if err != nil {
+			diags.AddError("failed to compile actual_regex", err.Error())
+			return nil
+		}
+	}
+
+	return &result
+}
+
+// anomalyMatcher is the compiled form of anomalyFilter. Empty criteria match
+// every anomaly.
+type anomalyMatcher struct {
+	severities   []string
+	anomalyTypes []string
+	systemIds    []string
+	roles        []string
+	expected     *regexp.Regexp
+	actual       *regexp.Regexp
+}
+
+// matches returns true when the anomaly satisfies every criterion. A nil
+// matcher matches every anomaly.
+func (o *anomalyMatcher) matches(anomaly apstra.BlueprintAnomaly) bool {
+	if o == nil {
+		return true
+	}
+	if !matchesOneOf(o.severities, anomaly.Severity) {
+		return false
+	}
+	if !matchesOneOf(o.anomalyTypes, anomaly.AnomalyType) {
+		return false
+	}
+	if !matchesOneOf(o.roles, stringFromPtr(anomaly.Role)) {
+		return false
+	}
+	if len(o.systemIds) > 0 {
+		var identity struct {
+			SystemId string `json:"system_id"`
+		}
+		if err := json.Unmarshal([]byte(anomaly.Identity), &identity); err != nil {
+			return false
+		}
+		if !matchesOneOf(o.systemIds, identity.SystemId) {
+			return false
+		}
+	}
+	if o.expected != nil && !o.expected.Match([]byte(anomaly.Expected)) {
+		return false
+	}
+	if o.actual != nil && !o.actual.Match([]byte(anomaly.Actual)) {
+		return false
+	}
+
+	return true
+}
+
+// matchesOneOf returns true when candidates is empty or contains s.
+func matchesOneOf(candidates []string, s string) bool {
+	return len(candidates) == 0 || slices.Contains(candidates, s)
+}
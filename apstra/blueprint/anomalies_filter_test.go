@@ -0,0 +1,89 @@
+package blueprint
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnomalyMatcherMatches(t *testing.T) {
+	role := "spine_leaf"
+	anomaly := apstra.BlueprintAnomaly{
+		Id:          "a1",
+		Severity:    "critical",
+		AnomalyType: "bgp",
+		Role:        &role,
+		Identity:    json.RawMessage(`{"system_id":"525400ABCDEF","destination_ip":"10.0.0.1"}`),
+		Expected:    json.RawMessage(`{"value":"up"}`),
+		Actual:      json.RawMessage(`{"value":"down"}`),
+	}
+
+	type testCase struct {
+		matcher  *anomalyMatcher
+		expected bool
+	}
+
+	testCases := map[string]testCase{
+		"nil": {
+			matcher:  nil,
+			expected: true,
+		},
+		"empty": {
+			matcher:  &anomalyMatcher{},
+			expected: true,
+		},
+		"severity_match": {
+			matcher:  &anomalyMatcher{severities: []string{"warning", "critical"}},
+			expected: true,
+		},
+		"severity_mismatch": {
+			matcher:  &anomalyMatcher{severities: []string{"warning"}},
+			expected: false,
+		},
+		"type_mismatch": {
+			matcher:  &anomalyMatcher{anomalyTypes: []string{"cabling"}},
+			expected: false,
+		},
+		"role_mismatch": {
+			matcher:  &anomalyMatcher{roles: []string{"leaf_access"}},
+			expected: false,
+		},
+		"system_id_match": {
+			matcher:  &anomalyMatcher{systemIds: []string{"525400ABCDEF"}},
+			expected: true,
+		},
+		"system_id_mismatch": {
+			matcher:  &anomalyMatcher{systemIds: []string{"525400000000"}},
+			expected: false,
+		},
+		"expected_match": {
+			matcher:  &anomalyMatcher{expected: regexp.MustCompile(`"up"`)},
+			expected: true,
+		},
+		"actual_mismatch": {
+			matcher:  &anomalyMatcher{actual: regexp.MustCompile(`"up"`)},
+			expected: false,
+		},
+		"all_match": {
+			matcher: &anomalyMatcher{
+				severities:   []string{"critical"},
+				anomalyTypes: []string{"bgp"},
+				systemIds:    []string{"525400ABCDEF"},
+				roles:        []string{"spine_leaf"},
+				expected:     regexp.MustCompile(`up`),
+				actual:       regexp.MustCompile(`down`),
+			},
+			expected: true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tCase.expected, tCase.matcher.matches(anomaly))
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package blueprint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	AnomalySuppressionActionAcknowledge = "acknowledge"
+	AnomalySuppressionActionSuppress    = "suppress"
+)
+
+// anomalySuppression is a provider-side rule which acknowledges or suppresses
+// matching anomalies. Rules exist only in the Terraform configuration: they
+// are applied by the apstra_blueprint_anomalies data source and by the
+// apstra_blueprint_deployment anomaly gate, and are never sent to Apstra.
+type anomalySuppression struct {
+	Action      types.String `tfsdk:"action"`
+	AnomalyType types.String `tfsdk:"anomaly_type"`
+	Role        types.String `tfsdk:"role"`
+	Identity    types.Map    `tfsdk:"identity"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	Comment     types.String `tfsdk:"comment"`
+}
+
+// dataSourceAttributes returns the resourceAttributes converted for use in a
+// data source schema.
+func (o anomalySuppression) dataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	resourceAttributes := o.resourceAttributes()
+	result := make(map[string]dataSourceSchema.Attribute, len(resourceAttributes))
+	for k, v := range resourceAttributes {
+		switch v := v.(type) {
+		case resourceSchema.StringAttribute:
+			result[k] = dataSourceSchema.StringAttribute{
+				MarkdownDescription: v.MarkdownDescription,
+				Required:            v.Required,
+				Optional:            v.Optional,
+				Validators:          v.Validators,
+			}
+		case resourceSchema.MapAttribute:
+			result[k] = dataSourceSchema.MapAttribute{
+				MarkdownDescription: v.MarkdownDescription,
+				Optional:            v.Optional,
+				ElementType:         v.ElementType,
+				Validators:          v.Validators,
+			}
+		default:
+			panic(fmt.Sprintf("anomaly suppression attribute %q has unhandled type %T", k, v))
+		}
+	}
+	return result
+}
+
+func (o anomalySuppression) resourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"action": resourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Treatment of matching anomalies. With `%s`, matching anomalies "+
+				"are still listed by the `apstra_blueprint_anomalies` data source (flagged as acknowledged), but "+
+				"are not counted and do not block deployment. With `%s`, matching anomalies are omitted entirely.",
+				AnomalySuppressionActionAcknowledge, AnomalySuppressionActionSuppress),
+			Required: true,
+			Validators: []validator.String{stringvalidator.OneOf(
+				AnomalySuppressionActionAcknowledge,
+				AnomalySuppressionActionSuppress,
+			)},
+		},
+		"anomaly_type": resourceSchema.StringAttribute{
+			MarkdownDescription: "When set, only anomalies of this type (e.g. `bgp`, `cabling`) match.",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"role": resourceSchema.StringAttribute{
+			MarkdownDescription: "When set, only anomalies with this role (e.g. `spine_leaf`) match.",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"identity": resourceSchema.MapAttribute{
+			MarkdownDescription: "When set, only anomalies whose `identity` includes each of these keys with " +
+				"the given value match. For example, `{ system_id = \"525400ABCDEF\" }` matches only anomalies " +
+				"raised against that system. Non-string identity values are compared in JSON form.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators:  []validator.Map{mapvalidator.SizeAtLeast(1)},
+		},
+		"expires_at": resourceSchema.StringAttribute{
+			MarkdownDescription: "RFC3339 timestamp (e.g. `2030-01-01T00:00:00Z`) after which the rule no longer " +
+				"matches any anomaly. Omit for a rule which never expires.",
+			Optional:   true,
+			Validators: []validator.String{apstravalidator.ParseRfc3339()},
+		},
+		"comment": resourceSchema.StringAttribute{
+			MarkdownDescription: "Explanation of why the matching anomalies are benign. Not used by the provider.",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	}
+}
+
+// rule returns the compiled form of the suppression.
+func (o anomalySuppression) rule(ctx context.Context, diags *diag.Diagnostics) *AnomalySuppressionRule {
+	result := AnomalySuppressionRule{
+		Action:      o.Action.ValueString(),
+		AnomalyType: o.AnomalyType.ValueString(),
+		Role:        o.Role.ValueString(),
+	}
+
+	diags.Append(o.Identity.ElementsAs(ctx, &result.Identity, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	if !o.ExpiresAt.IsNull() {
+		expires, err := time.Parse(time.RFC3339, o.ExpiresAt.ValueString())
+		if err != nil {
+			diags.AddError("failed to parse anomaly suppression expires_at timestamp", err.Error())
+			return nil
+		}
+		result.ExpiresAt = &expires
+	}
+
+	return &result
+}
+
+// anomalySuppressionRules compiles a list of anomalySuppression objects.
+func anomalySuppressionRules(ctx context.Context, in types.List, diags *diag.Diagnostics) []AnomalySuppressionRule {
+	var suppressions []anomalySuppression
+	diags.Append(in.ElementsAs(ctx, &suppressions, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	result := make([]AnomalySuppressionRule, len(suppressions))
+	for i, suppression := range suppressions {
+		rule := suppression.rule(ctx, diags)
+		if diags.HasError() {
+			return nil
+		}
+		result[i] = *rule
+	}
+
+	return result
+}
+
+// AnomalySuppressionRule is the compiled form of an anomalySuppression.
+type AnomalySuppressionRule struct {
+	Action      string
+	AnomalyType string
+	Role        string
+	Identity    map[string]string
+	ExpiresAt   *time.Time
+}
+
+// Expired returns true when the rule has an expiry time before now.
+func (o AnomalySuppressionRule) Expired(now time.Time) bool {
+	return o.ExpiresAt != nil && now.After(*o.ExpiresAt)
+}
+
+// Matches returns true when an anomaly with the specified type, role and
+// identity is matched by the (unexpired) rule.
+func (o AnomalySuppressionRule) Matches(anomalyType, role string, identity json.RawMessage, now time.Time) bool {
+	if o.Expired(now) {
+		return false
+	}
+	if o.AnomalyType != "" && o.AnomalyType != anomalyType {
+		return false
+	}
+	if o.Role != "" && o.Role != role {
+		return false
+	}
+	if len(o.Identity) == 0 {
+		return true
+	}
+
+	var identityMap map[string]json.RawMessage
+	if err := json.Unmarshal(identity, &identityMap); err != nil {
+		return false
+	}
+
+	for k, want := range o.Identity {
+		got, ok := identityMap[k]
+		if !ok {
+			return false
+		}
+
+		var s string
+		if err := json.Unmarshal(got, &s); err != nil {
+			// not a string; compare the compacted JSON
+			var buf bytes.Buffer
+			if err = json.Compact(&buf, got); err != nil {
+				return false
+			}
+			s = buf.String()
+		}
+
+		if s != want {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SuppressAnomalies applies rules to anomalies. Anomalies matched by a
+// "suppress" rule are omitted from the result. The IDs of remaining anomalies
+// matched by an "acknowledge" rule are returned in the map.
+func SuppressAnomalies(anomalies []apstra.BlueprintAnomaly, rules []AnomalySuppressionRule, now time.Time) ([]apstra.BlueprintAnomaly, map[apstra.ObjectId]bool) {
+	result := make([]apstra.BlueprintAnomaly, 0, len(anomalies))
+	acknowledged := make(map[apstra.ObjectId]bool)
+
+ANOMALY:
+	for _, anomaly := range anomalies {
+		var ack bool
+		for _, rule := range rules {
+			if !rule.Matches(anomaly.AnomalyType, stringFromPtr(anomaly.Role), json.RawMessage(anomaly.Identity), now) {
+				continue
+			}
+			if rule.Action == AnomalySuppressionActionSuppress {
+				continue ANOMALY
+			}
+			ack = true
+		}
+
+		if ack {
+			acknowledged[anomaly.Id] = true
+		}
+		result = append(result, anomaly)
+	}
+
+	return result, acknowledged
+}
+
+func stringFromPtr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,107 @@
+package blueprint
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnomalySuppressionRuleMatches(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	type anomaly struct {
+		anomalyType string
+		role        string
+		identity    string
+	}
+
+	type testCase struct {
+		rule     AnomalySuppressionRule
+		anomaly  anomaly
+		expected bool
+	}
+
+	bgp := anomaly{
+		anomalyType: "bgp",
+		role:        "spine_leaf",
+		identity:    `{"anomaly_type":"bgp","system_id":"525400ABCDEF","destination_asn":65001}`,
+	}
+
+	testCases := map[string]testCase{
+		"type_match": {
+			rule:     AnomalySuppressionRule{AnomalyType: "bgp"},
+			anomaly:  bgp,
+			expected: true,
+		},
+		"type_mismatch": {
+			rule:     AnomalySuppressionRule{AnomalyType: "cabling"},
+			anomaly:  bgp,
+			expected: false,
+		},
+		"role_match": {
+			rule:     AnomalySuppressionRule{AnomalyType: "bgp", Role: "spine_leaf"},
+			anomaly:  bgp,
+			expected: true,
+		},
+		"role_mismatch": {
+			rule:     AnomalySuppressionRule{Role: "to_generic"},
+			anomaly:  bgp,
+			expected: false,
+		},
+		"identity_string_match": {
+			rule:     AnomalySuppressionRule{Identity: map[string]string{"system_id": "525400ABCDEF"}},
+			anomaly:  bgp,
+			expected: true,
+		},
+		"identity_number_match": {
+			rule:     AnomalySuppressionRule{Identity: map[string]string{"destination_asn": "65001"}},
+			anomaly:  bgp,
+			expected: true,
+		},
+		"identity_mismatch": {
+			rule:     AnomalySuppressionRule{Identity: map[string]string{"system_id": "525400ABCDEF", "destination_asn": "65002"}},
+			anomaly:  bgp,
+			expected: false,
+		},
+		"identity_missing_key": {
+			rule:     AnomalySuppressionRule{Identity: map[string]string{"interface": "xe-0/0/0"}},
+			anomaly:  bgp,
+			expected: false,
+		},
+		"not_yet_expired": {
+			rule:     AnomalySuppressionRule{AnomalyType: "bgp", ExpiresAt: &future},
+			anomaly:  bgp,
+			expected: true,
+		},
+		"expired": {
+			rule:     AnomalySuppressionRule{AnomalyType: "bgp", ExpiresAt: &past},
+			anomaly:  bgp,
+			expected: false,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+			result := tCase.rule.Matches(tCase.anomaly.anomalyType, tCase.anomaly.role, json.RawMessage(tCase.anomaly.identity), now)
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
+
+func TestAnomalySuppressionDataSourceAttributes(t *testing.T) {
+	resourceAttributes := anomalySuppression{}.resourceAttributes()
+	dataSourceAttributes := anomalySuppression{}.dataSourceAttributes()
+
+	require.Len(t, dataSourceAttributes, len(resourceAttributes))
+	for k, v := range resourceAttributes {
+		require.Contains(t, dataSourceAttributes, k)
+		require.Equal(t, v.GetMarkdownDescription(), dataSourceAttributes[k].GetMarkdownDescription())
+		require.Equal(t, v.IsRequired(), dataSourceAttributes[k].IsRequired())
+		require.Equal(t, v.IsOptional(), dataSourceAttributes[k].IsOptional())
+	}
+}
@@ -186,3 +186,10 @@ func (o *DatacenterConfiglet) Request(ctx context.Context, diags *diag.Diagnosti
 
 	return &result
 }
+
+func (o DatacenterConfiglet) DriftAttributePaths() DriftAttributePaths {
+	return DriftAttributePaths{
+		"label":     path.Root("name"),
+		"condition": path.Root("condition"),
+	}
+}
@@ -148,3 +148,10 @@ func (o *ConnectivityTemplate) LoadApiData(ctx context.Context, in *apstra.Conne
 	o.Tags = value.SetOrNull(ctx, types.StringType, in.Tags, diags)           // safe to ignore diagnostic here
 	o.Primitives = value.SetOrNull(ctx, types.StringType, oPrimitives, diags) // safe to ignore diagnostic here
 }
+
+func (o ConnectivityTemplate) DriftAttributePaths() DriftAttributePaths {
+	return DriftAttributePaths{
+		"label":       path.Root("name"),
+		"description": path.Root("description"),
+	}
+}
@@ -1524,3 +1524,14 @@ func (o *DatacenterGenericSystem) CopyGenericSystemInterfaceNamesFromStateWhereP
 
 	o.Links = value.SetOrNull(ctx, DatacenterGenericSystemLink{}.attrType(), slices.Collect(maps.Values(planLinksMap)), diags)
 }
+
+func (o DatacenterGenericSystem) DriftAttributePaths() DriftAttributePaths {
+	return DriftAttributePaths{
+		"label":               path.Root("name"),
+		"hostname":            path.Root("hostname"),
+		"deploy_mode":         path.Root("deploy_mode"),
+		"external":            path.Root("external"),
+		"port_channel_id_min": path.Root("port_channel_id_min"),
+		"port_channel_id_max": path.Root("port_channel_id_max"),
+	}
+}
@@ -526,3 +526,17 @@ func (o DatacenterRoutingPolicy) VersionConstraints(ctx context.Context, diags *
 
 	return response
 }
+
+func (o DatacenterRoutingPolicy) DriftAttributePaths() DriftAttributePaths {
+	return DriftAttributePaths{
+		"label":                     path.Root("name"),
+		"description":               path.Root("description"),
+		"import_policy":             path.Root("import_policy"),
+		"export_policy":             path.Root("export_policy"),
+		"expect_default_ipv4_route": path.Root("expect_default_ipv4"),
+		"expect_default_ipv6_route": path.Root("expect_default_ipv6"),
+		"aggregate_prefixes":        path.Root("aggregate_prefixes"),
+		"extra_import_routes":       path.Root("extra_imports"),
+		"extra_export_routes":       path.Root("extra_exports"),
+	}
+}
@@ -769,3 +769,13 @@ func (o DatacenterRoutingZone) VersionConstraints(_ context.Context, _ *diag.Dia
 
 	return response
 }
+
+func (o DatacenterRoutingZone) DriftAttributePaths() DriftAttributePaths {
+	return DriftAttributePaths{
+		"label":               path.Root("name"),
+		"vrf_name":            path.Root("vrf_name"),
+		"vlan_id":             path.Root("vlan_id"),
+		"vni_id":              path.Root("vni"),
+		"junos_evpn_irb_mode": path.Root("junos_evpn_irb_mode"),
+	}
+}
@@ -473,3 +473,11 @@ func (o DatacenterSecurityPolicy) VersionConstraints(_ context.Context, _ *diag.
 
 	return response
 }
+
+func (o DatacenterSecurityPolicy) DriftAttributePaths() DriftAttributePaths {
+	return DriftAttributePaths{
+		"label":       path.Root("name"),
+		"description": path.Root("description"),
+		"enabled":     path.Root("enabled"),
+	}
+}
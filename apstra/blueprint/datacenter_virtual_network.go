@@ -1090,3 +1090,22 @@ func (o DatacenterVirtualNetwork) VersionConstraints() compatibility.ConfigConst
 	}
 	return response
 }
+
+func (o DatacenterVirtualNetwork) DriftAttributePaths() DriftAttributePaths {
+	return DriftAttributePaths{
+		"label":                        path.Root("name"),
+		"description":                  path.Root("description"),
+		"vn_type":                      path.Root("type"),
+		"vn_id":                        path.Root("vni"),
+		"reserved_vlan_id":             path.Root("reserved_vlan_id"),
+		"ipv4_enabled":                 path.Root("ipv4_connectivity_enabled"),
+		"ipv6_enabled":                 path.Root("ipv6_connectivity_enabled"),
+		"ipv4_subnet":                  path.Root("ipv4_subnet"),
+		"ipv6_subnet":                  path.Root("ipv6_subnet"),
+		"virtual_gateway_ipv4_enabled": path.Root("ipv4_virtual_gateway_enabled"),
+		"virtual_gateway_ipv6_enabled": path.Root("ipv6_virtual_gateway_enabled"),
+		"virtual_gateway_ipv4":         path.Root("ipv4_virtual_gateway"),
+		"virtual_gateway_ipv6":         path.Root("ipv6_virtual_gateway"),
+		"l3_mtu":                       path.Root("l3_mtu"),
+	}
+}
@@ -95,23 +95,40 @@ func (o Deploy) ResourceAttributes() map[string]resourceSchema.Attribute {
 }
 
 func (o *Deploy) Deploy(ctx context.Context, commentTemplate *CommentTemplate, client *apstra.Client, diags *diag.Diagnostics) {
+	o.deploy(ctx, commentTemplate, client, deployPolicy{}, diags)
+}
+
+// deploy commits the blueprint subject to policy. The returned boolean is true
+// when the revision attributes have been updated to reflect the state of the
+// blueprint, even if the post-commit checks produced errors.
+func (o *Deploy) deploy(ctx context.Context, commentTemplate *CommentTemplate, client *apstra.Client, policy deployPolicy, diags *diag.Diagnostics) bool {
 	status, err := client.GetBlueprintStatus(ctx, apstra.ObjectId(o.BlueprintId.ValueString()))
 	if err != nil {
 		diags.AddError("error getting Blueprint status", err.Error())
-		return
+		return false
 	}
 
 	if status.BuildErrorsCount > 0 {
 		diags.AddError("Blueprint has build errors",
 			fmt.Sprintf("Blueprint %s has %d build errors which must be resolved prior to deployment", o.BlueprintId, status.BuildErrorsCount))
-		return
+		return false
 	}
 
 	if status.BuildWarningsCount > 0 {
+		if policy.failOnBuildWarnings {
+			diags.AddError("Blueprint has build warnings",
+				fmt.Sprintf("Blueprint %s has %d build warnings and `fail_on_build_warnings` is set", o.BlueprintId, status.BuildWarningsCount))
+			return false
+		}
 		diags.AddWarning("Blueprint has build warnings",
 			fmt.Sprintf("Blueprint %s has %d build warnings, but deployment may proceed", o.BlueprintId, status.BuildWarningsCount))
 	}
 
+	policy.checkAnomalies(ctx, client, o.BlueprintId.ValueString(), "prior to deployment", diags)
+	if diags.HasError() {
+		return false
+	}
+
 	if !status.HasUncommittedChanges {
 		diags.AddWarning("no uncommitted changes",
 			fmt.Sprintf(
@@ -120,7 +137,7 @@ func (o *Deploy) Deploy(ctx context.Context, commentTemplate *CommentTemplate, c
 		o.HasUncommittedChanges = types.BoolValue(status.HasUncommittedChanges)
 		o.ActiveRevision = types.Int64Value(int64(status.Version))
 		o.StagedRevision = types.Int64Value(int64(status.Version))
-		return
+		return true
 	}
 
 	// expand environment variables in the comment
@@ -147,26 +164,42 @@ func (o *Deploy) Deploy(ctx context.Context, commentTemplate *CommentTemplate, c
 	})
 	if err != nil {
 		diags.AddError("error deploying Blueprint", err.Error())
-		return
+		return false
 	}
 	if response.Error != nil {
 		diags.AddError(
 			fmt.Sprintf("blueprint deployment: status %q", response.Status.String()),
 			*response.Error,
 		)
-		return
+		return false
 	}
 	if response.Status != apstra.DeployStatusSuccess {
 		diags.AddError(
 			"blueprint deploy status",
 			fmt.Sprintf("status: %q", response.Status.String()),
 		)
-		return
+		return false
 	}
 
 	o.ActiveRevision = types.Int64Value(int64(response.Version))
 	o.StagedRevision = types.Int64Value(int64(response.Version))
 	o.HasUncommittedChanges = types.BoolValue(false)
+
+	// The blueprint has been committed. Errors from here on do not change the
+	// revision attributes.
+	if policy.waitForDeployed > 0 {
+		systemIds, getStatus, err := committedSystems(ctx, client, apstra.ObjectId(o.BlueprintId.ValueString()))
+		if err == nil {
+			err = waitForDeployed(ctx, systemIds, getStatus, policy.waitForDeployed)
+		}
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Blueprint %s revision %d did not reach deployed state", o.BlueprintId, response.Version), err.Error())
+			return true
+		}
+	}
+
+	policy.checkAnomalies(ctx, client, o.BlueprintId.ValueString(), "following deployment", diags)
+	return true
 }
 
 func (o *Deploy) Read(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
@@ -0,0 +1,345 @@
+package blueprint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/apstra-go-sdk/enum"
+	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	deployStatusPollInterval = 5 * time.Second
+)
+
+// DeployWithPolicy is the apstra_blueprint_deployment resource model. It
+// extends the Deploy model (shared with the data source) with opt-in checks
+// which gate the commit.
+type DeployWithPolicy struct {
+	Deploy
+	FailOnBuildWarnings    types.Bool  `tfsdk:"fail_on_build_warnings"`
+	BlockingAnomalyTypes   types.Set   `tfsdk:"blocking_anomaly_types"`
+	AnomalySuppressions    types.List  `tfsdk:"anomaly_suppressions"`
+	WaitForDeployedSeconds types.Int64 `tfsdk:"wait_for_deployed_seconds"`
+	PreviewConfig          types.Bool  `tfsdk:"preview_config"`
+	PendingConfig          types.Map   `tfsdk:"pending_config"`
+	PendingNodesAdded      types.Int64 `tfsdk:"pending_nodes_added"`
+	PendingNodesRemoved    types.Int64 `tfsdk:"pending_nodes_removed"`
+	PendingNodesChanged    types.Int64 `tfsdk:"pending_nodes_changed"`
+}
+
+func (o DeployWithPolicy) ResourceAttributes() map[string]resourceSchema.Attribute {
+	result := o.Deploy.ResourceAttributes()
+	result["fail_on_build_warnings"] = resourceSchema.BoolAttribute{
+		MarkdownDescription: "When `true`, deployment is refused if the Blueprint has build warnings. " +
+			"By default, build warnings are reported but do not prevent deployment.",
+		Optional: true,
+	}
+	result["blocking_anomaly_types"] = resourceSchema.SetAttribute{
+		MarkdownDescription: "Set of anomaly types (e.g. `bgp`, `cabling`, `config`; see the `type` attribute " +
+			"of the `apstra_blueprint_anomalies` data source) which block deployment. The Blueprint is checked " +
+			"for anomalies of these types before commit and again after commit (following the wait specified " +
+			"by `wait_for_deployed_seconds`, if any). An error is produced if any are found. Anomalies " +
+			"acknowledged or suppressed by one of the `anomaly_suppressions` rules are ignored.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+	}
+	result["anomaly_suppressions"] = resourceSchema.ListNestedAttribute{
+		MarkdownDescription: "Rules which exempt known-benign anomalies from the `blocking_anomaly_types` " +
+			"check. Rules are evaluated by the provider only: they are not saved to Apstra. The same rules " +
+			"may be supplied to the `suppressions` attribute of the `apstra_blueprint_anomalies` data source.",
+		Optional: true,
+		NestedObject: resourceSchema.NestedAttributeObject{
+			Attributes: anomalySuppression{}.resourceAttributes(),
+			Validators: []validator.Object{
+				apstravalidator.AtLeastNAttributes(1, "anomaly_type", "role", "identity"),
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.AlsoRequires(path.MatchRoot("blocking_anomaly_types")),
+		},
+	}
+	result["wait_for_deployed_seconds"] = resourceSchema.Int64Attribute{
+		MarkdownDescription: "When set, the provider waits up to this many seconds following commit for " +
+			"every system in the Blueprint to run the configuration rendered for the new revision. A system " +
+			"is deployed once the configuration Apstra expects it to run matches that rendering, its most " +
+			"recent deployment has not failed, and its configuration has not deviated. An error listing the " +
+			"failed systems is produced once no system remains pending, and an error listing every system " +
+			"which is not deployed is produced if the time elapses first. The status of each system which is " +
+			"not yet deployed is read every 5 seconds. The new revision is saved to the Terraform state even " +
+			"when this check fails, so the commit is not repeated by the next apply.",
+		Optional:   true,
+		Validators: []validator.Int64{int64validator.AtLeast(1)},
+	}
+	for k, v := range o.previewAttributes() {
+		result[k] = v
+	}
+	return result
+}
+
+// Commit deploys the Blueprint subject to the configured policies. The
+// returned boolean is true when the Blueprint has been committed (or had
+// nothing to commit), in which case the caller should save the resulting state
+// even if diags contains errors from the post-commit checks. Failing to do so
+// would cause the next apply to commit again.
+func (o *DeployWithPolicy) Commit(ctx context.Context, commentTemplate *CommentTemplate, client *apstra.Client, diags *diag.Diagnostics) bool {
+	policy := deployPolicy{
+		failOnBuildWarnings: o.FailOnBuildWarnings.ValueBool(),
+		waitForDeployed:     time.Duration(o.WaitForDeployedSeconds.ValueInt64()) * time.Second,
+	}
+
+	diags.Append(o.BlockingAnomalyTypes.ElementsAs(ctx, &policy.blockingAnomalyTypes, false)...)
+	if diags.HasError() {
+		return false
+	}
+
+	policy.anomalySuppressions = anomalySuppressionRules(ctx, o.AnomalySuppressions, diags)
+	if diags.HasError() {
+		return false
+	}
+
+	return o.Deploy.deploy(ctx, commentTemplate, client, policy, diags)
+}
+
+// deployPolicy describes the optional checks performed around a commit. The
+// zero value performs no checks.
+type deployPolicy struct {
+	failOnBuildWarnings  bool
+	blockingAnomalyTypes []string
+	anomalySuppressions  []AnomalySuppressionRule
+	waitForDeployed      time.Duration
+}
+
+// checkAnomalies adds an error to diags if the blueprint has any anomalies of
+// the blocking types. Anomalies matched by one of the policy's suppression
+// rules are ignored. when describes the point in the deployment at which the
+// check was made.
+func (o deployPolicy) checkAnomalies(ctx context.Context, client *apstra.Client, bpId string, when string, diags *diag.Diagnostics) {
+	if len(o.blockingAnomalyTypes) == 0 {
+		return
+	}
+
+	anomalies, err := client.GetBlueprintAnomalies(ctx, apstra.ObjectId(bpId))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed reading Blueprint %q anomalies", bpId), err.Error())
+		return
+	}
+
+	// drop both suppressed and acknowledged anomalies
+	anomalies, acknowledged := SuppressAnomalies(anomalies, o.anomalySuppressions, time.Now())
+	anomalies = slices.DeleteFunc(anomalies, func(a apstra.BlueprintAnomaly) bool { return acknowledged[a.Id] })
+
+	found := blockingAnomalyCounts(anomalies, o.blockingAnomalyTypes)
+	if len(found) == 0 {
+		return
+	}
+
+	summary := make([]string, len(found))
+	for i, t := range sortedKeys(found) {
+		summary[i] = fmt.Sprintf("%s: %d", t, found[t])
+	}
+
+	diags.AddError(
+		"Blueprint has blocking anomalies",
+		fmt.Sprintf("Blueprint %q has anomalies of blocking types %s: [%s]", bpId, when, strings.Join(summary, ", ")),
+	)
+}
+
+// blockingAnomalyCounts returns the number of anomalies of each of the
+// blocking types. Types with no anomalies are omitted.
+func blockingAnomalyCounts(anomalies []apstra.BlueprintAnomaly, blockingTypes []string) map[string]int {
+	blocking := make(map[string]bool, len(blockingTypes))
+	for _, t := range blockingTypes {
+		blocking[t] = true
+	}
+
+	result := make(map[string]int)
+	for _, anomaly := range anomalies {
+		if blocking[anomaly.AnomalyType] {
+			result[anomaly.AnomalyType]++
+		}
+	}
+
+	return result
+}
+
+func sortedKeys(m map[string]int) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// systemDeployStatus describes the deployment of the committed revision to a
+// single system.
+type systemDeployStatus struct {
+	deployed bool   // the system runs the configuration of the committed revision
+	err      string // non-empty when deployment to the system failed
+}
+
+// getSystemDeployStatusFunc returns the systemDeployStatus of the specified
+// system.
+type getSystemDeployStatusFunc func(ctx context.Context, systemId string) (*systemDeployStatus, error)
+
+// committedSystems returns the IDs of systems (devices) assigned to the
+// blueprint, along with a getSystemDeployStatusFunc which compares the
+// configuration Apstra expects each system to run with the configuration
+// rendered for the committed revision. It must be called after commit.
+func committedSystems(ctx context.Context, client *apstra.Client, bpId apstra.ObjectId) ([]string, getSystemDeployStatusFunc, error) {
+	systemIds, err := assignedSystemIds(ctx, client, bpId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the configuration rendered for the committed revision, keyed by system ID
+	committed := make(map[string]string, len(systemIds))
+	for _, systemId := range systemIds {
+		config, err := client.GetSystemRenderedConfig(ctx, bpId, apstra.ObjectId(systemId), enum.RenderedConfigTypeDeployed)
+		if err != nil {
+			var ace apstra.ClientErr
+			if errors.As(err, &ace) && ace.Type() == apstra.ErrNotfound {
+				continue // nothing is deployed to this system
+			}
+			return nil, nil, fmt.Errorf("failed to fetch deployed configuration for system %s: %w", systemId, err)
+		}
+
+		committed[systemId] = strings.TrimSpace(config)
+	}
+
+	getStatus := func(ctx context.Context, systemId string) (*systemDeployStatus, error) {
+		systemConfig, err := client.GetSystemConfig(ctx, apstra.ObjectId(systemId))
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(systemConfig.ExpectedConfig) != committed[systemId] {
+			// Apstra has not yet begun deploying the committed revision
+			return &systemDeployStatus{}, nil
+		}
+
+		if systemConfig.ErrorMessage != nil && *systemConfig.ErrorMessage != "" {
+			return &systemDeployStatus{err: *systemConfig.ErrorMessage}, nil
+		}
+
+		if systemConfig.ContiguousFailures > 0 {
+			return &systemDeployStatus{err: fmt.Sprintf("%d consecutive deployment failures", systemConfig.ContiguousFailures)}, nil
+		}
+
+		return &systemDeployStatus{deployed: !systemConfig.Deviated}, nil
+	}
+
+	return slices.Sorted(maps.Keys(committed)), getStatus, nil
+}
+
+// assignedSystemIds returns the IDs of the systems (devices) assigned to nodes
+// in the staging blueprint.
+func assignedSystemIds(ctx context.Context, client *apstra.Client, bpId apstra.ObjectId) ([]string, error) {
+	query := new(apstra.PathQuery).
+		SetBlueprintId(bpId).
+		SetClient(client).
+		Node([]apstra.QEEAttribute{
+			apstra.NodeTypeSystem.QEEAttribute(),
+			{Key: "name", Value: apstra.QEStringVal("n_system")},
+		})
+
+	var queryResult struct {
+		Items []struct {
+			System struct {
+				SystemId *string `json:"system_id"`
+			} `json:"n_system"`
+		} `json:"items"`
+	}
+
+	err := query.Do(ctx, &queryResult)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying for system nodes: %w", err)
+	}
+
+	var result []string
+	for _, item := range queryResult.Items {
+		if item.System.SystemId == nil || *item.System.SystemId == "" {
+			continue // no device assigned
+		}
+		result = append(result, *item.System.SystemId)
+	}
+
+	return result, nil
+}
+
+// waitForDeployed polls the deployment status of each of the specified
+// systems until every one of them runs the configuration of the committed
+// revision. Systems are polled one at a time, and are not polled again once
+// they have been seen to be deployed. It returns an error listing the systems
+// which failed once no system remains pending, or listing the systems which
+// are not deployed if timeout elapses first.
+func waitForDeployed(ctx context.Context, systemIds []string, getStatus getSystemDeployStatusFunc, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// poll at least ten times within the timeout
+	ticker := time.NewTicker(min(deployStatusPollInterval, timeout/10))
+	defer ticker.Stop()
+
+	for {
+		var pending, failed, remaining []string
+		for _, systemId := range systemIds {
+			status, err := getStatus(ctx, systemId)
+			if err != nil {
+				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					return fmt.Errorf("deployment not complete after %s", timeout)
+				}
+				return fmt.Errorf("failed reading system %s deployment status: %w", systemId, err)
+			}
+
+			switch {
+			case status.deployed:
+				continue
+			case status.err != "":
+				failed = append(failed, fmt.Sprintf("%s: %s", systemId, status.err))
+			default:
+				pending = append(pending, systemId)
+			}
+			remaining = append(remaining, systemId)
+		}
+
+		if len(remaining) == 0 {
+			return nil
+		}
+
+		if len(pending) == 0 {
+			return fmt.Errorf("deployment failed on %d systems: [%s]", len(failed), strings.Join(failed, ", "))
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("deployment not complete after %s: %d systems pending [%s], %d systems failed [%s]",
+				timeout, len(pending), strings.Join(pending, ", "), len(failed), strings.Join(failed, ", "))
+		case <-ticker.C:
+		}
+
+		systemIds = remaining
+	}
+}
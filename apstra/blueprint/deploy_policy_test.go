@@ -0,0 +1,135 @@
+package blueprint
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockingAnomalyCounts(t *testing.T) {
+	anomalies := []apstra.BlueprintAnomaly{
+		{AnomalyType: "bgp"},
+		{AnomalyType: "bgp"},
+		{AnomalyType: "cabling"},
+		{AnomalyType: "config"},
+	}
+
+	type testCase struct {
+		blocking []string
+		expected map[string]int
+	}
+
+	testCases := map[string]testCase{
+		"none_blocking": {
+			blocking: nil,
+			expected: map[string]int{},
+		},
+		"no_matches": {
+			blocking: []string{"lag", "mlag"},
+			expected: map[string]int{},
+		},
+		"single_type": {
+			blocking: []string{"bgp"},
+			expected: map[string]int{"bgp": 2},
+		},
+		"multiple_types": {
+			blocking: []string{"bgp", "config", "route"},
+			expected: map[string]int{"bgp": 2, "config": 1},
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tCase.expected, blockingAnomalyCounts(anomalies, tCase.blocking))
+		})
+	}
+}
+
+func TestWaitForDeployed(t *testing.T) {
+	ctx := context.Background()
+
+	const timeout = 2 * time.Second
+	const step = 500 * time.Millisecond
+
+	const (
+		pending  = "pending"
+		deployed = "deployed"
+		failed   = "failed"
+	)
+
+	type testCase struct {
+		states    map[string][]string // per-system state at each step; the last state persists
+		expectErr bool
+		minWait   time.Duration
+	}
+
+	testCases := map[string]testCase{
+		"no_systems": {},
+		"already_deployed": {
+			states: map[string][]string{"a": {deployed}, "b": {deployed}},
+		},
+		"deployed_in_turn": {
+			states:  map[string][]string{"a": {pending, deployed}, "b": {pending, pending, deployed}},
+			minWait: 2 * step,
+		},
+		"one_fails": {
+			states:    map[string][]string{"a": {pending, deployed}, "b": {pending, failed}},
+			expectErr: true,
+		},
+		"failure_waits_for_pending": {
+			states:    map[string][]string{"a": {failed}, "b": {pending, pending, deployed}},
+			expectErr: true,
+			minWait:   2 * step,
+		},
+		"failed_system_recovers": {
+			states:  map[string][]string{"a": {failed, deployed}, "b": {pending, pending, deployed}},
+			minWait: 2 * step,
+		},
+		"one_recovers_another_fails": {
+			states:    map[string][]string{"a": {failed, deployed}, "b": {pending, failed}},
+			expectErr: true,
+		},
+		"failed_system_not_recovered": {
+			states:    map[string][]string{"a": {failed}, "b": {deployed}},
+			expectErr: true,
+		},
+		"deployment_incomplete": {
+			states:    map[string][]string{"a": {deployed}, "b": {pending}},
+			expectErr: true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			start := time.Now()
+			getStatus := func(_ context.Context, systemId string) (*systemDeployStatus, error) {
+				states := tCase.states[systemId]
+				state := states[min(int(time.Since(start)/step), len(states)-1)]
+				switch state {
+				case deployed:
+					return &systemDeployStatus{deployed: true}, nil
+				case failed:
+					return &systemDeployStatus{err: "commit failed"}, nil
+				}
+				return &systemDeployStatus{}, nil
+			}
+
+			systemIds := slices.Sorted(maps.Keys(tCase.states))
+			err := waitForDeployed(ctx, systemIds, getStatus, timeout)
+			if tCase.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.GreaterOrEqual(t, time.Since(start), tCase.minWait)
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package blueprint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DeployPreview struct {
+	BlueprintId         types.String `tfsdk:"blueprint_id"`
+	PendingConfig       types.Map    `tfsdk:"pending_config"`
+	PendingNodesAdded   types.Int64  `tfsdk:"pending_nodes_added"`
+	PendingNodesRemoved types.Int64  `tfsdk:"pending_nodes_removed"`
+	PendingNodesChanged types.Int64  `tfsdk:"pending_nodes_changed"`
+}
+
+func (o DeployPreview) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"blueprint_id": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the Blueprint.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"pending_config": dataSourceSchema.MapAttribute{
+			MarkdownDescription: "Map of incremental configuration keyed by system ID (serial number). Systems " +
+				"with no pending configuration are omitted. Collecting it requires one API call per system with " +
+				"an assigned device.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"pending_nodes_added": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Count of nodes which exist in the staging Blueprint, but not in the active Blueprint.",
+			Computed:            true,
+		},
+		"pending_nodes_removed": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Count of nodes which exist in the active Blueprint, but not in the staging Blueprint.",
+			Computed:            true,
+		},
+		"pending_nodes_changed": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Count of nodes which exist in both the staging and active Blueprints, but with " +
+				"different attributes.",
+			Computed: true,
+		},
+	}
+}
+
+// Read populates the pending_* attributes with the changes which will be made
+// when the staging blueprint is deployed.
+func (o *DeployPreview) Read(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	changes, err := getPendingChanges(ctx, client, apstra.ObjectId(o.BlueprintId.ValueString()), true)
+	if err != nil {
+		if utils.IsApstra404(err) {
+			diags.AddAttributeError(path.Root("blueprint_id"), "not found", fmt.Sprintf("Blueprint %s not found", o.BlueprintId))
+			return
+		}
+		diags.AddError(fmt.Sprintf("failed to preview Blueprint %s changes", o.BlueprintId), err.Error())
+		return
+	}
+
+	o.PendingConfig, o.PendingNodesAdded, o.PendingNodesRemoved, o.PendingNodesChanged = changes.values(ctx, diags)
+}
+
+func (o DeployWithPolicy) previewAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"preview_config": resourceSchema.BoolAttribute{
+			MarkdownDescription: "When `true`, `pending_config` is collected. This requires one API call per " +
+				"system with an assigned device each time the deployment is planned or applied, so it is off by " +
+				"default.",
+			Optional: true,
+		},
+		"pending_config": resourceSchema.MapAttribute{
+			MarkdownDescription: "Map of incremental configuration keyed by system ID (serial number) which is " +
+				"pushed to systems by this deployment. Systems with no pending configuration are omitted. Null " +
+				"unless `preview_config` is `true`. When the Blueprint already has uncommitted changes at plan " +
+				"time, this is shown in the plan, and deployment is refused if the changes staged at apply time " +
+				"differ from it (e.g. because other resources in the same apply modified the Blueprint). Otherwise " +
+				"it is known after apply. Following deployment, this describes the changes made by the most recent " +
+				"commit.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"pending_nodes_added": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Count of nodes which exist in the staging Blueprint, but not in the active " +
+				"Blueprint. Determined at plan time, and checked before commit, under the same conditions as " +
+				"`pending_config`.",
+			Computed: true,
+		},
+		"pending_nodes_removed": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Count of nodes which exist in the active Blueprint, but not in the staging " +
+				"Blueprint. Determined at plan time, and checked before commit, under the same conditions as " +
+				"`pending_config`.",
+			Computed: true,
+		},
+		"pending_nodes_changed": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Count of nodes which exist in both the staging and active Blueprints, but with " +
+				"different attributes. Determined at plan time, and checked before commit, under the same " +
+				"conditions as `pending_config`.",
+			Computed: true,
+		},
+	}
+}
+
+// Preview populates the pending_* attributes with the changes which will be
+// made when the staging blueprint is deployed. pending_config is collected only
+// when preview_config is set.
+func (o *DeployWithPolicy) Preview(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	changes, err := getPendingChanges(ctx, client, apstra.ObjectId(o.BlueprintId.ValueString()), o.PreviewConfig.ValueBool())
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to preview Blueprint %s changes", o.BlueprintId), err.Error())
+		return
+	}
+
+	o.PendingConfig, o.PendingNodesAdded, o.PendingNodesRemoved, o.PendingNodesChanged = changes.values(ctx, diags)
+}
+
+// ConfirmPreview is called just before commit. It collects the changes which
+// will be deployed, and produces an error if any pending_* attribute which
+// was previewed at plan time no longer describes them, because the plan would
+// then understate the deployment.
+func (o *DeployWithPolicy) ConfirmPreview(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	planned := *o
+
+	o.Preview(ctx, client, diags)
+	if diags.HasError() {
+		return
+	}
+
+	differs := func(planned, current attr.Value) bool {
+		return !planned.IsUnknown() && !planned.Equal(current)
+	}
+
+	if differs(planned.PendingConfig, o.PendingConfig) ||
+		differs(planned.PendingNodesAdded, o.PendingNodesAdded) ||
+		differs(planned.PendingNodesRemoved, o.PendingNodesRemoved) ||
+		differs(planned.PendingNodesChanged, o.PendingNodesChanged) {
+		diags.AddError(
+			"Staged changes differ from the plan",
+			fmt.Sprintf("The changes staged in Blueprint %s differ from those previewed in the plan (nodes "+
+				"added/removed/changed: planned %s/%s/%s, now %s/%s/%s), so the Blueprint has not been deployed. "+
+				"Plan again to preview the changes which will be deployed.",
+				o.BlueprintId,
+				planned.PendingNodesAdded, planned.PendingNodesRemoved, planned.PendingNodesChanged,
+				o.PendingNodesAdded, o.PendingNodesRemoved, o.PendingNodesChanged),
+		)
+	}
+}
+
+// pendingChanges describes the difference between the staging and active
+// blueprints.
+type pendingChanges struct {
+	config  map[string]string
+	added   int64
+	removed int64
+	changed int64
+}
+
+// values returns the pending_* attribute values. pending_config is null when
+// the configuration was not collected.
+func (o pendingChanges) values(ctx context.Context, diags *diag.Diagnostics) (types.Map, types.Int64, types.Int64, types.Int64) {
+	config := types.MapNull(types.StringType)
+	if o.config != nil {
+		var d diag.Diagnostics
+		config, d = types.MapValueFrom(ctx, types.StringType, o.config)
+		diags.Append(d...)
+	}
+
+	return config, types.Int64Value(o.added), types.Int64Value(o.removed), types.Int64Value(o.changed)
+}
+
+// getPendingChanges counts the nodes which differ between the staging and
+// active blueprints. When withConfig is true, it also collects the incremental
+// configuration for each system.
+func getPendingChanges(ctx context.Context, client *apstra.Client, bpId apstra.ObjectId, withConfig bool) (*pendingChanges, error) {
+	var config map[string]string
+	if withConfig {
+		var err error
+		config, err = getPendingConfig(ctx, client, bpId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	drift, err := GetDrift(ctx, client, bpId)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading staged node changes: %w", err)
+	}
+
+	result := pendingChanges{config: config}
+	for _, node := range drift {
+		switch node.Status {
+		case NodeDriftStatusAdded:
+			result.added++
+		case NodeDriftStatusRemoved:
+			result.removed++
+		case NodeDriftStatusChanged:
+			result.changed++
+		}
+	}
+
+	return &result, nil
+}
+
+// getPendingConfig returns the incremental configuration for each system in
+// the blueprint, keyed by system ID. Systems without an assigned device and
+// systems with no pending configuration are omitted. One API call is made per
+// system.
+func getPendingConfig(ctx context.Context, client *apstra.Client, bpId apstra.ObjectId) (map[string]string, error) {
+	systemIds, err := assignedSystemIds(ctx, client, bpId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	for _, systemId := range systemIds {
+		diff, err := client.GetSystemRenderedConfigDiff(ctx, bpId, apstra.ObjectId(systemId))
+		if err != nil {
+			var ace apstra.ClientErr
+			if errors.As(err, &ace) && ace.Type() == apstra.ErrNotfound {
+				continue
+			}
+			return nil, fmt.Errorf("failed to fetch incremental configuration for system %s: %w", systemId, err)
+		}
+
+		if diff == nil || diff.Config == "" {
+			continue
+		}
+
+		result[systemId] = diff.Config
+	}
+
+	return result, nil
+}
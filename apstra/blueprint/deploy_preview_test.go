@@ -0,0 +1,53 @@
+package blueprint
+
+import (
+	"context"
+	"testing"
+
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmPreview(t *testing.T) {
+	ctx := context.Background()
+
+	srv := testutils.MockApstra(t)
+	client := testutils.GetMockClient(t, ctx, srv)
+
+	// a new blueprint has never been deployed, so both of its nodes (the
+	// default routing zone and the metadata node) are pending
+	bpId := srv.AddBlueprint("test")
+
+	var diags diag.Diagnostics
+	planned := DeployWithPolicy{
+		Deploy:        Deploy{BlueprintId: types.StringValue(bpId)},
+		PreviewConfig: types.BoolValue(true),
+	}
+	planned.Preview(ctx, client, &diags)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, int64(2), planned.PendingNodesAdded.ValueInt64())
+	require.Equal(t, 0, len(planned.PendingConfig.Elements()))
+
+	// nothing has been staged since the plan
+	unchanged := planned
+	unchanged.ConfirmPreview(ctx, client, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	// values which were unknown at plan time are collected
+	unknown := planned
+	unknown.PendingConfig = types.MapUnknown(types.StringType)
+	unknown.PendingNodesAdded = types.Int64Unknown()
+	unknown.PendingNodesRemoved = types.Int64Unknown()
+	unknown.PendingNodesChanged = types.Int64Unknown()
+	srv.AddNode(bpId, map[string]any{"type": "system", "label": "leaf1", "role": "leaf"})
+	unknown.ConfirmPreview(ctx, client, &diags)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, int64(3), unknown.PendingNodesAdded.ValueInt64())
+
+	// a change staged since the plan is not described by the planned values
+	stale := planned
+	stale.ConfirmPreview(ctx, client, &diags)
+	require.True(t, diags.HasError())
+}
@@ -241,7 +241,9 @@ func (o *DeviceAllocation) populateInterfaceMapIdFromNodeIdAndDeviceProfileNodeI
 	}
 }
 
-func (o *DeviceAllocation) nodeIdFromNodeName(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+// NodeIdFromNodeName sets NodeId by looking up the system node labeled with
+// NodeName in the staging blueprint.
+func (o *DeviceAllocation) NodeIdFromNodeName(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
 	var result struct {
 		Items []struct {
 			System struct {
@@ -291,7 +293,7 @@ func (o *DeviceAllocation) nodeIdFromNodeName(ctx context.Context, client *apstr
 func (o *DeviceAllocation) PopulateDataFromGraphDb(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
 	if o.NodeId.IsUnknown() {
 		// this should only be true once, in Create()
-		o.nodeIdFromNodeName(ctx, client, diags)
+		o.NodeIdFromNodeName(ctx, client, diags)
 	}
 	if diags.HasError() {
 		return
@@ -0,0 +1,390 @@
+package blueprint
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	NodeDriftStatusAdded   = "added"
+	NodeDriftStatusRemoved = "removed"
+	NodeDriftStatusChanged = "changed"
+)
+
+type Drift struct {
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	NodeIds     types.Set    `tfsdk:"node_ids"`
+	NodeTypes   types.Set    `tfsdk:"node_types"`
+	HasDrift    types.Bool   `tfsdk:"has_drift"`
+	Nodes       types.List   `tfsdk:"nodes"`
+}
+
+func (o Drift) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"blueprint_id": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the Blueprint.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"node_ids": dataSourceSchema.SetAttribute{
+			MarkdownDescription: "When set, only these graph nodes are compared. Typically these are the " +
+				"`id` values of resources managed by Terraform.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+		},
+		"node_types": dataSourceSchema.SetAttribute{
+			MarkdownDescription: "When set, only graph nodes of these types (e.g. `virtual_network`, " +
+				"`security_zone`, `system`) are compared.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+		},
+		"has_drift": dataSourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether any of the compared nodes differ between the staging and " +
+				"active Blueprints.",
+			Computed: true,
+		},
+		"nodes": dataSourceSchema.ListNestedAttribute{
+			MarkdownDescription: "Nodes which differ between the staging and active Blueprints, ordered by node ID.",
+			Computed:            true,
+			NestedObject: dataSourceSchema.NestedAttributeObject{
+				Attributes: nodeDrift{}.dataSourceAttributes(),
+			},
+		},
+	}
+}
+
+func (o *Drift) ReadFromApi(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	var nodeIds, nodeTypes []string
+	diags.Append(o.NodeIds.ElementsAs(ctx, &nodeIds, false)...)
+	diags.Append(o.NodeTypes.ElementsAs(ctx, &nodeTypes, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	drift, err := GetDrift(ctx, client, apstra.ObjectId(o.BlueprintId.ValueString()))
+	if err != nil {
+		if utils.IsApstra404(err) {
+			diags.AddAttributeError(
+				path.Root("blueprint_id"),
+				"not found",
+				fmt.Sprintf("Blueprint %s not found", o.BlueprintId),
+			)
+			return
+		}
+		diags.AddError(fmt.Sprintf("failed to compare Blueprint %s staging and active graphs", o.BlueprintId), err.Error())
+		return
+	}
+
+	var tfNodes []nodeDrift
+	for _, d := range drift {
+		if len(nodeIds) > 0 && !utils.SliceContains(d.Id, nodeIds) {
+			continue
+		}
+		if len(nodeTypes) > 0 && !utils.SliceContains(d.Type, nodeTypes) {
+			continue
+		}
+
+		var n nodeDrift
+		n.loadApiData(ctx, d, diags)
+		tfNodes = append(tfNodes, n)
+	}
+	if diags.HasError() {
+		return
+	}
+
+	o.HasDrift = types.BoolValue(len(tfNodes) > 0)
+	o.Nodes = value.ListOrNull(ctx, types.ObjectType{AttrTypes: nodeDrift{}.attrTypes()}, tfNodes, diags)
+}
+
+type nodeDrift struct {
+	Id         types.String `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	Label      types.String `tfsdk:"label"`
+	Status     types.String `tfsdk:"status"`
+	Attributes types.Set    `tfsdk:"attributes"`
+}
+
+func (o nodeDrift) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"type":       types.StringType,
+		"label":      types.StringType,
+		"status":     types.StringType,
+		"attributes": types.SetType{ElemType: types.StringType},
+	}
+}
+
+func (o nodeDrift) dataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"id": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Graph node ID.",
+			Computed:            true,
+		},
+		"type": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Graph node type.",
+			Computed:            true,
+		},
+		"label": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Graph node label, if any.",
+			Computed:            true,
+		},
+		"status": dataSourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("One of `%s` (the node exists only in the staging Blueprint), "+
+				"`%s` (the node exists only in the active Blueprint) or `%s` (the node's attributes differ).",
+				NodeDriftStatusAdded, NodeDriftStatusRemoved, NodeDriftStatusChanged),
+			Computed: true,
+		},
+		"attributes": dataSourceSchema.SetAttribute{
+			MarkdownDescription: fmt.Sprintf("Names of the node attributes which differ. Only populated when "+
+				"`status` is `%s`.", NodeDriftStatusChanged),
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (o *nodeDrift) loadApiData(ctx context.Context, in NodeDrift, diags *diag.Diagnostics) {
+	o.Id = types.StringValue(in.Id)
+	o.Type = value.StringOrNull(ctx, in.Type, diags)
+	o.Label = value.StringOrNull(ctx, in.Label, diags)
+	o.Status = types.StringValue(in.Status)
+	o.Attributes = value.SetOrNull(ctx, types.StringType, in.Attributes, diags)
+}
+
+// NodeDrift describes a graph node which differs between the staging and
+// active blueprints.
+type NodeDrift struct {
+	Id          string
+	Type        string
+	Label       string
+	Status      string
+	Attributes  []string
+	Fingerprint string // fingerprint of the staging node; empty when the node has been removed
+}
+
+// DriftAttributePaths maps graph node attribute names to the paths of the
+// resource attributes which represent them in Terraform.
+type DriftAttributePaths map[string]path.Path
+
+// Summary renders the drift in a form suitable for a diagnostic detail. The
+// names of changed graph attributes are translated to Terraform attribute
+// paths using paths. Graph attributes which have no Terraform counterpart are
+// listed separately, by name.
+func (o NodeDrift) Summary(paths DriftAttributePaths) string {
+	switch o.Status {
+	case NodeDriftStatusAdded:
+		return fmt.Sprintf("%s node %q exists in the staging Blueprint, but not in the active Blueprint", o.Type, o.Id)
+	case NodeDriftStatusRemoved:
+		return fmt.Sprintf("%s node %q exists in the active Blueprint, but has been removed from the staging Blueprint", o.Type, o.Id)
+	}
+
+	var tfPaths, graphAttributes []string
+	for _, attribute := range o.Attributes {
+		p, ok := paths[attribute]
+		if !ok {
+			graphAttributes = append(graphAttributes, attribute)
+			continue
+		}
+		if !utils.SliceContains(p.String(), tfPaths) {
+			tfPaths = append(tfPaths, p.String())
+		}
+	}
+	sort.Strings(tfPaths)
+
+	switch {
+	case len(graphAttributes) == 0:
+		return fmt.Sprintf("%s node %q differs between the staging and active Blueprints at attribute paths: [%s]",
+			o.Type, o.Id, strings.Join(tfPaths, ", "))
+	case len(tfPaths) == 0:
+		return fmt.Sprintf("%s node %q differs between the staging and active Blueprints at graph attributes "+
+			"not managed by this resource: [%s]", o.Type, o.Id, strings.Join(graphAttributes, ", "))
+	}
+	return fmt.Sprintf("%s node %q differs between the staging and active Blueprints at attribute paths: [%s], "+
+		"and at graph attributes not managed by this resource: [%s]",
+		o.Type, o.Id, strings.Join(tfPaths, ", "), strings.Join(graphAttributes, ", "))
+}
+
+// GetDrift compares every node in the staging blueprint with its counterpart
+// in the active blueprint and returns those which differ, ordered by ID.
+func GetDrift(ctx context.Context, client *apstra.Client, bpId apstra.ObjectId) ([]NodeDrift, error) {
+	staging, err := getAllNodes(ctx, client, bpId, "staging")
+	if err != nil {
+		return nil, fmt.Errorf("failed reading staging blueprint nodes: %w", err)
+	}
+
+	active, err := getAllNodes(ctx, client, bpId, "operation")
+	if err != nil {
+		return nil, fmt.Errorf("failed reading active blueprint nodes: %w", err)
+	}
+
+	return DiffNodes(staging, active), nil
+}
+
+// GetNodeFingerprint returns the NodeFingerprint of the specified node in the
+// staging blueprint.
+func GetNodeFingerprint(ctx context.Context, client *apstra.Client, bpId apstra.ObjectId, nodeId string) (string, error) {
+	nodes, err := getNodes(ctx, client, bpId, "staging", fmt.Sprintf("node(id=%s, name='n')", qeQuote(nodeId)))
+	if err != nil {
+		return "", fmt.Errorf("failed reading staging blueprint node %q: %w", nodeId, err)
+	}
+
+	node, ok := nodes[nodeId]
+	if !ok {
+		return "", fmt.Errorf("node %q not found in staging blueprint", nodeId)
+	}
+
+	return NodeFingerprint(node), nil
+}
+
+// NodeFingerprint returns a digest of the node's attributes. Differences in
+// JSON formatting do not affect the result.
+func NodeFingerprint(node map[string]json.RawMessage) string {
+	attributes := make(map[string]any, len(node))
+	for k, v := range node {
+		var a any
+		_ = json.Unmarshal(v, &a)
+		attributes[k] = a
+	}
+
+	b, _ := json.Marshal(attributes) // map keys are sorted
+	return fmt.Sprintf("%x", sha256.Sum256(b))
+}
+
+// getAllNodes returns every node in the specified blueprint graph ("staging"
+// or "operation"), keyed by node ID.
+func getAllNodes(ctx context.Context, client *apstra.Client, bpId apstra.ObjectId, blueprintType string) (map[string]map[string]json.RawMessage, error) {
+	return getNodes(ctx, client, bpId, blueprintType, "node(name='n')")
+}
+
+// getNodes returns the nodes matched by query (which must name them "n") in
+// the specified blueprint graph ("staging" or "operation"), keyed by node ID.
+// The SDK's query types cannot select the "operation" graph, so the query
+// engine is called directly. The response format is that of Apstra 6.1.0 (see
+// compatibility.BlueprintDriftOK).
+func getNodes(ctx context.Context, client *apstra.Client, bpId apstra.ObjectId, blueprintType string, query string) (map[string]map[string]json.RawMessage, error) {
+	u, err := url.Parse(fmt.Sprintf("/api/blueprints/%s/qe?type=%s", url.PathEscape(bpId.String()), blueprintType))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse graph query URL: %w", err)
+	}
+
+	var response struct {
+		Items []struct {
+			Node map[string]json.RawMessage `json:"n"`
+		} `json:"items"`
+	}
+
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{
+		Method:  http.MethodPost,
+		Url:     u,
+		Payload: map[string]string{"query": query},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]map[string]json.RawMessage, len(response.Items))
+	for _, item := range response.Items {
+		var id string
+		if err = json.Unmarshal(item.Node["id"], &id); err != nil || id == "" {
+			continue
+		}
+		result[id] = item.Node
+	}
+
+	return result, nil
+}
+
+// DiffNodes compares staging and active graph nodes (each keyed by node ID)
+// and returns those which differ, ordered by ID.
+func DiffNodes(staging, active map[string]map[string]json.RawMessage) []NodeDrift {
+	ids := make(map[string]struct{}, len(staging))
+	for id := range staging {
+		ids[id] = struct{}{}
+	}
+	for id := range active {
+		ids[id] = struct{}{}
+	}
+
+	var result []NodeDrift
+	for id := range ids {
+		s, inStaging := staging[id]
+		a, inActive := active[id]
+
+		var d NodeDrift
+		switch {
+		case !inActive:
+			d = nodeDriftFromAttributes(id, NodeDriftStatusAdded, s)
+			d.Fingerprint = NodeFingerprint(s)
+		case !inStaging:
+			d = nodeDriftFromAttributes(id, NodeDriftStatusRemoved, a)
+		default:
+			d = nodeDriftFromAttributes(id, NodeDriftStatusChanged, s)
+			d.Attributes = diffAttributes(s, a)
+			if len(d.Attributes) == 0 {
+				continue
+			}
+			d.Fingerprint = NodeFingerprint(s)
+		}
+
+		result = append(result, d)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Id < result[j].Id })
+
+	return result
+}
+
+func nodeDriftFromAttributes(id, status string, attributes map[string]json.RawMessage) NodeDrift {
+	result := NodeDrift{Id: id, Status: status}
+	_ = json.Unmarshal(attributes["type"], &result.Type)
+	_ = json.Unmarshal(attributes["label"], &result.Label)
+	return result
+}
+
+// diffAttributes returns the sorted names of attributes which differ between
+// a and b. Values are compared semantically, so differences in JSON
+// formatting are ignored.
+func diffAttributes(a, b map[string]json.RawMessage) []string {
+	names := make(map[string]struct{}, len(a))
+	for k := range a {
+		names[k] = struct{}{}
+	}
+	for k := range b {
+		names[k] = struct{}{}
+	}
+
+	var result []string
+	for name := range names {
+		var aVal, bVal any
+		_ = json.Unmarshal(a[name], &aVal)
+		_ = json.Unmarshal(b[name], &bVal)
+		if !reflect.DeepEqual(aVal, bVal) {
+			result = append(result, name)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
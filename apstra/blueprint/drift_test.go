@@ -0,0 +1,141 @@
+package blueprint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffNodes(t *testing.T) {
+	node := func(s string) map[string]json.RawMessage {
+		var result map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal([]byte(s), &result))
+		return result
+	}
+
+	type testCase struct {
+		staging  map[string]map[string]json.RawMessage
+		active   map[string]map[string]json.RawMessage
+		expected []NodeDrift
+	}
+
+	testCases := map[string]testCase{
+		"empty": {},
+		"identical": {
+			staging: map[string]map[string]json.RawMessage{
+				"a": node(`{"id":"a","type":"virtual_network","label":"vn1","vn_id":"10000"}`),
+			},
+			active: map[string]map[string]json.RawMessage{
+				"a": node(`{"id": "a", "label": "vn1", "type": "virtual_network", "vn_id": "10000"}`),
+			},
+		},
+		"changed": {
+			staging: map[string]map[string]json.RawMessage{
+				"a": node(`{"id":"a","type":"virtual_network","label":"vn1","vn_id":"10001","tags":["x"]}`),
+			},
+			active: map[string]map[string]json.RawMessage{
+				"a": node(`{"id":"a","type":"virtual_network","label":"vn1","vn_id":"10000"}`),
+			},
+			expected: []NodeDrift{
+				{Id: "a", Type: "virtual_network", Label: "vn1", Status: NodeDriftStatusChanged, Attributes: []string{"tags", "vn_id"}},
+			},
+		},
+		"added_and_removed": {
+			staging: map[string]map[string]json.RawMessage{
+				"b": node(`{"id":"b","type":"security_zone","label":"rz2"}`),
+			},
+			active: map[string]map[string]json.RawMessage{
+				"a": node(`{"id":"a","type":"security_zone","label":"rz1"}`),
+			},
+			expected: []NodeDrift{
+				{Id: "a", Type: "security_zone", Label: "rz1", Status: NodeDriftStatusRemoved},
+				{Id: "b", Type: "security_zone", Label: "rz2", Status: NodeDriftStatusAdded},
+			},
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			result := DiffNodes(tCase.staging, tCase.active)
+
+			// nodes found in the staging graph are fingerprinted
+			for i, d := range result {
+				if s, ok := tCase.staging[d.Id]; ok {
+					require.Equal(t, NodeFingerprint(s), d.Fingerprint)
+				} else {
+					require.Empty(t, d.Fingerprint)
+				}
+				result[i].Fingerprint = ""
+			}
+
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
+
+func TestNodeFingerprint(t *testing.T) {
+	node := func(s string) map[string]json.RawMessage {
+		var result map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal([]byte(s), &result))
+		return result
+	}
+
+	a := NodeFingerprint(node(`{"id":"a","type":"virtual_network","label":"vn1","tags":["x","y"]}`))
+	require.Len(t, a, 64)
+
+	// formatting and attribute order are not significant
+	require.Equal(t, a, NodeFingerprint(node(`{ "tags": [ "x", "y" ], "label": "vn1", "type": "virtual_network", "id": "a" }`)))
+
+	// values are
+	require.NotEqual(t, a, NodeFingerprint(node(`{"id":"a","type":"virtual_network","label":"vn2","tags":["x","y"]}`)))
+	require.NotEqual(t, a, NodeFingerprint(node(`{"id":"a","type":"virtual_network","label":"vn1","tags":["y","x"]}`)))
+	require.NotEqual(t, a, NodeFingerprint(node(`{"id":"a","type":"virtual_network","label":"vn1"}`)))
+}
+
+func TestNodeDriftSummary(t *testing.T) {
+	paths := DriftAttributePaths{
+		"label":        path.Root("name"),
+		"vn_id":        path.Root("vni"),
+		"ipv4_subnet":  path.Root("ipv4_subnet"),
+		"ipv4_enabled": path.Root("ipv4_connectivity_enabled"),
+	}
+
+	type testCase struct {
+		drift    NodeDrift
+		expected string
+	}
+
+	testCases := map[string]testCase{
+		"mapped": {
+			drift: NodeDrift{Id: "a", Type: "virtual_network", Status: NodeDriftStatusChanged, Attributes: []string{"label", "vn_id"}},
+			expected: `virtual_network node "a" differs between the staging and active Blueprints at attribute ` +
+				`paths: [name, vni]`,
+		},
+		"unmapped": {
+			drift: NodeDrift{Id: "a", Type: "virtual_network", Status: NodeDriftStatusChanged, Attributes: []string{"svi_ips"}},
+			expected: `virtual_network node "a" differs between the staging and active Blueprints at graph ` +
+				`attributes not managed by this resource: [svi_ips]`,
+		},
+		"mixed": {
+			drift: NodeDrift{Id: "a", Type: "virtual_network", Status: NodeDriftStatusChanged, Attributes: []string{"ipv4_enabled", "ipv4_subnet", "svi_ips"}},
+			expected: `virtual_network node "a" differs between the staging and active Blueprints at attribute ` +
+				`paths: [ipv4_connectivity_enabled, ipv4_subnet], and at graph attributes not managed by this ` +
+				`resource: [svi_ips]`,
+		},
+		"added": {
+			drift:    NodeDrift{Id: "a", Type: "virtual_network", Status: NodeDriftStatusAdded},
+			expected: `virtual_network node "a" exists in the staging Blueprint, but not in the active Blueprint`,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tCase.expected, tCase.drift.Summary(paths))
+		})
+	}
+}
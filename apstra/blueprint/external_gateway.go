@@ -493,3 +493,16 @@ func (o ExternalGateway) VersionConstraints(_ context.Context, _ *diag.Diagnosti
 
 	return response
 }
+
+func (o ExternalGateway) DriftAttributePaths() DriftAttributePaths {
+	return DriftAttributePaths{
+		"label":            path.Root("name"),
+		"gw_ip":            path.Root("ip_address"),
+		"gw_asn":           path.Root("asn"),
+		"ttl":              path.Root("ttl"),
+		"keepalive_timer":  path.Root("keepalive_time"),
+		"holdtime_timer":   path.Root("hold_time"),
+		"evpn_route_types": path.Root("evpn_route_types"),
+		"password":         path.Root("password"),
+	}
+}
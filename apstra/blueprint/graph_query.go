@@ -0,0 +1,366 @@
+package blueprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	GraphQueryGraphStaging = "staging"
+	GraphQueryGraphActive  = "active"
+
+	GraphQueryLanguageQe      = "qe"
+	GraphQueryLanguageGraphQl = "graphql"
+)
+
+// qeParameterRegexp matches parameter placeholders (e.g. "$leaf_label") in
+// QE query strings.
+var qeParameterRegexp = regexp.MustCompile(`\$([A-Za-z_][A-Za-z0-9_]*)`)
+
+type GraphQuery struct {
+	BlueprintId   types.String  `tfsdk:"blueprint_id"`
+	Query         types.String  `tfsdk:"query"`
+	QueryLanguage types.String  `tfsdk:"query_language"`
+	Parameters    types.Map     `tfsdk:"parameters"`
+	Graph         types.String  `tfsdk:"graph"`
+	RevisionId    types.Int64   `tfsdk:"revision_id"`
+	Result        types.String  `tfsdk:"result"`
+	ResultDecoded types.Dynamic `tfsdk:"result_decoded"`
+}
+
+func (o GraphQuery) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"blueprint_id": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "The Blueprint ID you want to run the query against",
+			Required:            true,
+		},
+		"query": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "The query string in graph format. When `parameters` is set, QE queries may " +
+				"reference parameters by name with a `$` prefix (e.g. `node('system', label=$leaf)`). GraphQL " +
+				"queries reference them as ordinary GraphQL variables.",
+			Required:   true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"query_language": dataSourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Query language used by `query`. Either `%s` (the Apstra query "+
+				"engine syntax, e.g. `node('system', name='n_system')`) or `%s`. Default: `%s`. GraphQL "+
+				"requires Apstra %s.",
+				GraphQueryLanguageQe, GraphQueryLanguageGraphQl, GraphQueryLanguageQe,
+				compatibility.BlueprintQueryGraphsOK),
+			Optional:   true,
+			Validators: []validator.String{stringvalidator.OneOf(GraphQueryLanguageQe, GraphQueryLanguageGraphQl)},
+		},
+		"parameters": dataSourceSchema.MapAttribute{
+			MarkdownDescription: "Values to be passed into the query. For QE queries, each `$name` placeholder " +
+				"outside of a string literal is replaced by the corresponding value rendered as a quoted string literal, so values " +
+				"containing quotes or other special characters cannot alter the structure of the query. " +
+				"Every placeholder must have a value and every value must be used. For GraphQL queries, " +
+				"the values are sent as GraphQL variables.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators:  []validator.Map{mapvalidator.SizeAtLeast(1)},
+		},
+		"graph": dataSourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Blueprint graph to query. Either `%s` (the uncommitted staging "+
+				"Blueprint) or `%s` (the deployed Blueprint, known as the \"operation\" Blueprint in the "+
+				"Apstra API). Default: `%s`. Querying the `%s` graph requires Apstra %s.",
+				GraphQueryGraphStaging, GraphQueryGraphActive, GraphQueryGraphStaging,
+				GraphQueryGraphActive, compatibility.BlueprintQueryGraphsOK),
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(GraphQueryGraphStaging, GraphQueryGraphActive),
+				stringvalidator.ConflictsWith(path.MatchRoot("revision_id")),
+			},
+		},
+		"revision_id": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "When set, the query is run against this previously deployed revision of the " +
+				"Blueprint rather than the staging or active graph. Available revisions are listed by the " +
+				"`apstra_blueprint_revisions` data source. Requires Apstra " +
+				compatibility.BlueprintRevisionsOK.String() + ".",
+			Optional:   true,
+			Validators: []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"result": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "The result of the query",
+			Computed:            true,
+		},
+		"result_decoded": dataSourceSchema.DynamicAttribute{
+			MarkdownDescription: "The result of the query as a Terraform value, suitable for use in `for` " +
+				"expressions without `jsondecode()`. For QE queries this is the list of matched `items`, each " +
+				"an object keyed by the names given in the query. For GraphQL queries this is the `data` object. " +
+				"JSON arrays become lists. Where the elements of an array differ in type, e.g. nodes of different " +
+				"types matched by the same name, the array becomes a tuple instead, because Terraform lists " +
+				"require a single element type. Both can be iterated with `for` expressions.",
+			Computed: true,
+		},
+	}
+}
+
+// VersionConstraints returns the Apstra versions required by the options
+// selected in the configuration. Queries of the staging graph use the SDK and
+// work with any version. The other options call the API directly, and require
+// the version whose API their requests were written against.
+func (o GraphQuery) VersionConstraints() compatibility.ConfigConstraints {
+	var response compatibility.ConfigConstraints
+
+	if o.Graph.ValueString() == GraphQueryGraphActive {
+		response.AddAttributeConstraints(compatibility.AttributeConstraint{
+			Path:        path.Root("graph"),
+			Constraints: compatibility.BlueprintQueryGraphsOK,
+		})
+	}
+
+	if o.QueryLanguage.ValueString() == GraphQueryLanguageGraphQl {
+		response.AddAttributeConstraints(compatibility.AttributeConstraint{
+			Path:        path.Root("query_language"),
+			Constraints: compatibility.BlueprintQueryGraphsOK,
+		})
+	}
+
+	if utils.HasValue(o.RevisionId) {
+		response.AddAttributeConstraints(compatibility.AttributeConstraint{
+			Path:        path.Root("revision_id"),
+			Constraints: compatibility.BlueprintRevisionsOK,
+		})
+	}
+
+	return response
+}
+
+// Read runs the query and populates Result and ResultDecoded.
+func (o *GraphQuery) Read(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	language := GraphQueryLanguageQe
+	if !o.QueryLanguage.IsNull() {
+		language = o.QueryLanguage.ValueString()
+	}
+
+	var parameters map[string]string
+	diags.Append(o.Parameters.ElementsAs(ctx, &parameters, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	u, err := o.url(language)
+	if err != nil {
+		diags.AddError("cannot parse graph query URL", err.Error())
+		return
+	}
+
+	var payload struct {
+		Query     string            `json:"query"`
+		Variables map[string]string `json:"variables,omitempty"`
+	}
+
+	switch language {
+	case GraphQueryLanguageGraphQl:
+		payload.Query = o.Query.ValueString()
+		payload.Variables = parameters
+	default:
+		payload.Query, err = QeRenderParameters(o.Query.ValueString(), parameters)
+		if err != nil {
+			diags.AddAttributeError(path.Root("parameters"), "cannot apply query parameters", err.Error())
+			return
+		}
+	}
+
+	var response json.RawMessage
+	if language == GraphQueryLanguageQe && o.RevisionId.IsNull() && o.Graph.ValueString() != GraphQueryGraphActive {
+		// the SDK covers QE queries of the staging graph
+		query := new(apstra.RawQuery).
+			SetBlueprintType(apstra.BlueprintTypeStaging).
+			SetBlueprintId(apstra.ObjectId(o.BlueprintId.ValueString())).
+			SetClient(client).
+			SetQuery(payload.Query)
+		err = query.Do(ctx, nil)
+		response = query.RawResult()
+	} else {
+		// The SDK does not cover the other graphs, revisions or GraphQL, so
+		// the API is called directly. Requests follow Apstra 6.1.0 (see
+		// VersionConstraints).
+		err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPost, Url: u, Payload: &payload}, &response)
+	}
+	if err != nil {
+		if utils.IsApstra404(err) {
+			diags.AddAttributeError(
+				path.Root("blueprint_id"),
+				"not found",
+				fmt.Sprintf("Blueprint %s (or the requested revision) not found", o.BlueprintId),
+			)
+			return
+		}
+		diags.AddError("failed while running graph query", err.Error())
+		return
+	}
+
+	o.Result = types.StringValue(string(response))
+
+	// extract the interesting part of the response
+	var decoded struct {
+		Items json.RawMessage `json:"items"` // qe
+		Data  json.RawMessage `json:"data"`  // graphql
+	}
+	err = json.Unmarshal(response, &decoded)
+	if err != nil {
+		diags.AddError("failed to parse graph query response", err.Error())
+		return
+	}
+
+	raw := decoded.Items
+	if language == GraphQueryLanguageGraphQl {
+		raw = decoded.Data
+	}
+	if len(raw) == 0 {
+		raw = []byte("null")
+	}
+
+	o.ResultDecoded = value.DynamicFromJson(ctx, raw, diags)
+}
+
+// url returns the query endpoint for the configured graph and language.
+func (o GraphQuery) url(language string) (*url.URL, error) {
+	endpoint := "qe"
+	if language == GraphQueryLanguageGraphQl {
+		endpoint = "ql"
+	}
+
+	bpId := url.PathEscape(o.BlueprintId.ValueString())
+
+	if !o.RevisionId.IsNull() {
+		return url.Parse(fmt.Sprintf("/api/blueprints/%s/revisions/%d/%s", bpId, o.RevisionId.ValueInt64(), endpoint))
+	}
+
+	// the API calls the active blueprint "operation"
+	blueprintType := "staging"
+	if o.Graph.ValueString() == GraphQueryGraphActive {
+		blueprintType = "operation"
+	}
+
+	return url.Parse(fmt.Sprintf("/api/blueprints/%s/%s?type=%s", bpId, endpoint, blueprintType))
+}
+
+// QeRenderParameters replaces each "$name" placeholder in the QE query with
+// the corresponding parameter value, rendered as a quoted string literal.
+// Placeholders within string literals (e.g. 'cost$5') are left alone. It
+// is an error for the query to reference an undefined parameter, or for a
+// parameter to go unused. When parameters is empty, the query is returned
+// unchanged.
+func QeRenderParameters(query string, parameters map[string]string) (string, error) {
+	if len(parameters) == 0 {
+		return query, nil
+	}
+
+	used := make(map[string]bool, len(parameters))
+	var missing []string
+
+	result := qeReplaceUnquoted(query, func(s string) string {
+		name := s[1:]
+		v, ok := parameters[name]
+		if !ok {
+			if !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+			return s
+		}
+		used[name] = true
+		return qeQuote(v)
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("query references undefined parameters: [%s]", strings.Join(missing, ", "))
+	}
+
+	var unused []string
+	for k := range parameters {
+		if !used[k] {
+			unused = append(unused, k)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		return "", fmt.Errorf("parameters not referenced by the query: [%s]", strings.Join(unused, ", "))
+	}
+
+	return result, nil
+}
+
+// qeReplaceUnquoted replaces each match of qeParameterRegexp in query with the
+// result of f, skipping string literals. Literals are recognized the same way
+// as by the ParseGraphQuery validator: they're delimited by single or double
+// quotes, within which a backslash escapes the following character.
+func qeReplaceUnquoted(query string, f func(string) string) string {
+	var sb strings.Builder
+	var quote rune
+	var escaped bool
+	start := 0 // beginning of the current quoted or unquoted span
+	for i, r := range query {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0 && r == '\\':
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				// copy the literal, including its closing quote, verbatim
+				sb.WriteString(query[start : i+1])
+				start = i + 1
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			sb.WriteString(qeParameterRegexp.ReplaceAllStringFunc(query[start:i], f))
+			start = i
+			quote = r
+		}
+	}
+
+	if quote != 0 {
+		sb.WriteString(query[start:]) // unterminated literal
+	} else {
+		sb.WriteString(qeParameterRegexp.ReplaceAllStringFunc(query[start:], f))
+	}
+
+	return sb.String()
+}
+
+// qeQuote renders s as a single-quoted QE (python) string literal.
+func qeQuote(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('\'')
+	for _, r := range s {
+		switch r {
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\'':
+			sb.WriteString(`\'`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('\'')
+	return sb.String()
+}
@@ -0,0 +1,78 @@
+package blueprint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQeRenderParameters(t *testing.T) {
+	type testCase struct {
+		query      string
+		parameters map[string]string
+		expected   string
+		expErr     bool
+	}
+
+	testCases := map[string]testCase{
+		"no_parameters": {
+			query:    "node('system', label='$not_a_placeholder', name='n')",
+			expected: "node('system', label='$not_a_placeholder', name='n')",
+		},
+		"simple": {
+			query:      "node('system', label=$leaf, name='n')",
+			parameters: map[string]string{"leaf": "leaf1"},
+			expected:   "node('system', label='leaf1', name='n')",
+		},
+		"repeated": {
+			query:      "node('system', label=$leaf, name='n').out().node(label=$leaf)",
+			parameters: map[string]string{"leaf": "leaf1"},
+			expected:   "node('system', label='leaf1', name='n').out().node(label='leaf1')",
+		},
+		"placeholder_in_string_literal": {
+			query:      `node('system', label=$leaf, name='n').out().node(cost='cost$5', note="$leaf \"$leaf\"")`,
+			parameters: map[string]string{"leaf": "leaf1"},
+			expected:   `node('system', label='leaf1', name='n').out().node(cost='cost$5', note="$leaf \"$leaf\"")`,
+		},
+		"placeholder_after_escaped_quote": {
+			query:      `node(label='it\'s $leaf', role=$leaf)`,
+			parameters: map[string]string{"leaf": "leaf1"},
+			expected:   `node(label='it\'s $leaf', role='leaf1')`,
+		},
+		"only_placeholder_in_string_literal": {
+			query:      "node(label='$leaf')",
+			parameters: map[string]string{"leaf": "leaf1"},
+			expErr:     true, // unused
+		},
+		"escaping": {
+			query:      "node(label=$label)",
+			parameters: map[string]string{"label": `it's a \ "test"` + "\n"},
+			expected:   `node(label='it\'s a \\ "test"\n')`,
+		},
+		"undefined": {
+			query:      "node(label=$label, role=$role)",
+			parameters: map[string]string{"label": "foo"},
+			expErr:     true,
+		},
+		"unused": {
+			query:      "node(label=$label)",
+			parameters: map[string]string{"label": "foo", "role": "leaf"},
+			expErr:     true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := QeRenderParameters(tCase.query, tCase.parameters)
+			if tCase.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
@@ -0,0 +1,658 @@
+package blueprint
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/apstra-go-sdk/enum"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	importConfigCtInterface        = "apstra_datacenter_connectivity_template_interface"
+	importConfigCtLoopback         = "apstra_datacenter_connectivity_template_loopback"
+	importConfigCtProtocolEndpoint = "apstra_datacenter_connectivity_template_protocol_endpoint"
+	importConfigCtSvi              = "apstra_datacenter_connectivity_template_svi"
+	importConfigCtSystem           = "apstra_datacenter_connectivity_template_system"
+)
+
+// importConfigTarget describes how blueprint graph nodes of a single type are
+// discovered and mapped to a Terraform resource type.
+type importConfigTarget struct {
+	resourceType string
+	nodeType     string
+	nodeAttrs    []apstra.QEEAttribute       // additional node match criteria
+	skip         func(importConfigNode) bool // optional filter
+	importId     func(importConfigNode) string
+}
+
+// ImportConfigStateFunc imports and reads the resource of the specified type
+// identified by importId, returning the resource's schema and resulting state.
+type ImportConfigStateFunc func(ctx context.Context, resourceType, importId string, diags *diag.Diagnostics) (resourceSchema.Schema, tftypes.Value)
+
+// importConfigNode collects the graph node fields used by the various
+// importConfigTargets.
+type importConfigNode struct {
+	Id            string `json:"id"`
+	Label         string `json:"label"`
+	VrfName       string `json:"vrf_name"`
+	PropertySetId string `json:"property_set_id"`
+	LinkType      string `json:"link_type"`
+}
+
+var importConfigDatacenterTargets = []importConfigTarget{
+	{
+		resourceType: "apstra_datacenter_routing_zone",
+		nodeType:     "security_zone",
+		skip:         func(n importConfigNode) bool { return n.VrfName == "default" }, // default routing zone is not a resource
+	},
+	{
+		resourceType: "apstra_datacenter_virtual_network",
+		nodeType:     "virtual_network",
+	},
+	{
+		resourceType: "apstra_datacenter_generic_system",
+		nodeType:     "system",
+		nodeAttrs:    []apstra.QEEAttribute{{Key: "role", Value: apstra.QEStringVal("generic")}},
+	},
+	{
+		resourceType: "apstra_datacenter_property_set",
+		nodeType:     "property_set",
+		importId: func(n importConfigNode) string { // blueprint property sets are addressed by their catalog ID
+			if n.PropertySetId != "" {
+				return n.PropertySetId
+			}
+			return n.Id
+		},
+	},
+	{
+		resourceType: "apstra_datacenter_configlet",
+		nodeType:     "configlet",
+	},
+	{
+		resourceType: "apstra_datacenter_tag",
+		nodeType:     "tag",
+	},
+}
+
+var importConfigFreeformTargets = []importConfigTarget{
+	{
+		resourceType: "apstra_freeform_system",
+		nodeType:     "system",
+	},
+	{
+		resourceType: "apstra_freeform_link",
+		nodeType:     "link",
+		skip:         func(n importConfigNode) bool { return n.LinkType != "ethernet" },
+	},
+	{
+		resourceType: "apstra_freeform_aggregate_link",
+		nodeType:     "link",
+		skip:         func(n importConfigNode) bool { return n.LinkType != "aggregate_link" },
+	},
+	{
+		resourceType: "apstra_freeform_property_set",
+		nodeType:     "property_set",
+	},
+	{
+		resourceType: "apstra_freeform_config_template",
+		nodeType:     "config_template",
+	},
+}
+
+// ImportConfigResourceTypes returns the Terraform resource types which can be
+// discovered by ImportConfig.
+func ImportConfigResourceTypes() []string {
+	var result []string
+	for _, target := range importConfigDatacenterTargets {
+		result = append(result, target.resourceType)
+	}
+	result = append(result,
+		importConfigCtInterface,
+		importConfigCtLoopback,
+		importConfigCtProtocolEndpoint,
+		importConfigCtSvi,
+		importConfigCtSystem,
+	)
+	for _, target := range importConfigFreeformTargets {
+		result = append(result, target.resourceType)
+	}
+
+	return result
+}
+
+type ImportConfig struct {
+	BlueprintId    types.String `tfsdk:"blueprint_id"`
+	ResourceTypes  types.Set    `tfsdk:"resource_types"`
+	Resources      types.Map    `tfsdk:"resources"`
+	ImportBlocks   types.String `tfsdk:"import_blocks"`
+	ResourceBlocks types.String `tfsdk:"resource_blocks"`
+}
+
+func (o ImportConfig) DataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"blueprint_id": schema.StringAttribute{
+			MarkdownDescription: "Apstra Blueprint ID.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"resource_types": schema.SetAttribute{
+			MarkdownDescription: "Optional filter limiting discovery to the specified resource types. Must be one or " +
+				"more of: `" + strings.Join(ImportConfigResourceTypes(), "`, `") + "`. Resource types which do not apply " +
+				"to the Blueprint's reference design are ignored.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.OneOf(ImportConfigResourceTypes()...)),
+			},
+		},
+		"resources": schema.MapNestedAttribute{
+			MarkdownDescription: "Discovered objects, keyed by the Terraform resource address used in `import_blocks`.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ImportConfigResource{}.DataSourceAttributes(),
+			},
+		},
+		"import_blocks": schema.StringAttribute{
+			MarkdownDescription: "Terraform `import` blocks for each discovered object.",
+			Computed:            true,
+		},
+		"resource_blocks": schema.StringAttribute{
+			MarkdownDescription: "Terraform `resource` blocks for each discovered object, matching the addresses " +
+				"used in `import_blocks`. Only configurable attributes are rendered. Objects which could not be " +
+				"read are omitted with a warning; `terraform plan -generate-config-out=<file>` can be used to " +
+				"generate configuration for those.",
+			Computed: true,
+		},
+	}
+}
+
+// Discover walks the blueprint graph and populates Resources, ImportBlocks and
+// ResourceBlocks. bpFunc is used to obtain a datacenter client when
+// Connectivity Templates must be examined. stateFunc is used to read each
+// discovered object as its Terraform resource would.
+func (o *ImportConfig) Discover(ctx context.Context, client *apstra.Client, design enum.RefDesign, bpFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error), stateFunc ImportConfigStateFunc, diags *diag.Diagnostics) {
+	var wanted []string
+	if !o.ResourceTypes.IsNull() {
+		diags.Append(o.ResourceTypes.ElementsAs(ctx, &wanted, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+	want := func(resourceType string) bool {
+		return len(wanted) == 0 || slices.Contains(wanted, resourceType)
+	}
+
+	var targets []importConfigTarget
+	switch design {
+	case enum.RefDesignDatacenter:
+		targets = importConfigDatacenterTargets
+	case enum.RefDesignFreeform:
+		targets = importConfigFreeformTargets
+	default:
+		diags.AddError("unsupported reference design",
+			fmt.Sprintf("blueprint %s uses unsupported reference design %q", o.BlueprintId, design.String()))
+		return
+	}
+
+	var resources []ImportConfigResource
+	for _, target := range targets {
+		if !want(target.resourceType) {
+			continue
+		}
+
+		resources = append(resources, o.discoverNodes(ctx, client, target, diags)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if design == enum.RefDesignDatacenter {
+		ctTypes := []string{importConfigCtInterface, importConfigCtLoopback, importConfigCtProtocolEndpoint, importConfigCtSvi, importConfigCtSystem}
+		if slices.ContainsFunc(ctTypes, want) {
+			bp, err := bpFunc(ctx, o.BlueprintId.ValueString())
+			if err != nil {
+				diags.AddError(fmt.Sprintf("failed to create blueprint %s client", o.BlueprintId), err.Error())
+				return
+			}
+
+			for _, r := range o.discoverConnectivityTemplates(ctx, bp, diags) {
+				if want(r.ResourceType.ValueString()) {
+					resources = append(resources, r)
+				}
+			}
+			if diags.HasError() {
+				return
+			}
+		}
+	}
+
+	// assign unique Terraform resource names within each resource type
+	used := make(map[string]bool)
+	result := make(map[string]ImportConfigResource, len(resources))
+	for i, r := range resources {
+		name := importConfigResourceName(r.Label.ValueString(), r.ImportId.ValueString())
+		for n := 2; used[r.ResourceType.ValueString()+"."+name]; n++ {
+			name = importConfigResourceName(r.Label.ValueString(), r.ImportId.ValueString()) + "_" + strconv.Itoa(n)
+		}
+		used[r.ResourceType.ValueString()+"."+name] = true
+		resources[i].ResourceName = types.StringValue(name)
+		result[r.ResourceType.ValueString()+"."+name] = resources[i]
+	}
+
+	o.Resources = value.MapOrNull(ctx, types.ObjectType{AttrTypes: ImportConfigResource{}.AttrTypes()}, result, diags)
+	o.ImportBlocks = types.StringValue(importConfigRender(resources))
+	o.ResourceBlocks = types.StringValue(importConfigRenderResources(ctx, resources, stateFunc, diags))
+}
+
+func (o *ImportConfig) discoverNodes(ctx context.Context, client *apstra.Client, target importConfigTarget, diags *diag.Diagnostics) []ImportConfigResource {
+	var queryResponse struct {
+		Items []struct {
+			Node importConfigNode `json:"n_node"`
+		} `json:"items"`
+	}
+
+	query := new(apstra.PathQuery).
+		SetClient(client).
+		SetBlueprintId(apstra.ObjectId(o.BlueprintId.ValueString())).
+		SetBlueprintType(apstra.BlueprintTypeStaging).
+		Node(append([]apstra.QEEAttribute{
+			{Key: "type", Value: apstra.QEStringVal(target.nodeType)},
+			{Key: "name", Value: apstra.QEStringVal("n_node")},
+		}, target.nodeAttrs...))
+
+	err := query.Do(ctx, &queryResponse)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed querying for %q nodes", target.nodeType), err.Error())
+		return nil
+	}
+
+	var result []ImportConfigResource
+	for _, item := range queryResponse.Items {
+		if target.skip != nil && target.skip(item.Node) {
+			continue
+		}
+
+		id := item.Node.Id
+		if target.importId != nil {
+			id = target.importId(item.Node)
+		}
+
+		result = append(result, ImportConfigResource{
+			ResourceType: types.StringValue(target.resourceType),
+			ImportId:     types.StringValue(o.BlueprintId.ValueString() + ":" + id),
+			Label:        value.StringOrNull(ctx, item.Node.Label, diags),
+		})
+	}
+
+	slices.SortFunc(result, func(a, b ImportConfigResource) int {
+		return strings.Compare(a.ImportId.ValueString(), b.ImportId.ValueString())
+	})
+
+	return result
+}
+
+func (o *ImportConfig) discoverConnectivityTemplates(ctx context.Context, bp *apstra.TwoStageL3ClosClient, diags *diag.Diagnostics) []ImportConfigResource {
+	statuses, err := bp.GetAllConnectivityTemplateStatus(ctx)
+	if err != nil {
+		diags.AddError("failed to fetch Connectivity Template statuses", err.Error())
+		return nil
+	}
+
+	ids := make([]apstra.ObjectId, 0, len(statuses))
+	for id := range statuses {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	var result []ImportConfigResource
+	for _, id := range ids {
+		ct, err := bp.GetConnectivityTemplate(ctx, id)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("failed to fetch Connectivity Template %s", id), err.Error())
+			return nil
+		}
+
+		resourceType := importConfigCtResourceType(ct)
+		switch resourceType {
+		case "":
+			diags.AddWarning("Connectivity Template skipped",
+				fmt.Sprintf("Unable to determine the type of Connectivity Template %q (%s) from its primitives.", ct.Label, id))
+			continue
+		case importConfigCtLoopback:
+			diags.AddWarning("Connectivity Template type is ambiguous",
+				fmt.Sprintf("Connectivity Template %q (%s) contains only BGP Peering (IP Endpoint) primitives, which "+
+					"are valid in both *loopback* and *svi* Connectivity Templates. It has been rendered as %s. "+
+					"Change the resource type if it is assigned to SVI Application Points.", ct.Label, id, resourceType))
+		}
+
+		result = append(result, ImportConfigResource{
+			ResourceType: types.StringValue(resourceType),
+			ImportId:     types.StringValue(o.BlueprintId.ValueString() + ":" + id.String()),
+			Label:        value.StringOrNull(ctx, ct.Label, diags),
+		})
+	}
+
+	return result
+}
+
+// importConfigCtResourceType determines the Terraform resource type suitable
+// for a Connectivity Template by examining its top-level primitives. An empty
+// string indicates that the type could not be determined.
+func importConfigCtResourceType(ct *apstra.ConnectivityTemplate) string {
+	var ipEndpoint, dynamicBgp bool
+	for _, subpolicy := range ct.Subpolicies {
+		if subpolicy == nil {
+			continue
+		}
+
+		switch subpolicy.Attributes.(type) {
+		case *apstra.ConnectivityTemplatePrimitiveAttributesAttachSingleVlan,
+			*apstra.ConnectivityTemplatePrimitiveAttributesAttachMultipleVlan,
+			*apstra.ConnectivityTemplatePrimitiveAttributesAttachLogicalLink,
+			*apstra.ConnectivityTemplatePrimitiveAttributesAttachRoutingZoneConstraint:
+			return importConfigCtInterface
+		case *apstra.ConnectivityTemplatePrimitiveAttributesAttachCustomStaticRoute:
+			return importConfigCtSystem
+		case *apstra.ConnectivityTemplatePrimitiveAttributesAttachExistingRoutingPolicy:
+			return importConfigCtProtocolEndpoint
+		case *apstra.ConnectivityTemplatePrimitiveAttributesAttachBgpWithPrefixPeeringForSviOrSubinterface:
+			dynamicBgp = true
+		case *apstra.ConnectivityTemplatePrimitiveAttributesAttachIpEndpointWithBgpNsxt:
+			ipEndpoint = true
+		}
+	}
+
+	switch {
+	case dynamicBgp:
+		return importConfigCtSvi
+	case ipEndpoint:
+		return importConfigCtLoopback
+	}
+
+	return ""
+}
+
+var importConfigInvalidNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// importConfigResourceName produces a valid Terraform resource name from an
+// Apstra label, falling back to the import ID when no label is available.
+func importConfigResourceName(label, importId string) string {
+	name := strings.Trim(importConfigInvalidNameChars.ReplaceAllString(strings.ToLower(label), "_"), "_-")
+	if name == "" {
+		_, id, _ := strings.Cut(importId, ":")
+		name = strings.Trim(importConfigInvalidNameChars.ReplaceAllString(strings.ToLower(id), "_"), "_-")
+	}
+
+	// names must begin with a letter or underscore
+	if name == "" || !(name[0] == '_' || (name[0] >= 'a' && name[0] <= 'z')) {
+		name = "_" + name
+	}
+
+	return name
+}
+
+// importConfigRender renders a Terraform import block for each resource.
+func importConfigRender(resources []ImportConfigResource) string {
+	f := hclwrite.NewEmptyFile()
+	for i, r := range resources {
+		if i > 0 {
+			f.Body().AppendNewline()
+		}
+
+		block := f.Body().AppendNewBlock("import", nil).Body()
+		block.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: r.ResourceType.ValueString()},
+			hcl.TraverseAttr{Name: r.ResourceName.ValueString()},
+		})
+		block.SetAttributeValue("id", cty.StringVal(r.ImportId.ValueString()))
+	}
+
+	return string(f.Bytes())
+}
+
+// importConfigRenderResources renders a Terraform resource block for each
+// resource, using stateFunc to read the resource's configurable attributes.
+// Resources which cannot be read are skipped with a warning.
+func importConfigRenderResources(ctx context.Context, resources []ImportConfigResource, stateFunc ImportConfigStateFunc, diags *diag.Diagnostics) string {
+	f := hclwrite.NewEmptyFile()
+	for _, r := range resources {
+		address := r.ResourceType.ValueString() + "." + r.ResourceName.ValueString()
+
+		var d diag.Diagnostics
+		rs, state := stateFunc(ctx, r.ResourceType.ValueString(), r.ImportId.ValueString(), &d)
+		if d.HasError() {
+			var details []string
+			for _, e := range d.Errors() {
+				details = append(details, e.Summary()+": "+e.Detail())
+			}
+			diags.AddWarning("resource configuration not rendered",
+				fmt.Sprintf("Failed to read %s (import ID %q). Only its import block has been produced.\n\n%s",
+					address, r.ImportId.ValueString(), strings.Join(details, "\n")))
+			continue
+		}
+
+		attributes, err := importConfigObject(rs.Attributes, state)
+		if err != nil {
+			diags.AddWarning("resource configuration not rendered",
+				fmt.Sprintf("Failed to render %s (import ID %q). Only its import block has been produced.\n\n%s",
+					address, r.ImportId.ValueString(), err.Error()))
+			continue
+		}
+
+		if len(f.Body().Blocks()) > 0 {
+			f.Body().AppendNewline()
+		}
+
+		block := f.Body().AppendNewBlock("resource", []string{r.ResourceType.ValueString(), r.ResourceName.ValueString()}).Body()
+		for _, name := range slices.Sorted(maps.Keys(attributes)) {
+			block.SetAttributeValue(name, attributes[name])
+		}
+	}
+
+	return string(f.Bytes())
+}
+
+// importConfigObject converts val, an object conforming to attributes, into
+// values suitable for rendering as HCL. Computed-only, write-only and null
+// attributes are omitted because they cannot (or need not) be configured.
+func importConfigObject(attributes map[string]resourceSchema.Attribute, val tftypes.Value) (map[string]cty.Value, error) {
+	var m map[string]tftypes.Value
+	err := val.As(&m)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]cty.Value)
+	for name, attribute := range attributes {
+		if !(attribute.IsRequired() || attribute.IsOptional()) || attribute.IsWriteOnly() {
+			continue
+		}
+
+		v, ok := m[name]
+		if !ok || v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		result[name], err = importConfigAttributeValue(attribute, v)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", name, err)
+		}
+	}
+
+	return result, nil
+}
+
+// importConfigAttributeValue converts the value of a single attribute. Nested
+// attributes are walked so that their computed-only attributes can be omitted.
+func importConfigAttributeValue(attribute resourceSchema.Attribute, val tftypes.Value) (cty.Value, error) {
+	var nested map[string]resourceSchema.Attribute
+	switch attribute := attribute.(type) {
+	case resourceSchema.SingleNestedAttribute:
+		m, err := importConfigObject(attribute.Attributes, val)
+		if err != nil {
+			return cty.NilVal, err
+		}
+		return cty.ObjectVal(m), nil
+	case resourceSchema.ListNestedAttribute:
+		nested = attribute.NestedObject.Attributes
+	case resourceSchema.SetNestedAttribute:
+		nested = attribute.NestedObject.Attributes
+	case resourceSchema.MapNestedAttribute:
+		nested = attribute.NestedObject.Attributes
+	default:
+		return importConfigValue(val)
+	}
+
+	if val.Type().Is(tftypes.Map{}) {
+		var elements map[string]tftypes.Value
+		err := val.As(&elements)
+		if err != nil {
+			return cty.NilVal, err
+		}
+
+		result := make(map[string]cty.Value, len(elements))
+		for k, element := range elements {
+			m, err := importConfigObject(nested, element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			result[k] = cty.ObjectVal(m)
+		}
+		return cty.ObjectVal(result), nil
+	}
+
+	var elements []tftypes.Value
+	err := val.As(&elements)
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	result := make([]cty.Value, len(elements))
+	for i, element := range elements {
+		m, err := importConfigObject(nested, element)
+		if err != nil {
+			return cty.NilVal, err
+		}
+		result[i] = cty.ObjectVal(m)
+	}
+
+	return cty.TupleVal(result), nil
+}
+
+// importConfigValue converts a value which is not a nested attribute. Lists
+// and sets are rendered as tuples, and maps as objects, so that elements need
+// not share a single type.
+func importConfigValue(val tftypes.Value) (cty.Value, error) {
+	if val.IsNull() || !val.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	var err error
+	switch typ := val.Type(); {
+	case typ.Is(tftypes.String):
+		var s string
+		err = val.As(&s)
+		return cty.StringVal(s), err
+	case typ.Is(tftypes.Number):
+		var f big.Float
+		err = val.As(&f)
+		return cty.NumberVal(&f), err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err = val.As(&b)
+		return cty.BoolVal(b), err
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		err = val.As(&elements)
+		if err != nil {
+			return cty.NilVal, err
+		}
+
+		result := make([]cty.Value, len(elements))
+		for i, element := range elements {
+			result[i], err = importConfigValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+		}
+		return cty.TupleVal(result), nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		err = val.As(&elements)
+		if err != nil {
+			return cty.NilVal, err
+		}
+
+		result := make(map[string]cty.Value, len(elements))
+		for k, element := range elements {
+			if typ.Is(tftypes.Object{}) && element.IsNull() {
+				continue // unset object attributes need not be rendered
+			}
+			result[k], err = importConfigValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+		}
+		return cty.ObjectVal(result), nil
+	}
+
+	return cty.NilVal, fmt.Errorf("unsupported type %s", val.Type())
+}
+
+type ImportConfigResource struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceName types.String `tfsdk:"resource_name"`
+	ImportId     types.String `tfsdk:"import_id"`
+	Label        types.String `tfsdk:"label"`
+}
+
+func (o ImportConfigResource) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"resource_type": types.StringType,
+		"resource_name": types.StringType,
+		"import_id":     types.StringType,
+		"label":         types.StringType,
+	}
+}
+
+func (o ImportConfigResource) DataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"resource_type": schema.StringAttribute{
+			MarkdownDescription: "Terraform resource type.",
+			Computed:            true,
+		},
+		"resource_name": schema.StringAttribute{
+			MarkdownDescription: "Terraform resource name, derived from the object's label.",
+			Computed:            true,
+		},
+		"import_id": schema.StringAttribute{
+			MarkdownDescription: "Import ID suitable for use with the resource type.",
+			Computed:            true,
+		},
+		"label": schema.StringAttribute{
+			MarkdownDescription: "Label of the object as displayed in the web UI.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,222 @@
+package blueprint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportConfigResourceName(t *testing.T) {
+	type testCase struct {
+		label    string
+		importId string
+		expected string
+	}
+
+	testCases := map[string]testCase{
+		"simple":           {label: "blue", importId: "bp:abc", expected: "blue"},
+		"mixed_case":       {label: "Blue_VRF", importId: "bp:abc", expected: "blue_vrf"},
+		"spaces_and_punct": {label: "My VN (10)!", importId: "bp:abc", expected: "my_vn_10"},
+		"leading_digit":    {label: "10_net", importId: "bp:abc", expected: "_10_net"},
+		"hyphen_preserved": {label: "web-tier", importId: "bp:abc", expected: "web-tier"},
+		"no_label":         {label: "", importId: "bp:Ns0Y8-ZQb", expected: "ns0y8-zqb"},
+		"no_usable_label":  {label: "!!!", importId: "bp:abc", expected: "abc"},
+		"nothing_usable":   {label: "", importId: "bp:", expected: "_"},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tCase.expected, importConfigResourceName(tCase.label, tCase.importId))
+		})
+	}
+}
+
+func TestImportConfigCtResourceType(t *testing.T) {
+	primitive := func(attributes apstra.ConnectivityTemplatePrimitiveAttributes) *apstra.ConnectivityTemplatePrimitive {
+		return &apstra.ConnectivityTemplatePrimitive{Attributes: attributes}
+	}
+
+	type testCase struct {
+		subpolicies []*apstra.ConnectivityTemplatePrimitive
+		expected    string
+	}
+
+	testCases := map[string]testCase{
+		"empty": {
+			expected: "",
+		},
+		"interface_vlan": {
+			subpolicies: []*apstra.ConnectivityTemplatePrimitive{
+				primitive(&apstra.ConnectivityTemplatePrimitiveAttributesAttachSingleVlan{}),
+			},
+			expected: importConfigCtInterface,
+		},
+		"interface_ip_link": {
+			subpolicies: []*apstra.ConnectivityTemplatePrimitive{
+				primitive(&apstra.ConnectivityTemplatePrimitiveAttributesAttachLogicalLink{}),
+			},
+			expected: importConfigCtInterface,
+		},
+		"system": {
+			subpolicies: []*apstra.ConnectivityTemplatePrimitive{
+				primitive(&apstra.ConnectivityTemplatePrimitiveAttributesAttachCustomStaticRoute{}),
+			},
+			expected: importConfigCtSystem,
+		},
+		"protocol_endpoint": {
+			subpolicies: []*apstra.ConnectivityTemplatePrimitive{
+				primitive(&apstra.ConnectivityTemplatePrimitiveAttributesAttachExistingRoutingPolicy{}),
+			},
+			expected: importConfigCtProtocolEndpoint,
+		},
+		"svi": {
+			subpolicies: []*apstra.ConnectivityTemplatePrimitive{
+				primitive(&apstra.ConnectivityTemplatePrimitiveAttributesAttachIpEndpointWithBgpNsxt{}),
+				primitive(&apstra.ConnectivityTemplatePrimitiveAttributesAttachBgpWithPrefixPeeringForSviOrSubinterface{}),
+			},
+			expected: importConfigCtSvi,
+		},
+		"loopback": {
+			subpolicies: []*apstra.ConnectivityTemplatePrimitive{
+				primitive(&apstra.ConnectivityTemplatePrimitiveAttributesAttachIpEndpointWithBgpNsxt{}),
+			},
+			expected: importConfigCtLoopback,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+			ct := apstra.ConnectivityTemplate{Subpolicies: tCase.subpolicies}
+			require.Equal(t, tCase.expected, importConfigCtResourceType(&ct))
+		})
+	}
+}
+
+func TestImportConfigRender(t *testing.T) {
+	resources := []ImportConfigResource{
+		{
+			ResourceType: types.StringValue("apstra_datacenter_routing_zone"),
+			ResourceName: types.StringValue("blue"),
+			ImportId:     types.StringValue("bp1:rz1"),
+		},
+		{
+			ResourceType: types.StringValue("apstra_datacenter_tag"),
+			ResourceName: types.StringValue("odd"),
+			ImportId:     types.StringValue("bp1:${tag}"),
+		},
+	}
+
+	expected := `import {
+  to = apstra_datacenter_routing_zone.blue
+  id = "bp1:rz1"
+}
+
+import {
+  to = apstra_datacenter_tag.odd
+  id = "bp1:$${tag}"
+}
+`
+
+	require.Equal(t, expected, importConfigRender(resources))
+}
+
+func TestImportConfigRenderResources(t *testing.T) {
+	ctx := context.Background()
+
+	bindingAttrTypes := map[string]attr.Type{
+		"vlan_id":      types.Int64Type,
+		"access_ports": types.SetType{ElemType: types.StringType},
+		"leaf_name":    types.StringType,
+	}
+
+	s := resourceSchema.Schema{
+		Attributes: map[string]resourceSchema.Attribute{
+			"id":           resourceSchema.StringAttribute{Computed: true},
+			"blueprint_id": resourceSchema.StringAttribute{Required: true},
+			"name":         resourceSchema.StringAttribute{Required: true},
+			"vni":          resourceSchema.Int64Attribute{Optional: true, Computed: true},
+			"description":  resourceSchema.StringAttribute{Optional: true},
+			"password_wo":  resourceSchema.StringAttribute{Optional: true, WriteOnly: true},
+			"tags":         resourceSchema.SetAttribute{Optional: true, ElementType: types.StringType},
+			"bindings": resourceSchema.MapNestedAttribute{
+				Optional: true,
+				NestedObject: resourceSchema.NestedAttributeObject{
+					Attributes: map[string]resourceSchema.Attribute{
+						"vlan_id":      resourceSchema.Int64Attribute{Optional: true},
+						"access_ports": resourceSchema.SetAttribute{Optional: true, ElementType: types.StringType},
+						"leaf_name":    resourceSchema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+
+	state := types.ObjectValueMust(s.Type().(types.ObjectType).AttrTypes, map[string]attr.Value{
+		"id":           types.StringValue("vn1"),
+		"blueprint_id": types.StringValue("bp1"),
+		"name":         types.StringValue("web"),
+		"vni":          types.Int64Value(10100),
+		"description":  types.StringNull(),
+		"password_wo":  types.StringNull(),
+		"tags":         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("prod")}),
+		"bindings": types.MapValueMust(types.ObjectType{AttrTypes: bindingAttrTypes}, map[string]attr.Value{
+			"leaf 1": types.ObjectValueMust(bindingAttrTypes, map[string]attr.Value{
+				"vlan_id":      types.Int64Value(100),
+				"access_ports": types.SetValueMust(types.StringType, []attr.Value{}),
+				"leaf_name":    types.StringValue("leaf1"),
+			}),
+		}),
+	})
+	raw, err := state.ToTerraformValue(ctx)
+	require.NoError(t, err)
+
+	stateFunc := func(_ context.Context, _, importId string, diags *diag.Diagnostics) (resourceSchema.Schema, tftypes.Value) {
+		if importId != "bp1:vn1" {
+			diags.AddError("not found", importId)
+			return resourceSchema.Schema{}, tftypes.Value{}
+		}
+		return s, raw
+	}
+
+	resources := []ImportConfigResource{
+		{
+			ResourceType: types.StringValue("apstra_datacenter_virtual_network"),
+			ResourceName: types.StringValue("web"),
+			ImportId:     types.StringValue("bp1:vn1"),
+		},
+		{
+			ResourceType: types.StringValue("apstra_datacenter_virtual_network"),
+			ResourceName: types.StringValue("gone"),
+			ImportId:     types.StringValue("bp1:vn2"),
+		},
+	}
+
+	expected := `resource "apstra_datacenter_virtual_network" "web" {
+  bindings = {
+    "leaf 1" = {
+      access_ports = []
+      vlan_id      = 100
+    }
+  }
+  blueprint_id = "bp1"
+  name         = "web"
+  tags         = ["prod"]
+  vni          = 10100
+}
+`
+
+	var diags diag.Diagnostics
+	result := importConfigRenderResources(ctx, resources, stateFunc, &diags)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, 1, diags.WarningsCount())
+	require.Equal(t, expected, result)
+}
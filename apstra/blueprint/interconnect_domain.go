@@ -187,3 +187,11 @@ func (o *InterconnectDomain) Query(resultName string) apstra.QEQuery {
 	return new(apstra.PathQuery).
 		Node(attributes)
 }
+
+func (o InterconnectDomain) DriftAttributePaths() DriftAttributePaths {
+	return DriftAttributePaths{
+		"label":                     path.Root("name"),
+		"interconnect_route_target": path.Root("route_target"),
+		"interconnect_esi_mac":      path.Root("esi_mac"),
+	}
+}
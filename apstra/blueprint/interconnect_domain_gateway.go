@@ -464,3 +464,15 @@ func (o InterconnectDomainGateway) FilterMatch(_ context.Context, in *Interconne
 
 	return true
 }
+
+func (o InterconnectDomainGateway) DriftAttributePaths() DriftAttributePaths {
+	return DriftAttributePaths{
+		"label":           path.Root("name"),
+		"gw_ip":           path.Root("ip_address"),
+		"gw_asn":          path.Root("asn"),
+		"ttl":             path.Root("ttl"),
+		"keepalive_timer": path.Root("keepalive_time"),
+		"holdtime_timer":  path.Root("hold_time"),
+		"password":        path.Root("password"),
+	}
+}
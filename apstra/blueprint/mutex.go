@@ -0,0 +1,215 @@
+package blueprint
+
+import (
+	"context"
+	"time"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephemeralSchema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MutexStatus describes the state of a blueprint mutex, whoever holds it.
+type MutexStatus struct {
+	BlueprintId        types.String `tfsdk:"blueprint_id"`
+	Locked             types.Bool   `tfsdk:"locked"`
+	HeldByThisProvider types.Bool   `tfsdk:"held_by_this_provider"`
+	TagId              types.String `tfsdk:"tag_id"`
+	TagName            types.String `tfsdk:"tag_name"`
+	RawMessage         types.String `tfsdk:"raw_message"`
+	Message            types.String `tfsdk:"message"`
+	AcquiredAt         types.String `tfsdk:"acquired_at"`
+	TtlSeconds         types.Int64  `tfsdk:"ttl_seconds"`
+	ExpiresAt          types.String `tfsdk:"expires_at"`
+	Expired            types.Bool   `tfsdk:"expired"`
+}
+
+func (o MutexStatus) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"blueprint_id": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra Blueprint ID.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"locked": dataSourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether the Blueprint mutex is currently locked.",
+			Computed:            true,
+		},
+		"held_by_this_provider": dataSourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether the mutex is held by this instance of the Apstra provider, " +
+				"either because a resource has locked it in preparation for making changes, or because of an " +
+				"`apstra_blueprint_mutex` resource or ephemeral resource.",
+			Computed: true,
+		},
+		"tag_id": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "ID of the Global Catalog Tag which represents the mutex. Null when unlocked.",
+			Computed:            true,
+		},
+		"tag_name": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Name of the Global Catalog Tag which represents the mutex. Null when unlocked.",
+			Computed:            true,
+		},
+		"raw_message": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "The complete mutex message (the mutex Tag's description), including any lease " +
+				"information. Null when unlocked.",
+			Computed: true,
+		},
+		"message": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "The human-readable portion of the mutex message, as configured by the " +
+				"`blueprint_mutex_message` provider attribute of the client holding the lock. Null when unlocked.",
+			Computed: true,
+		},
+		"acquired_at": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "RFC3339 timestamp at which the mutex was locked, as recorded in the mutex lease. " +
+				"Null when the mutex has no lease.",
+			Computed: true,
+		},
+		"ttl_seconds": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Lease duration recorded in the mutex message. Null when the mutex has no lease.",
+			Computed:            true,
+		},
+		"expires_at": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "RFC3339 timestamp at which the mutex lease expires. Null when the mutex has no lease.",
+			Computed:            true,
+		},
+		"expired": dataSourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether the mutex lease has expired. Mutexes with expired leases are " +
+				"broken by any client waiting to lock the Blueprint.",
+			Computed: true,
+		},
+	}
+}
+
+// LoadApiData populates the status from the Tag which represents the mutex. A
+// nil tag indicates that the Blueprint is not locked.
+func (o *MutexStatus) LoadApiData(_ context.Context, in *apstra.DesignTag, _ *diag.Diagnostics) {
+	o.Locked = types.BoolValue(in != nil)
+	o.TagId = types.StringNull()
+	o.TagName = types.StringNull()
+	o.RawMessage = types.StringNull()
+	o.Message = types.StringNull()
+	o.AcquiredAt = types.StringNull()
+	o.TtlSeconds = types.Int64Null()
+	o.ExpiresAt = types.StringNull()
+	o.Expired = types.BoolValue(false)
+
+	if in == nil || in.Data == nil {
+		return
+	}
+
+	lease := utils.ParseMutexLease(in.Data.Description)
+
+	o.TagId = types.StringValue(in.Id.String())
+	o.TagName = types.StringValue(in.Data.Label)
+	o.RawMessage = types.StringValue(in.Data.Description)
+	o.Message = types.StringValue(lease.Message)
+
+	if expires, ok := lease.Expires(); ok {
+		o.AcquiredAt = types.StringValue(lease.Acquired.UTC().Format(time.RFC3339))
+		o.TtlSeconds = types.Int64Value(int64(lease.TTL / time.Second))
+		o.ExpiresAt = types.StringValue(expires.UTC().Format(time.RFC3339))
+		o.Expired = types.BoolValue(lease.Expired(time.Now()))
+	}
+}
+
+// Mutex is a blueprint mutex explicitly locked by the configuration.
+type Mutex struct {
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Message     types.String `tfsdk:"message"`
+	TagId       types.String `tfsdk:"tag_id"`
+	AcquiredAt  types.String `tfsdk:"acquired_at"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+func (o Mutex) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"blueprint_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra Blueprint ID.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
+		"message": resourceSchema.StringAttribute{
+			MarkdownDescription: "Human-readable mutex message. Environment variables are expanded as with the " +
+				"`blueprint_mutex_message` provider attribute, which is used when this attribute is omitted. Ignored, " +
+				"with a warning, when this provider instance already holds the mutex.",
+			Optional:      true,
+			Validators:    []validator.String{stringvalidator.LengthAtLeast(1)},
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
+		"tag_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "ID of the Global Catalog Tag which represents the mutex.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"acquired_at": resourceSchema.StringAttribute{
+			MarkdownDescription: "RFC3339 timestamp at which the mutex was locked, as recorded in the mutex lease. " +
+				"Null unless the `blueprint_mutex_ttl` provider attribute is set.",
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"expires_at": resourceSchema.StringAttribute{
+			MarkdownDescription: "RFC3339 timestamp at which the mutex lease expires. Null unless the " +
+				"`blueprint_mutex_ttl` provider attribute is set.",
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+	}
+}
+
+func (o Mutex) EphemeralAttributes() map[string]ephemeralSchema.Attribute {
+	return map[string]ephemeralSchema.Attribute{
+		"blueprint_id": ephemeralSchema.StringAttribute{
+			MarkdownDescription: "Apstra Blueprint ID.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"message": ephemeralSchema.StringAttribute{
+			MarkdownDescription: "Human-readable mutex message. Environment variables are expanded as with the " +
+				"`blueprint_mutex_message` provider attribute, which is used when this attribute is omitted. Ignored, " +
+				"with a warning, when this provider instance already holds the mutex.",
+			Optional:   true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"tag_id": ephemeralSchema.StringAttribute{
+			MarkdownDescription: "ID of the Global Catalog Tag which represents the mutex.",
+			Computed:            true,
+		},
+		"acquired_at": ephemeralSchema.StringAttribute{
+			MarkdownDescription: "RFC3339 timestamp at which the mutex was locked, as recorded in the mutex lease. " +
+				"Null unless the `blueprint_mutex_ttl` provider attribute is set.",
+			Computed: true,
+		},
+		"expires_at": ephemeralSchema.StringAttribute{
+			MarkdownDescription: "RFC3339 timestamp at which the mutex lease expires. Null unless the " +
+				"`blueprint_mutex_ttl` provider attribute is set.",
+			Computed: true,
+		},
+	}
+}
+
+// LoadApiData populates the computed attributes from the Tag which represents
+// the mutex.
+func (o *Mutex) LoadApiData(_ context.Context, in *apstra.DesignTag, _ *diag.Diagnostics) {
+	o.TagId = types.StringValue(in.Id.String())
+	o.AcquiredAt = types.StringNull()
+	o.ExpiresAt = types.StringNull()
+
+	if in.Data == nil {
+		return
+	}
+
+	lease := utils.ParseMutexLease(in.Data.Description)
+	if expires, ok := lease.Expires(); ok {
+		o.AcquiredAt = types.StringValue(lease.Acquired.UTC().Format(time.RFC3339))
+		o.ExpiresAt = types.StringValue(expires.UTC().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,79 @@
+package blueprint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutexStatusLoadApiData(t *testing.T) {
+	acquired := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	lease := utils.MutexLease{Message: "locked by jenkins", Acquired: acquired, TTL: time.Hour}
+
+	type testCase struct {
+		tag      *apstra.DesignTag
+		expected MutexStatus
+	}
+
+	testCases := map[string]testCase{
+		"unlocked": {
+			expected: MutexStatus{
+				Locked:     types.BoolValue(false),
+				TagId:      types.StringNull(),
+				TagName:    types.StringNull(),
+				RawMessage: types.StringNull(),
+				Message:    types.StringNull(),
+				AcquiredAt: types.StringNull(),
+				TtlSeconds: types.Int64Null(),
+				ExpiresAt:  types.StringNull(),
+				Expired:    types.BoolValue(false),
+			},
+		},
+		"locked_without_lease": {
+			tag: &apstra.DesignTag{Id: "tag1", Data: &apstra.DesignTagData{Label: "mutex_bp1", Description: "locked by terraform"}},
+			expected: MutexStatus{
+				Locked:     types.BoolValue(true),
+				TagId:      types.StringValue("tag1"),
+				TagName:    types.StringValue("mutex_bp1"),
+				RawMessage: types.StringValue("locked by terraform"),
+				Message:    types.StringValue("locked by terraform"),
+				AcquiredAt: types.StringNull(),
+				TtlSeconds: types.Int64Null(),
+				ExpiresAt:  types.StringNull(),
+				Expired:    types.BoolValue(false),
+			},
+		},
+		"locked_with_expired_lease": {
+			tag: &apstra.DesignTag{Id: "tag1", Data: &apstra.DesignTagData{Label: "mutex_bp1", Description: lease.String()}},
+			expected: MutexStatus{
+				Locked:     types.BoolValue(true),
+				TagId:      types.StringValue("tag1"),
+				TagName:    types.StringValue("mutex_bp1"),
+				RawMessage: types.StringValue(lease.String()),
+				Message:    types.StringValue("locked by jenkins"),
+				AcquiredAt: types.StringValue("2026-10-16T12:00:00Z"),
+				TtlSeconds: types.Int64Value(3600),
+				ExpiresAt:  types.StringValue("2026-10-16T13:00:00Z"),
+				Expired:    types.BoolValue(true),
+			},
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			var result MutexStatus
+			result.LoadApiData(context.Background(), tCase.tag, &diags)
+			require.False(t, diags.HasError())
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package blueprint
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
@@ -236,9 +238,90 @@ func (o *Rack) GetName(ctx context.Context, client *apstra.Client) (string, erro
 	return node.Label, nil
 }
 
+// GetImportDetails fetches the rack type ID and (in pod-based blueprints) the
+// pod ID associated with the rack node. It is used when importing a rack.
+func (o *Rack) GetImportDetails(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	// struct used to collect the rack node info
+	var node struct {
+		RackTypeJson json.RawMessage `json:"rack_type_json"`
+	}
+
+	// collect the rack node info
+	err := client.GetNode(ctx, apstra.ObjectId(o.BlueprintId.ValueString()), apstra.ObjectId(o.Id.ValueString()), &node)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to fetch rack %s", o.Id), err.Error())
+		return
+	}
+
+	// rack_type_json may be either a JSON object or a string containing one
+	rackTypeJson := []byte(node.RackTypeJson)
+	var s string
+	if json.Unmarshal(rackTypeJson, &s) == nil {
+		rackTypeJson = []byte(s)
+	}
+
+	var rackType struct {
+		Id string `json:"id"`
+	}
+	err = json.Unmarshal(rackTypeJson, &rackType)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to parse rack type info from rack %s", o.Id), err.Error())
+		return
+	}
+	if rackType.Id == "" {
+		diags.AddError("rack type not found", fmt.Sprintf("rack %s has no rack type ID", o.Id))
+		return
+	}
+	o.RackTypeId = types.StringValue(rackType.Id)
+
+	// determine the pod ID (if any)
+	var result struct {
+		Items []struct {
+			Pod struct {
+				Id string `json:"id"`
+			} `json:"n_pod"`
+		} `json:"items"`
+	}
+
+	query := new(apstra.PathQuery).
+		SetClient(client).
+		SetBlueprintId(apstra.ObjectId(o.BlueprintId.ValueString())).
+		SetBlueprintType(apstra.BlueprintTypeStaging).
+		Node([]apstra.QEEAttribute{
+			{Key: "type", Value: apstra.QEStringVal("rack")},
+			{Key: "id", Value: apstra.QEStringVal(o.Id.ValueString())},
+		}).
+		Out([]apstra.QEEAttribute{{Key: "type", Value: apstra.QEStringVal("part_of_pod")}}).
+		Node([]apstra.QEEAttribute{
+			{Key: "type", Value: apstra.QEStringVal("pod")},
+			{Key: "name", Value: apstra.QEStringVal("n_pod")},
+		})
+
+	err = query.Do(ctx, &result)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed querying for pod associated with rack %s", o.Id), err.Error())
+		return
+	}
+
+	switch len(result.Items) {
+	case 0:
+		o.PodId = types.StringNull() // 3-stage blueprint
+	case 1:
+		o.PodId = types.StringValue(result.Items[0].Pod.Id)
+	default:
+		diags.AddError("multiple pods found", fmt.Sprintf("rack %s is associated with %d pods", o.Id, len(result.Items)))
+	}
+}
+
 type RackElement struct {
 	Id       apstra.ObjectId `json:"id"`
 	Type     string          `json:"type,omitempty"`
 	Label    string          `json:"label"`
 	Hostname *string         `json:"hostname,omitempty"`
 }
+
+func (o Rack) DriftAttributePaths() DriftAttributePaths {
+	return DriftAttributePaths{
+		"label": path.Root("name"),
+	}
+}
@@ -0,0 +1,175 @@
+package blueprint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Revisions struct {
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Revisions   types.List   `tfsdk:"revisions"`
+}
+
+func (o Revisions) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"blueprint_id": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the Blueprint.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"revisions": dataSourceSchema.ListNestedAttribute{
+			MarkdownDescription: "Deployed revisions retained by Apstra, most recent first.",
+			Computed:            true,
+			NestedObject: dataSourceSchema.NestedAttributeObject{
+				Attributes: revision{}.dataSourceAttributes(),
+			},
+		},
+	}
+}
+
+func (o *Revisions) ReadFromApi(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	revisions, err := GetRevisions(ctx, client, apstra.ObjectId(o.BlueprintId.ValueString()))
+	if err != nil {
+		if utils.IsApstra404(err) {
+			diags.AddAttributeError(
+				path.Root("blueprint_id"),
+				"not found",
+				fmt.Sprintf("Blueprint %s not found", o.BlueprintId),
+			)
+			return
+		}
+		diags.AddError(fmt.Sprintf("unable to fetch Blueprint %s revisions from API", o.BlueprintId), err.Error())
+		return
+	}
+
+	tfRevisions := make([]revision, len(revisions))
+	for i, r := range revisions {
+		tfRevisions[i].loadApiData(ctx, &r, diags)
+	}
+	if diags.HasError() {
+		return
+	}
+
+	o.Revisions = value.ListOrNull(ctx, types.ObjectType{AttrTypes: revision{}.attrTypes()}, tfRevisions, diags)
+}
+
+type revision struct {
+	RevisionId  types.Int64  `tfsdk:"revision_id"`
+	Description types.String `tfsdk:"description"`
+	User        types.String `tfsdk:"user"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (o revision) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"revision_id": types.Int64Type,
+		"description": types.StringType,
+		"user":        types.StringType,
+		"created_at":  types.StringType,
+	}
+}
+
+func (o revision) dataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"revision_id": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Revision number. This is the value reported as `revision_active` by the " +
+				"`apstra_blueprint_deployment` resource and data source.",
+			Computed: true,
+		},
+		"description": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Comment supplied when the revision was deployed.",
+			Computed:            true,
+		},
+		"user": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra user who deployed the revision.",
+			Computed:            true,
+		},
+		"created_at": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Time at which the revision was deployed.",
+			Computed:            true,
+		},
+	}
+}
+
+func (o *revision) loadApiData(ctx context.Context, in *Revision, diags *diag.Diagnostics) {
+	o.RevisionId = types.Int64Value(in.RevisionId)
+	o.Description = value.StringOrNull(ctx, in.Description, diags)
+	o.User = value.StringOrNull(ctx, in.User, diags)
+	o.CreatedAt = value.StringOrNull(ctx, in.CreatedAt, diags)
+}
+
+// Revision is a deployed blueprint revision retained by Apstra.
+type Revision struct {
+	RevisionId  int64
+	Description string
+	User        string
+	CreatedAt   string
+}
+
+func (o *Revision) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		RevisionId  json.RawMessage `json:"revision_id"`
+		Description string          `json:"description"`
+		User        string          `json:"user"`
+		CreatedAt   string          `json:"created_at"`
+	}
+	err := json.Unmarshal(b, &raw)
+	if err != nil {
+		return err
+	}
+
+	// revision_id may be rendered as either a number or a string
+	id := string(bytes.Trim(raw.RevisionId, `"`))
+	o.RevisionId, err = strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("cannot parse revision_id %s: %w", raw.RevisionId, err)
+	}
+
+	o.Description = raw.Description
+	o.User = raw.User
+	o.CreatedAt = raw.CreatedAt
+	return nil
+}
+
+// GetRevisions returns the deployed revisions of the specified blueprint
+// retained by Apstra, most recent first. The SDK does not cover blueprint
+// revisions, so the API is called directly. The response format is that of
+// Apstra 6.1.0 (see compatibility.BlueprintRevisionsOK).
+func GetRevisions(ctx context.Context, client *apstra.Client, bpId apstra.ObjectId) ([]Revision, error) {
+	u, err := url.Parse(fmt.Sprintf("/api/blueprints/%s/revisions", url.PathEscape(bpId.String())))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse blueprint revisions URL: %w", err)
+	}
+
+	var response struct {
+		Items []Revision `json:"items"`
+	}
+
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodGet, Url: u}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(response.Items, func(i, j int) bool {
+		return response.Items[i].RevisionId > response.Items[j].RevisionId
+	})
+
+	return response.Items, nil
+}
@@ -0,0 +1,51 @@
+package blueprint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevisionUnmarshalJSON(t *testing.T) {
+	type testCase struct {
+		in       string
+		expected Revision
+		expErr   bool
+	}
+
+	testCases := map[string]testCase{
+		"numeric_id": {
+			in:       `{"revision_id": 7, "description": "first", "user": "admin", "created_at": "2026-10-16T10:00:00.000000Z"}`,
+			expected: Revision{RevisionId: 7, Description: "first", User: "admin", CreatedAt: "2026-10-16T10:00:00.000000Z"},
+		},
+		"string_id": {
+			in:       `{"revision_id": "12", "description": "second"}`,
+			expected: Revision{RevisionId: 12, Description: "second"},
+		},
+		"bogus_id": {
+			in:     `{"revision_id": "twelve"}`,
+			expErr: true,
+		},
+		"missing_id": {
+			in:     `{"description": "third"}`,
+			expErr: true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			var result Revision
+			err := json.Unmarshal([]byte(tCase.in), &result)
+			if tCase.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
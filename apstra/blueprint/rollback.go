@@ -0,0 +1,194 @@
+package blueprint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Rollback struct {
+	BlueprintId    types.String `tfsdk:"blueprint_id"`
+	RevisionId     types.Int64  `tfsdk:"revision_id"`
+	Deploy         types.Bool   `tfsdk:"deploy"`
+	Comment        types.String `tfsdk:"comment"`
+	ActiveRevision types.Int64  `tfsdk:"revision_active"`
+	StagedRevision types.Int64  `tfsdk:"revision_staged"`
+	DeployError    types.String `tfsdk:"deploy_error"`
+	Superseded     types.Bool   `tfsdk:"superseded"`
+}
+
+func (o Rollback) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"blueprint_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the Blueprint.",
+			Required:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"revision_id": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Previously deployed revision to which the staging Blueprint should be rolled " +
+				"back. This is a `revision_active` value reported by `apstra_blueprint_deployment`. Available " +
+				"revisions are listed by the `apstra_blueprint_revisions` data source. Changing this value " +
+				"performs a new rollback.",
+			Required:      true,
+			PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			Validators:    []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"deploy": resourceSchema.BoolAttribute{
+			MarkdownDescription: "When `true`, the rolled-back staging Blueprint is deployed immediately. When " +
+				"`false` (the default), the rollback is staged and must be deployed separately, for example " +
+				"with `apstra_blueprint_deployment`.",
+			Optional:      true,
+			Computed:      true,
+			Default:       booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+		},
+		"comment": resourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Comment associated with the deployment when `deploy` is `true`. "+
+				"Supports the same templating as the `comment` attribute of `apstra_blueprint_deployment` "+
+				"(currently supported replacements: [`%s`]).",
+				strings.Join(CommentTemplateReplacements, "`, `")),
+			Optional:      true,
+			Computed:      true,
+			Default:       stringdefault.StaticString("Rollback by Terraform {{.TerraformVersion}}, Apstra provider {{.ProviderVersion}}, User $USER."),
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:    []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"revision_active": resourceSchema.Int64Attribute{
+			MarkdownDescription: "The deployed revision number following the rollback.",
+			Computed:            true,
+		},
+		"revision_staged": resourceSchema.Int64Attribute{
+			MarkdownDescription: "The staging revision number following the rollback.",
+			Computed:            true,
+		},
+		"deploy_error": resourceSchema.StringAttribute{
+			MarkdownDescription: "When `deploy` is `true` and the rolled-back Blueprint could not be deployed, " +
+				"the reason deployment failed. The rollback remains staged and is saved to the Terraform state " +
+				"with this value, but the resource is tainted so that the next apply repeats the rollback and " +
+				"deployment. Null when deployment succeeded or was not requested.",
+			Computed: true,
+		},
+		"superseded": resourceSchema.BoolAttribute{
+			MarkdownDescription: "True when the currently deployed revision of the Blueprint differs from " +
+				"`revision_active`, meaning that the Blueprint has been deployed since the rollback was " +
+				"performed. The rollback is not repeated automatically; use `terraform apply -replace` to " +
+				"roll back again.",
+			Computed: true,
+		},
+	}
+}
+
+// Rollback reverts the staging blueprint to the specified previously deployed
+// revision and, if requested, deploys the result. The returned boolean is true
+// when the staging blueprint has been rolled back, in which case the caller
+// should save the resulting state even if diags contains errors from the
+// deployment.
+func (o *Rollback) Rollback(ctx context.Context, commentTemplate *CommentTemplate, client *apstra.Client, diags *diag.Diagnostics) bool {
+	bpId := apstra.ObjectId(o.BlueprintId.ValueString())
+
+	// ensure the requested revision is one Apstra still retains
+	revisions, err := GetRevisions(ctx, client, bpId)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("unable to fetch Blueprint %s revisions from API", bpId), err.Error())
+		return false
+	}
+
+	available := make([]string, len(revisions))
+	var found bool
+	for i, r := range revisions {
+		available[i] = strconv.FormatInt(r.RevisionId, 10)
+		if r.RevisionId == o.RevisionId.ValueInt64() {
+			found = true
+		}
+	}
+	if !found {
+		diags.AddAttributeError(
+			path.Root("revision_id"),
+			"revision not found",
+			fmt.Sprintf("Blueprint %s has no retained revision %d; available revisions: [%s]",
+				bpId, o.RevisionId.ValueInt64(), strings.Join(available, ", ")),
+		)
+		return false
+	}
+
+	// The SDK does not cover rollback, so the API is called directly. The
+	// request follows Apstra 6.1.0 (see compatibility.BlueprintRevisionsOK).
+	u, err := url.Parse(fmt.Sprintf("/api/blueprints/%s/revisions/%d/rollback", url.PathEscape(bpId.String()), o.RevisionId.ValueInt64()))
+	if err != nil {
+		diags.AddError("cannot parse blueprint rollback URL", err.Error())
+		return false
+	}
+
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPost, Url: u}, nil)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed rolling back Blueprint %s to revision %d", bpId, o.RevisionId.ValueInt64()), err.Error())
+		return false
+	}
+
+	o.DeployError = types.StringNull()
+	o.Superseded = types.BoolValue(false)
+
+	deploy := Deploy{
+		BlueprintId: o.BlueprintId,
+		Comment:     o.Comment,
+	}
+
+	if o.Deploy.ValueBool() {
+		var deployDiags diag.Diagnostics
+		deploy.Deploy(ctx, commentTemplate, client, &deployDiags)
+		diags.Append(deployDiags...)
+		if deployDiags.HasError() {
+			errs := make([]string, len(deployDiags.Errors()))
+			for i, d := range deployDiags.Errors() {
+				errs[i] = d.Summary() + ": " + d.Detail()
+			}
+			o.DeployError = types.StringValue(strings.Join(errs, "; "))
+		}
+	}
+
+	// Read() overwrites the comment with the one from the API; that's fine
+	// because we don't use it.
+	var readDiags diag.Diagnostics
+	deploy.Read(ctx, client, &readDiags)
+	diags.Append(readDiags...)
+	if readDiags.HasError() {
+		o.ActiveRevision = types.Int64Null()
+		o.StagedRevision = types.Int64Null()
+		return true
+	}
+
+	o.ActiveRevision = deploy.ActiveRevision
+	o.StagedRevision = deploy.StagedRevision
+	return true
+}
+
+// Read compares the currently deployed revision of the blueprint with the one
+// recorded following the rollback.
+func (o *Rollback) Read(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	deploy := Deploy{BlueprintId: o.BlueprintId}
+	deploy.Read(ctx, client, diags)
+	if diags.HasError() {
+		return
+	}
+
+	o.Superseded = types.BoolValue(!deploy.ActiveRevision.Equal(o.ActiveRevision))
+}
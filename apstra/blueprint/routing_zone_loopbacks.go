@@ -196,3 +196,36 @@ func (o *RoutingZoneLoopbacks) LoadApiData(ctx context.Context, info *apstra.Two
 
 	o.Loopbacks = value.MapOrNull(ctx, types.ObjectType{AttrTypes: RoutingZoneLoopback{}.AttrTypes()}, loopbacks, diags)
 }
+
+// ImportPrivate is used when importing an existing Routing Zone's loopback
+// addresses. It populates LoopbackIds from the API response and returns a
+// private state record which claims every loopback address currently assigned
+// within the Routing Zone, so that Read() will collect all of them.
+func (o *RoutingZoneLoopbacks) ImportPrivate(ctx context.Context, info *apstra.TwoStageL3ClosSecurityZoneInfo, diags *diag.Diagnostics) *private.ResourceDatacenterRoutingZoneLoopbackAddresses {
+	loopbackIds := make(map[string]string)
+	result := make(private.ResourceDatacenterRoutingZoneLoopbackAddresses)
+	for _, memberInterface := range info.MemberInterfaces {
+		if len(memberInterface.Loopbacks) != 1 {
+			continue // LoadApiData() will complain about unexpected loopback counts
+		}
+
+		sysId := memberInterface.HostingSystem.Id.String()
+		loopback := memberInterface.Loopbacks[0]
+		loopbackIds[sysId] = string(loopback.Id)
+
+		if loopback.Ipv4Addr == nil && loopback.Ipv6Addr == nil {
+			continue
+		}
+
+		var p struct {
+			HasIpv4 bool `json:"has_ipv4"`
+			HasIpv6 bool `json:"has_ipv6"`
+		}
+		p.HasIpv4 = loopback.Ipv4Addr != nil
+		p.HasIpv6 = loopback.Ipv6Addr != nil
+		result[sysId] = p
+	}
+	o.LoopbackIds = value.MapOrNull(ctx, types.StringType, loopbackIds, diags)
+
+	return &result
+}
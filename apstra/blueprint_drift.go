@@ -0,0 +1,134 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/Juniper/terraform-provider-apstra/apstra/private"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// blueprintDriftCheckFunc adds a warning to diags when the specified graph
+// node differs between the staging and active copies of the specified
+// blueprint, unless the staging node is exactly as recorded in ps by
+// blueprintDriftBaselineFunc (that is, the difference is Terraform's own
+// undeployed change). paths translates the names of changed graph attributes
+// into the resource's attribute paths for the warning. It does nothing unless
+// drift warnings are enabled in the provider configuration.
+type blueprintDriftCheckFunc func(ctx context.Context, blueprintId, nodeId string, paths blueprint.DriftAttributePaths, ps private.State, diags *diag.Diagnostics)
+
+// blueprintDriftBaselineFunc records in ps the current state of the specified
+// graph node in the staging blueprint. Resources which use
+// blueprintDriftCheckFunc in Read() should invoke it after writing the node in
+// Create() and Update(). It does nothing unless drift warnings are enabled in
+// the provider configuration.
+type blueprintDriftBaselineFunc func(ctx context.Context, blueprintId, nodeId string, ps private.State, diags *diag.Diagnostics)
+
+// blueprintDriftChecker implements blueprintDriftCheckFunc and
+// blueprintDriftBaselineFunc according to the
+// provider's blueprint_drift_warnings configuration. The (expensive)
+// comparison of staging and active graphs is made once per blueprint and
+// shared by every resource.
+type blueprintDriftChecker struct {
+	client  *apstra.Client
+	enabled bool
+
+	mutex sync.Mutex
+	cache map[string]*blueprintDrift // keyed by blueprint ID
+}
+
+type blueprintDrift struct {
+	nodes map[string]blueprint.NodeDrift // keyed by node ID
+	err   error
+}
+
+func (o *blueprintDriftChecker) check(ctx context.Context, bpId, nodeId string, paths blueprint.DriftAttributePaths, ps private.State, diags *diag.Diagnostics) {
+	if !o.enabled {
+		return
+	}
+
+	var baseline private.ResourceDriftBaseline
+	baseline.LoadPrivateState(ctx, ps, diags)
+	if diags.HasError() {
+		return
+	}
+
+	drift, isNew := o.get(ctx, bpId)
+	if drift.err != nil {
+		if isNew { // warn only once per blueprint
+			diags.AddWarning(
+				fmt.Sprintf("unable to check Blueprint %s for drift", bpId),
+				fmt.Sprintf("Comparing the staging and active Blueprints failed, so resources in this "+
+					"Blueprint will not be checked for changes made outside of Terraform: %s", drift.err))
+		}
+		return
+	}
+
+	d, ok := drift.nodes[nodeId]
+	if !ok {
+		return
+	}
+
+	if baseline.Fingerprint != "" && baseline.Fingerprint == d.Fingerprint {
+		return // the node is as Terraform left it
+	}
+
+	diags.AddWarning(
+		fmt.Sprintf("Blueprint %s node %s has undeployed changes", bpId, nodeId),
+		fmt.Sprintf("The %s. The node has been changed since it was last written by Terraform. These "+
+			"changes will be deployed along with the next commit.", d.Summary(paths)))
+}
+
+func (o *blueprintDriftChecker) baseline(ctx context.Context, bpId, nodeId string, ps private.State, diags *diag.Diagnostics) {
+	if !o.enabled {
+		return
+	}
+
+	// the blueprint has changed, so any drift report we have is stale
+	o.mutex.Lock()
+	delete(o.cache, bpId)
+	o.mutex.Unlock()
+
+	fingerprint, err := blueprint.GetNodeFingerprint(ctx, o.client, apstra.ObjectId(bpId), nodeId)
+	if err != nil {
+		diags.AddWarning(
+			fmt.Sprintf("unable to record Blueprint %s node %s for drift checks", bpId, nodeId),
+			fmt.Sprintf("Terraform's own undeployed changes to this node may be reported as drift: %s", err))
+		return
+	}
+
+	private.ResourceDriftBaseline{Fingerprint: fingerprint}.SetPrivateState(ctx, ps, diags)
+}
+
+// get returns the drift report for the specified blueprint, fetching it if
+// necessary. The boolean return value indicates that the report was fetched
+// by this call.
+func (o *blueprintDriftChecker) get(ctx context.Context, bpId string) (*blueprintDrift, bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if drift, ok := o.cache[bpId]; ok {
+		return drift, false
+	}
+
+	drift := new(blueprintDrift)
+	nodes, err := blueprint.GetDrift(ctx, o.client, apstra.ObjectId(bpId))
+	if err != nil {
+		drift.err = err
+	} else {
+		drift.nodes = make(map[string]blueprint.NodeDrift, len(nodes))
+		for _, node := range nodes {
+			drift.nodes[node.Id] = node
+		}
+	}
+
+	if o.cache == nil {
+		o.cache = make(map[string]*blueprintDrift)
+	}
+	o.cache[bpId] = drift
+
+	return drift, true
+}
@@ -0,0 +1,89 @@
+package tfapstra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/Juniper/terraform-provider-apstra/apstra/private"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+)
+
+// testPrivateState implements private.State
+type testPrivateState map[string][]byte
+
+func (o testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return o[key], nil
+}
+
+func (o testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	o[key] = value
+	return nil
+}
+
+func TestBlueprintDriftCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	checker := blueprintDriftChecker{
+		enabled: true,
+		cache: map[string]*blueprintDrift{
+			"bp": {
+				nodes: map[string]blueprint.NodeDrift{
+					"vn": {Id: "vn", Type: "virtual_network", Status: blueprint.NodeDriftStatusChanged, Attributes: []string{"label"}, Fingerprint: "abc"},
+				},
+			},
+		},
+	}
+
+	type testCase struct {
+		nodeId     string
+		baseline   string
+		disabled   bool
+		expectWarn bool
+	}
+
+	testCases := map[string]testCase{
+		"no_drift": {
+			nodeId: "rz",
+		},
+		"no_baseline": {
+			nodeId:     "vn",
+			expectWarn: true,
+		},
+		"changed_by_terraform": {
+			nodeId:   "vn",
+			baseline: "abc",
+		},
+		"changed_since_terraform": {
+			nodeId:     "vn",
+			baseline:   "def",
+			expectWarn: true,
+		},
+		"disabled": {
+			nodeId:   "vn",
+			disabled: true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			ps := make(testPrivateState)
+			if tCase.baseline != "" {
+				var diags diag.Diagnostics
+				private.ResourceDriftBaseline{Fingerprint: tCase.baseline}.SetPrivateState(ctx, ps, &diags)
+				require.False(t, diags.HasError())
+			}
+
+			c := &checker
+			if tCase.disabled {
+				c = &blueprintDriftChecker{cache: checker.cache}
+			}
+
+			var diags diag.Diagnostics
+			c.check(ctx, "bp", tCase.nodeId, nil, ps, &diags)
+			require.False(t, diags.HasError())
+			require.Equal(t, tCase.expectWarn, diags.WarningsCount() > 0)
+		})
+	}
+}
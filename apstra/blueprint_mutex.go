@@ -0,0 +1,284 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const blueprintMutexPollInterval = 2 * time.Second
+
+// blueprintMutexLocker locks blueprint mutexes according to the provider's
+// blueprint_mutex_* configuration.
+type blueprintMutexLocker struct {
+	client  *apstra.Client
+	message string        // mutex message prior to environment variable expansion
+	timeout time.Duration // zero means wait forever
+	ttl     time.Duration // zero means our mutexes never expire
+}
+
+// lock locks the mutex, polling until it becomes available. Mutexes held by
+// other clients are broken (with a warning) when their lease has expired. The
+// returned string is the message of the mutex tag created by this call. It
+// distinguishes our tag from any other tag which mentions the blueprint ID.
+func (o blueprintMutexLocker) lock(ctx context.Context, bpId string, mutex apstra.Mutex, diags *diag.Diagnostics) (string, error) {
+	var deadline time.Time
+	if o.timeout > 0 {
+		deadline = time.Now().Add(o.timeout)
+	}
+
+	for {
+		// Shove the date into the environment so it's available to ExpandEnv.
+		// This should probably be in a text/template configuration.
+		now := time.Now()
+		err := os.Setenv("DATE", now.UTC().String())
+		if err != nil {
+			return "", fmt.Errorf("error setting the 'DATE' environment variable - %w", err)
+		}
+
+		// Set the mutex message, including our lease, if any.
+		lease := utils.MutexLease{Message: os.ExpandEnv(o.message), Acquired: now, TTL: o.ttl}
+		err = mutex.SetMessage(lease.String())
+		if err != nil {
+			return "", fmt.Errorf("error setting mutex message - %w", err)
+		}
+
+		ok, holderId, err := mutex.TryLock(ctx)
+		if err != nil {
+			return "", fmt.Errorf("error locking blueprint mutex - %w", err)
+		}
+		if ok {
+			return lease.String(), nil
+		}
+
+		// Somebody else holds the mutex. Find out who.
+		holder, err := o.client.GetTag(ctx, holderId)
+		if err != nil {
+			if utils.IsApstra404(err) {
+				continue // the mutex was unlocked while we weren't looking
+			}
+			return "", fmt.Errorf("error reading blueprint %q mutex tag %q - %w", bpId, holderId, err)
+		}
+		holderLease := utils.ParseMutexLease(holder.Data.Description)
+
+		if holderLease.Expired(time.Now()) {
+			err = o.client.DeleteTag(ctx, holderId)
+			if err != nil && !utils.IsApstra404(err) {
+				return "", fmt.Errorf("error breaking stale blueprint %q mutex tag %q - %w", bpId, holderId, err)
+			}
+
+			expires, _ := holderLease.Expires()
+			diags.AddWarning(
+				fmt.Sprintf("Stale blueprint %q mutex broken", bpId),
+				fmt.Sprintf("Blueprint %q was locked by mutex tag %q, whose lease expired at %s. The mutex "+
+					"was deleted so that this run could proceed. The previous holder left this message: %q",
+					bpId, holderId, expires.UTC().Format(time.RFC3339), holderLease.Message),
+			)
+			continue
+		}
+
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return "", fmt.Errorf("timed out after %s waiting for blueprint %q mutex tag %q, which is "+
+				"held with message: %q", o.timeout, bpId, holderId, holder.Data.Description)
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("context canceled while waiting for blueprint %q mutex tag %q, which is "+
+				"held with message: %q - %w", bpId, holderId, holder.Data.Description, ctx.Err())
+		case <-time.After(blueprintMutexPollInterval):
+		}
+	}
+}
+
+// lockAndHold locks the blueprint mutex, unless this provider instance already
+// holds it, and returns the Tag which represents the mutex. When the provider
+// is configured to use blueprint mutexes, the mutex is recorded among those
+// held by the provider so that resources modifying the blueprint don't wait
+// for it. message, when not empty, overrides the configured mutex message. A
+// mutex which is already held keeps its original message, so a warning is
+// produced when message cannot be applied.
+func (o blueprintMutexLocker) lockAndHold(ctx context.Context, bpId, message string, diags *diag.Diagnostics) (*apstra.DesignTag, error) {
+	blueprintMutexesMutex.Lock()
+	defer blueprintMutexesMutex.Unlock()
+
+	tagMessage := blueprintMutexMessages[bpId]
+	if _, held := blueprintMutexes[bpId]; held {
+		if message != "" {
+			diags.AddWarning(
+				fmt.Sprintf("Blueprint %q mutex message not applied", bpId),
+				fmt.Sprintf("The blueprint %q mutex was already held by this provider, so the requested message "+
+					"%q was not applied. The mutex message is %q.", bpId, message, tagMessage),
+			)
+		}
+	} else {
+		bpClient, err := o.client.NewTwoStageL3ClosClient(ctx, apstra.ObjectId(bpId))
+		if err != nil {
+			return nil, fmt.Errorf("error creating blueprint client while attempting to lock blueprint mutex - %w", err)
+		}
+
+		if message != "" {
+			o.message = message
+		}
+
+		tagMessage, err = o.lock(ctx, bpId, bpClient.Mutex, diags)
+		if err != nil {
+			return nil, err
+		}
+
+		if blueprintMutexes != nil {
+			blueprintMutexes[bpId] = bpClient.Mutex
+			blueprintMutexMessages[bpId] = tagMessage
+		}
+	}
+
+	tag, err := findBlueprintMutexTag(ctx, o.client, bpId, tagMessage)
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil {
+		return nil, fmt.Errorf("blueprint %q mutex was locked, but its tag could not be found", bpId)
+	}
+
+	return tag, nil
+}
+
+// adopt records the mutex represented by tag among those held by this
+// provider instance, unless the provider is not configured to use blueprint
+// mutexes or already holds this one. It is used when an apstra_blueprint_mutex
+// resource locked by an earlier run is refreshed, so that resources modifying
+// the blueprint in this run treat the mutex as their own rather than waiting
+// for it.
+func (o blueprintMutexLocker) adopt(ctx context.Context, bpId string, tag *apstra.DesignTag) error {
+	blueprintMutexesMutex.Lock()
+	defer blueprintMutexesMutex.Unlock()
+
+	if blueprintMutexes == nil {
+		return nil
+	}
+	if _, held := blueprintMutexes[bpId]; held {
+		return nil
+	}
+
+	bpClient, err := o.client.NewTwoStageL3ClosClient(ctx, apstra.ObjectId(bpId))
+	if err != nil {
+		return fmt.Errorf("error creating blueprint client while attempting to adopt blueprint mutex - %w", err)
+	}
+
+	blueprintMutexes[bpId] = adoptedBlueprintMutex{Mutex: bpClient.Mutex, client: o.client, tagId: tag.Id}
+	blueprintMutexMessages[bpId] = tag.Data.Description
+
+	return nil
+}
+
+// adoptedBlueprintMutex is a mutex locked by an earlier run of the provider.
+// The SDK's Mutex only knows how to unlock mutexes it locked itself, so Unlock
+// deletes the tag directly.
+type adoptedBlueprintMutex struct {
+	apstra.Mutex
+	client *apstra.Client
+	tagId  apstra.ObjectId
+}
+
+func (o adoptedBlueprintMutex) Unlock(ctx context.Context) error {
+	err := o.client.DeleteTag(ctx, o.tagId)
+	if err != nil && !utils.IsApstra404(err) {
+		return err
+	}
+	return nil
+}
+
+// release unlocks the blueprint mutex represented by the specified tag and
+// removes it from the set of mutexes held by the provider. A mutex which has
+// already been unlocked (by a blueprint deployment, for example) is not an
+// error.
+func (o blueprintMutexLocker) release(ctx context.Context, bpId, tagId string) error {
+	blueprintMutexesMutex.Lock()
+	defer blueprintMutexesMutex.Unlock()
+
+	delete(blueprintMutexes, bpId)
+	delete(blueprintMutexMessages, bpId)
+
+	err := o.client.DeleteTag(ctx, apstra.ObjectId(tagId))
+	if err != nil && !utils.IsApstra404(err) {
+		return fmt.Errorf("error unlocking blueprint %q mutex tag %q - %w", bpId, tagId, err)
+	}
+
+	return nil
+}
+
+// blueprintMutexHeld returns true when this provider instance holds the mutex
+// for the specified blueprint.
+func blueprintMutexHeld(bpId string) bool {
+	blueprintMutexesMutex.Lock()
+	defer blueprintMutexesMutex.Unlock()
+
+	_, ok := blueprintMutexes[bpId]
+	return ok
+}
+
+// heldBlueprintMutexMessage returns the message of the mutex tag created when
+// this provider instance locked the specified blueprint's mutex. The boolean
+// return value is false when the mutex is not held by this provider instance.
+func heldBlueprintMutexMessage(bpId string) (string, bool) {
+	blueprintMutexesMutex.Lock()
+	defer blueprintMutexesMutex.Unlock()
+
+	if _, ok := blueprintMutexes[bpId]; !ok {
+		return "", false
+	}
+
+	message, ok := blueprintMutexMessages[bpId]
+	return message, ok
+}
+
+// findBlueprintMutexTag returns the Global Catalog Tag which represents the
+// specified blueprint's mutex, or nil if the blueprint is not locked. See
+// selectBlueprintMutexTag for the meaning of message.
+func findBlueprintMutexTag(ctx context.Context, client *apstra.Client, bpId, message string) (*apstra.DesignTag, error) {
+	tags, err := client.GetAllTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving tags while searching for blueprint %q mutex - %w", bpId, err)
+	}
+
+	return selectBlueprintMutexTag(tags, bpId, message)
+}
+
+// selectBlueprintMutexTag picks the tag which represents the specified
+// blueprint's mutex from tags, returning nil if there is none. The mutex
+// locking scheme embeds the blueprint ID in the tag name, but other tags may
+// mention the blueprint ID as well. When message is not empty, only the tag
+// with exactly that message (as returned by lock()) is considered. Otherwise,
+// an error is returned when more than one tag mentions the blueprint ID.
+func selectBlueprintMutexTag(tags []apstra.DesignTag, bpId, message string) (*apstra.DesignTag, error) {
+	var result []apstra.DesignTag
+	for _, tag := range tags {
+		if tag.Data == nil || !strings.Contains(tag.Data.Label, bpId) {
+			continue
+		}
+		if message != "" && tag.Data.Description != message {
+			continue
+		}
+		result = append(result, tag)
+	}
+
+	switch len(result) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &result[0], nil
+	}
+
+	ids := make([]string, len(result))
+	for i, tag := range result {
+		ids[i] = tag.Id.String()
+	}
+
+	return nil, fmt.Errorf("found %d tags which may represent the blueprint %q mutex: %s", len(result), bpId, strings.Join(ids, ", "))
+}
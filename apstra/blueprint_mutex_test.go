@@ -0,0 +1,79 @@
+package tfapstra
+
+import (
+	"testing"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectBlueprintMutexTag(t *testing.T) {
+	tag := func(id, label, description string) apstra.DesignTag {
+		return apstra.DesignTag{Id: apstra.ObjectId(id), Data: &apstra.DesignTagData{Label: label, Description: description}}
+	}
+
+	tags := []apstra.DesignTag{
+		tag("unrelated", "production", "not a mutex"),
+		tag("mutex", "mutex_bp1", "locked by terraform at 2026-10-16 12:00:00 +0000 UTC"),
+		tag("imposter", "notes about bp1", "not a mutex"),
+		tag("other_bp", "mutex_bp2", "locked by terraform at 2026-10-16 12:00:00 +0000 UTC"),
+		{Id: "no_data"},
+	}
+
+	type testCase struct {
+		tags       []apstra.DesignTag
+		bpId       string
+		message    string
+		expectedId string
+		expectErr  bool
+	}
+
+	testCases := map[string]testCase{
+		"message_selects_mutex_tag": {
+			tags:       tags,
+			bpId:       "bp1",
+			message:    "locked by terraform at 2026-10-16 12:00:00 +0000 UTC",
+			expectedId: "mutex",
+		},
+		"message_mismatch": {
+			tags:    tags,
+			bpId:    "bp1",
+			message: "locked by jenkins",
+		},
+		"no_message_ambiguous": {
+			tags:      tags,
+			bpId:      "bp1",
+			expectErr: true,
+		},
+		"no_message_unique": {
+			tags:       tags,
+			bpId:       "bp2",
+			expectedId: "other_bp",
+		},
+		"unlocked": {
+			tags: tags,
+			bpId: "bp3",
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := selectBlueprintMutexTag(tCase.tags, tCase.bpId, tCase.message)
+			if tCase.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			if tCase.expectedId == "" {
+				require.Nil(t, result)
+				return
+			}
+
+			require.NotNil(t, result)
+			require.Equal(t, tCase.expectedId, result.Id.String())
+		})
+	}
+}
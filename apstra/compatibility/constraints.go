@@ -6,19 +6,29 @@ import (
 )
 
 var (
+	AaaProvidersOK                              = versionconstraints.New(apiversions.GeApstra610)
+	AaaUsersAndRolesOK                          = versionconstraints.New(apiversions.GeApstra610)
+	AgentCredentialsUpdateOK                    = versionconstraints.New(apiversions.GeApstra610)
+	AgentHeartbeatOK                            = versionconstraints.New(apiversions.GeApstra610)
+	AgentJobLogOK                               = versionconstraints.New(apiversions.GeApstra610)
 	ApiNotSupportsSetLoopbackIps                = versionconstraints.New(apiversions.LtApstra500)
 	BPDefaultRoutingZoneAddressingOK            = versionconstraints.New(apiversions.GeApstra610)
 	BpIbaDashboardOk                            = versionconstraints.New(apiversions.LtApstra500)
 	BpIbaProbeOk                                = versionconstraints.New(apiversions.LtApstra500)
 	BpIbaWidgetOk                               = versionconstraints.New(apiversions.LtApstra500)
 	BlueprintIPv6ApplicationsOK                 = versionconstraints.New(apiversions.LtApstra610)
+	BlueprintDriftOK                            = versionconstraints.New(apiversions.GeApstra610)
+	BlueprintQueryGraphsOK                      = versionconstraints.New(apiversions.GeApstra610)
+	BlueprintRevisionsOK                        = versionconstraints.New(apiversions.GeApstra610)
 	ChangeVnRzIdForbidden                       = versionconstraints.New(apiversions.LeApstra422)
 	DatacenterCTPrimitiveVNSingleOverrideVLANOK = versionconstraints.New(apiversions.GeApstra620)
 	DatacenterPolicyAddressFamilyForbidden      = versionconstraints.New(apiversions.LtApstra620)
 	DatacenterPolicyAddressFamilyNotRequired    = versionconstraints.New(apiversions.LtApstra620)
 	DatacenterPolicyAddressFamilyOK             = versionconstraints.New(apiversions.GeApstra620)
 	DatacenterPolicyAddressFamilyRequired       = versionconstraints.New(apiversions.GeApstra620)
+	DeviceOsImagesOK                            = versionconstraints.New(apiversions.GeApstra610)
 	FabricSettingsSetInCreate                   = versionconstraints.New(apiversions.GeApstra421)
+	PlatformSettingsOK                          = versionconstraints.New(apiversions.GeApstra610)
 	PolicyNodesUseTagAttribute                  = versionconstraints.New(apiversions.LtApstra620)
 	RoutingPolicyExportL3EdgeServerOK           = versionconstraints.New(apiversions.LeApstra422)
 	RoutingZoneTagsOK                           = versionconstraints.New(apiversions.GeApstra500)
@@ -0,0 +1,28 @@
+package compatibility
+
+import (
+	"fmt"
+
+	versionconstraints "github.com/chrismarget-j/version-constraints"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// RequireApiVersion adds an error to diags unless apiVersion satisfies
+// constraints. It is used to gate features which call the Apstra API directly,
+// rather than through the SDK, on the releases their payloads were written
+// for.
+func RequireApiVersion(apiVersion string, constraints versionconstraints.Constraints, diags *diag.Diagnostics) {
+	v, err := version.NewVersion(apiVersion)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("cannot parse API version %q", apiVersion), err.Error())
+		return
+	}
+
+	if !constraints.Check(v) {
+		diags.AddError(
+			"Incompatible API version",
+			fmt.Sprintf("Requires Apstra %s, found Apstra %s", constraints, apiVersion),
+		)
+	}
+}
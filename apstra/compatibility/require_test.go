@@ -0,0 +1,36 @@
+package compatibility
+
+import (
+	"testing"
+
+	apiversions "github.com/Juniper/terraform-provider-apstra/apstra/api_versions"
+	versionconstraints "github.com/chrismarget-j/version-constraints"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireApiVersion(t *testing.T) {
+	constraints := versionconstraints.New(apiversions.GeApstra610)
+
+	type testCase struct {
+		apiVersion string
+		expErr     bool
+	}
+
+	testCases := map[string]testCase{
+		"older":       {apiVersion: apiversions.Apstra600, expErr: true},
+		"same":        {apiVersion: apiversions.Apstra610},
+		"newer":       {apiVersion: apiversions.Apstra612},
+		"unparseable": {apiVersion: "bogus", expErr: true},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			RequireApiVersion(tCase.apiVersion, constraints, &diags)
+			require.Equal(t, tCase.expErr, diags.HasError(), diags)
+		})
+	}
+}
@@ -23,6 +23,11 @@ type ephemeralWithSetFfBpClientFunc interface {
 	setBpClientFunc(func(context.Context, string) (*apstra.FreeformClient, error))
 }
 
+type ephemeralWithSetBpMutexLocker interface {
+	ephemeral.EphemeralResourceWithConfigure
+	setBpMutexLocker(*blueprintMutexLocker)
+}
+
 func configureEphemeral(_ context.Context, ep ephemeral.EphemeralResourceWithConfigure, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return // cannot continue
@@ -49,4 +54,8 @@ func configureEphemeral(_ context.Context, ep ephemeral.EphemeralResourceWithCon
 	if ep, ok := ep.(ephemeralWithSetFfBpClientFunc); ok {
 		ep.setBpClientFunc(pd.getFreeformClient)
 	}
+
+	if ep, ok := ep.(ephemeralWithSetBpMutexLocker); ok {
+		ep.setBpMutexLocker(pd.bpMutexLocker)
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -23,9 +24,26 @@ type resourceWithSetFfBpClientFunc interface {
 	setBpClientFunc(func(context.Context, string) (*apstra.FreeformClient, error))
 }
 
+// blueprintLockFunc locks the mutex associated with the specified blueprint.
+// Failure to lock the mutex is returned as an error so that callers may add
+// context. Conditions which do not prevent locking the mutex, such as breaking
+// a stale lock left behind by some other client, are added to diags as
+// warnings.
+type blueprintLockFunc func(ctx context.Context, blueprintId string, diags *diag.Diagnostics) error
+
 type resourceWithSetBpLockFunc interface {
 	resource.ResourceWithConfigure
-	setBpLockFunc(func(context.Context, string) error)
+	setBpLockFunc(blueprintLockFunc)
+}
+
+type resourceWithSetBpDriftCheckFunc interface {
+	resource.ResourceWithConfigure
+	setBpDriftCheckFunc(blueprintDriftCheckFunc)
+}
+
+type resourceWithSetBpDriftBaselineFunc interface {
+	resource.ResourceWithConfigure
+	setBpDriftBaselineFunc(blueprintDriftBaselineFunc)
 }
 
 type resourceWithSetBpUnlockFunc interface {
@@ -33,6 +51,11 @@ type resourceWithSetBpUnlockFunc interface {
 	setBpUnlockFunc(func(context.Context, string) error)
 }
 
+type resourceWithSetBpMutexLocker interface {
+	resource.ResourceWithConfigure
+	setBpMutexLocker(*blueprintMutexLocker)
+}
+
 type resourceWithSetExperimental interface {
 	resource.ResourceWithConfigure
 	setExperimental(bool)
@@ -79,10 +102,22 @@ func configureResource(_ context.Context, rs resource.ResourceWithConfigure, req
 		rs.setBpLockFunc(pd.bpLockFunc)
 	}
 
+	if rs, ok := rs.(resourceWithSetBpDriftCheckFunc); ok {
+		rs.setBpDriftCheckFunc(pd.bpDriftCheckFunc)
+	}
+
+	if rs, ok := rs.(resourceWithSetBpDriftBaselineFunc); ok {
+		rs.setBpDriftBaselineFunc(pd.bpDriftBaselineFunc)
+	}
+
 	if rs, ok := rs.(resourceWithSetBpUnlockFunc); ok {
 		rs.setBpUnlockFunc(pd.bpUnlockFunc)
 	}
 
+	if rs, ok := rs.(resourceWithSetBpMutexLocker); ok {
+		rs.setBpMutexLocker(pd.bpMutexLocker)
+	}
+
 	if rs, ok := rs.(resourceWithSetExperimental); ok {
 		rs.setExperimental(pd.experimental)
 	}
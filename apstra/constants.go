@@ -17,6 +17,7 @@ const (
 	errResourceReadFail                       = "Resource Read() failure'"
 	errImportJsonConflictingFields            = "Import ID JSON has conflicting fields"
 	errImportJsonMissingRequiredField         = "Import ID JSON missing required field"
+	errImportIdInvalid                        = "Invalid import ID"
 	errBpClientCreateSummary                  = "Failed to create client for Blueprint %s"
 	errBpNotFoundSummary                      = "Blueprint %s not found"
 
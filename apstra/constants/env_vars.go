@@ -1,13 +1,19 @@
 package constants
 
 const (
-	EnvApiTimeout            = "APSTRA_API_TIMEOUT"
-	EnvBlueprintMutexEnabled = "APSTRA_BLUEPRINT_MUTEX_ENABLED"
-	EnvBlueprintMutexMessage = "APSTRA_BLUEPRINT_MUTEX_MESSAGE"
-	EnvExperimental          = "APSTRA_EXPERIMENTAL"
-	EnvLogfile               = "APSTRA_LOG"
-	EnvPassword              = "APSTRA_PASS"
-	EnvTlsNoVerify           = "APSTRA_TLS_VALIDATION_DISABLED"
-	EnvUrl                   = "APSTRA_URL"
-	EnvUsername              = "APSTRA_USER"
+	EnvApiRetryMax            = "APSTRA_API_RETRY_MAX"
+	EnvApiRetryWaitMax        = "APSTRA_API_RETRY_WAIT_MAX"
+	EnvApiRetryWaitMin        = "APSTRA_API_RETRY_WAIT_MIN"
+	EnvApiTimeout             = "APSTRA_API_TIMEOUT"
+	EnvBlueprintDriftWarnings = "APSTRA_BLUEPRINT_DRIFT_WARNINGS"
+	EnvBlueprintMutexEnabled  = "APSTRA_BLUEPRINT_MUTEX_ENABLED"
+	EnvBlueprintMutexMessage  = "APSTRA_BLUEPRINT_MUTEX_MESSAGE"
+	EnvBlueprintMutexTimeout  = "APSTRA_BLUEPRINT_MUTEX_TIMEOUT"
+	EnvBlueprintMutexTtl      = "APSTRA_BLUEPRINT_MUTEX_TTL"
+	EnvExperimental           = "APSTRA_EXPERIMENTAL"
+	EnvLogfile                = "APSTRA_LOG"
+	EnvPassword               = "APSTRA_PASS"
+	EnvTlsNoVerify            = "APSTRA_TLS_VALIDATION_DISABLED"
+	EnvUrl                    = "APSTRA_URL"
+	EnvUsername               = "APSTRA_USER"
 )
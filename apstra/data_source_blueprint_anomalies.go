@@ -30,7 +30,9 @@ func (o *dataSourceBlueprintAnomalies) Configure(ctx context.Context, req dataso
 func (o *dataSourceBlueprintAnomalies) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: docCategoryRefDesignAny + "This data source provides per-node summary, " +
-			"per-service summary and full details of anomalies in the specified Blueprint.",
+			"per-service summary and full details of anomalies in the specified Blueprint. Details may be filtered, " +
+			"and known-benign anomalies may be acknowledged or suppressed by provider-side rules. The data source " +
+			"can optionally wait for matching anomalies to clear.",
 		Attributes: blueprint.Anomalies{}.DataSourceAttributes(),
 	}
 }
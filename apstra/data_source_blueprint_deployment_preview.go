@@ -0,0 +1,60 @@
+package tfapstra
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &dataSourceBlueprintDeployPreview{}
+	_ datasourceWithSetClient            = &dataSourceBlueprintDeployPreview{}
+)
+
+type dataSourceBlueprintDeployPreview struct {
+	client *apstra.Client
+}
+
+func (o *dataSourceBlueprintDeployPreview) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_deployment_preview"
+}
+
+func (o *dataSourceBlueprintDeployPreview) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	configureDataSource(ctx, o, req, resp)
+}
+
+func (o *dataSourceBlueprintDeployPreview) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryRefDesignAny + "This data source previews the changes which will be made " +
+			"when the staging Blueprint is deployed: the incremental configuration which will be pushed to each " +
+			"system, and the number of nodes added, removed and changed in the staging Blueprint.\n\n" +
+			"Like any data source, it is read at plan time unless it depends on values which are not yet known. " +
+			"When the Blueprint is staged and deployed by the same Terraform configuration, use `depends_on` to " +
+			"defer reading the preview until the staging changes have been applied. The `apstra_blueprint_deployment` " +
+			"resource includes the same preview in its plan.",
+		Attributes: blueprint.DeployPreview{}.DataSourceAttributes(),
+	}
+}
+
+func (o *dataSourceBlueprintDeployPreview) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config blueprint.DeployPreview
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Read(ctx, o.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (o *dataSourceBlueprintDeployPreview) setClient(client *apstra.Client) {
+	o.client = client
+}
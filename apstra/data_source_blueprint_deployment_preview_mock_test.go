@@ -0,0 +1,63 @@
+package tfapstra_test
+
+import (
+	"fmt"
+	"testing"
+
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const dataSourceBlueprintDeploymentPreviewMockHCL = `
+data "apstra_blueprint_deployment_preview" "test" {
+  blueprint_id = %q
+}
+`
+
+const dataSourceBlueprintDeploymentPreviewDeployedMockHCL = `
+resource "apstra_blueprint_deployment" "test" {
+  blueprint_id = %q
+}
+
+data "apstra_blueprint_deployment_preview" "test" {
+  blueprint_id = %q
+  depends_on   = [apstra_blueprint_deployment.test]
+}
+`
+
+// TestDataSourceBlueprintDeploymentPreviewMock checks the node counts reported
+// for a never-deployed blueprint, and that nothing is pending once it has been
+// deployed.
+func TestDataSourceBlueprintDeploymentPreviewMock(t *testing.T) {
+	srv := testutils.MockApstra(t)
+
+	// a new blueprint has never been deployed, so each of its nodes (the
+	// default routing zone, the metadata node and this system) is pending
+	bpId := srv.AddBlueprint("test")
+	srv.AddNode(bpId, map[string]any{"type": "system", "label": "leaf1", "role": "leaf"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfigHCL + fmt.Sprintf(dataSourceBlueprintDeploymentPreviewMockHCL, bpId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apstra_blueprint_deployment_preview.test", "pending_nodes_added", "3"),
+					resource.TestCheckResourceAttr("data.apstra_blueprint_deployment_preview.test", "pending_nodes_removed", "0"),
+					resource.TestCheckResourceAttr("data.apstra_blueprint_deployment_preview.test", "pending_nodes_changed", "0"),
+					// the system has no device assigned, so it has no pending configuration
+					resource.TestCheckResourceAttr("data.apstra_blueprint_deployment_preview.test", "pending_config.%", "0"),
+				),
+			},
+			{
+				Config: mockProviderConfigHCL + fmt.Sprintf(dataSourceBlueprintDeploymentPreviewDeployedMockHCL, bpId, bpId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apstra_blueprint_deployment_preview.test", "pending_nodes_added", "0"),
+					resource.TestCheckResourceAttr("data.apstra_blueprint_deployment_preview.test", "pending_nodes_removed", "0"),
+					resource.TestCheckResourceAttr("data.apstra_blueprint_deployment_preview.test", "pending_nodes_changed", "0"),
+					resource.TestCheckResourceAttr("data.apstra_blueprint_deployment_preview.test", "pending_config.%", "0"),
+				),
+			},
+		},
+	})
+}
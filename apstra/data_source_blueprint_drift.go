@@ -0,0 +1,65 @@
+package tfapstra
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &dataSourceBlueprintDrift{}
+	_ datasourceWithSetClient            = &dataSourceBlueprintDrift{}
+)
+
+type dataSourceBlueprintDrift struct {
+	client *apstra.Client
+}
+
+func (o *dataSourceBlueprintDrift) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_drift"
+}
+
+func (o *dataSourceBlueprintDrift) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	configureDataSource(ctx, o, req, resp)
+}
+
+func (o *dataSourceBlueprintDrift) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryRefDesignAny + "This data source compares the staging Blueprint with " +
+			"the active (deployed) Blueprint and reports graph nodes which differ, along with the names of the " +
+			"node attributes which have changed. It can be used to detect changes staged outside of Terraform " +
+			"(e.g. using the Web UI) before they are deployed. To have resources managed by Terraform report such " +
+			"changes as plan warnings, set `blueprint_drift_warnings` in the provider configuration. Requires " +
+			"Apstra " + compatibility.BlueprintDriftOK.String() + ".",
+		Attributes: blueprint.Drift{}.DataSourceAttributes(),
+	}
+}
+
+func (o *dataSourceBlueprintDrift) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config blueprint.Drift
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compatibility.RequireApiVersion(o.client.ApiVersion(), compatibility.BlueprintDriftOK, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ReadFromApi(ctx, o.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (o *dataSourceBlueprintDrift) setClient(client *apstra.Client) {
+	o.client = client
+}
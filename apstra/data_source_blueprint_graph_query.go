@@ -2,12 +2,14 @@ package tfapstra
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/Juniper/apstra-go-sdk/apstra"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSourceWithConfigure = &dataSourceDatacenterGraphQuery{}
@@ -27,50 +29,45 @@ func (o *dataSourceDatacenterGraphQuery) Configure(ctx context.Context, req data
 
 func (o *dataSourceDatacenterGraphQuery) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: docCategoryRefDesignAny + "This data source returns the result of a specific Graph DB query within a Blueprint.",
-		Attributes: map[string]schema.Attribute{
-			"blueprint_id": schema.StringAttribute{
-				MarkdownDescription: "The Blueprint ID you want to run the query against",
-				Required:            true,
-			},
-			"query": schema.StringAttribute{
-				MarkdownDescription: "The query string in graph format.",
-				Required:            true,
-				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
-			},
-			"result": schema.StringAttribute{
-				MarkdownDescription: "The result of the query",
-				Computed:            true,
-			},
-		},
+		MarkdownDescription: docCategoryRefDesignAny + "This data source returns the result of a specific Graph DB query within a Blueprint. " +
+			"The query may be run against the staging Blueprint, the active (deployed) Blueprint, or a previously " +
+			"deployed revision. Results are available both as raw JSON and as a decoded Terraform value.",
+		Attributes: blueprint.GraphQuery{}.DataSourceAttributes(),
 	}
 }
 
 func (o *dataSourceDatacenterGraphQuery) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config struct {
-		BlueprintId types.String `tfsdk:"blueprint_id"`
-		Query       types.String `tfsdk:"query"`
-		Result      types.String `tfsdk:"result"`
-	}
+	var config blueprint.GraphQuery
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// prepare and execute query
-	query := new(apstra.RawQuery).
-		SetBlueprintType(apstra.BlueprintTypeStaging).
-		SetBlueprintId(apstra.ObjectId(config.BlueprintId.ValueString())).
-		SetClient(o.client).
-		SetQuery(config.Query.ValueString())
-	err := query.Do(ctx, nil)
+	apiVersion, err := version.NewVersion(o.client.ApiVersion())
 	if err != nil {
-		resp.Diagnostics.AddError("failed while running graph query", err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("cannot parse API version %q", o.client.ApiVersion()), err.Error())
 		return
 	}
 
-	// set the result into config.Result
-	config.Result = types.StringValue(string(query.RawResult()))
+	// validate the configuration against the API version
+	resp.Diagnostics.Append(
+		compatibility.ValidateConfigConstraints(
+			ctx,
+			compatibility.ValidateConfigConstraintsRequest{
+				Version:     apiVersion,
+				Constraints: config.VersionConstraints(),
+			},
+		)...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// execute query
+	config.Read(ctx, o.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
@@ -38,7 +38,7 @@ func (o *dataSourceBlueprintIbaWidgets) Configure(ctx context.Context, req datas
 
 func (o *dataSourceBlueprintIbaWidgets) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: docCategoryRefDesignAny + "This data source returns the IDs of the IBA Widgets in a Blueprint." +
+		MarkdownDescription: docCategoryRefDesignAny + "This data source returns the IDs of the IBA Widgets in a Blueprint.\n\n" +
 			"*Note: Compatible only with Apstra " + compatibility.BpIbaWidgetOk.String() + "*",
 
 		Attributes: map[string]schema.Attribute{
@@ -0,0 +1,173 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &dataSourceBlueprintImportConfig{}
+	_ datasourceWithSetClient            = &dataSourceBlueprintImportConfig{}
+	_ datasourceWithSetDcBpClientFunc    = &dataSourceBlueprintImportConfig{}
+)
+
+type dataSourceBlueprintImportConfig struct {
+	client          *apstra.Client
+	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	providerData    any                                 // used to configure the resources read by readResource
+	resources       map[string]func() resource.Resource // resource factories keyed by type name
+}
+
+func (o *dataSourceBlueprintImportConfig) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_import_config"
+}
+
+func (o *dataSourceBlueprintImportConfig) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	configureDataSource(ctx, o, req, resp)
+	o.providerData = req.ProviderData
+
+	if o.resources != nil {
+		return
+	}
+
+	var providerMetadata provider.MetadataResponse
+	new(Provider).Metadata(ctx, provider.MetadataRequest{}, &providerMetadata)
+
+	o.resources = make(map[string]func() resource.Resource)
+	for _, f := range new(Provider).Resources(ctx) {
+		var metadata resource.MetadataResponse
+		f().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerMetadata.TypeName}, &metadata)
+		o.resources[metadata.TypeName] = f
+	}
+}
+
+func (o *dataSourceBlueprintImportConfig) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryRefDesignAny + "This data source walks an existing Datacenter or Freeform " +
+			"Blueprint and produces Terraform `resource` blocks, along with matching `import` blocks, for the " +
+			"objects it finds. It is intended to ease bringing brownfield Blueprints under Terraform management: " +
+			"Write the `resource_blocks` and `import_blocks` outputs to a file, then run `terraform plan` " +
+			"(Terraform 1.5+) to confirm that the configuration matches the Blueprint.\n\n" +
+			"Each object is read exactly as its Terraform resource would read it following an import, so only " +
+			"attributes which the resource can read back are rendered. Review the generated configuration before " +
+			"applying it.\n\n" +
+			"Datacenter Blueprints: Routing Zones, Virtual Networks, Connectivity Templates, Generic Systems, " +
+			"Property Sets, Configlets and Tags are discovered. The type of each Connectivity Template is inferred " +
+			"from its primitives.\n\n" +
+			"Freeform Blueprints: Systems, Links, Aggregate Links, Property Sets and Config Templates are discovered.",
+		Attributes: blueprint.ImportConfig{}.DataSourceAttributes(),
+	}
+}
+
+func (o *dataSourceBlueprintImportConfig) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config blueprint.ImportConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the reference design determines which objects we look for
+	status, err := o.client.GetBlueprintStatus(ctx, apstra.ObjectId(config.BlueprintId.ValueString()))
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf(errBpNotFoundSummary, config.BlueprintId), err.Error())
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to fetch blueprint %s status", config.BlueprintId), err.Error())
+		return
+	}
+
+	config.Discover(ctx, o.client, status.Design, o.getBpClientFunc, o.readResource, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// readResource imports and reads a single object using the ImportState() and
+// Read() methods of the Terraform resource of the specified type, much as
+// Terraform does when it imports an object. It satisfies
+// blueprint.ImportConfigStateFunc.
+func (o *dataSourceBlueprintImportConfig) readResource(ctx context.Context, resourceType, importId string, diags *diag.Diagnostics) (resourceSchema.Schema, tftypes.Value) {
+	var r resource.Resource
+	if f, ok := o.resources[resourceType]; ok {
+		r = f()
+	}
+
+	importer, ok := r.(resource.ResourceWithImportState)
+	if !ok {
+		diags.AddError(errProviderBug, fmt.Sprintf("resource type %q not found or does not support import", resourceType))
+		return resourceSchema.Schema{}, tftypes.Value{}
+	}
+
+	if r, ok := r.(resource.ResourceWithConfigure); ok {
+		var configureResp resource.ConfigureResponse
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: o.providerData}, &configureResp)
+		diags.Append(configureResp.Diagnostics...)
+		if diags.HasError() {
+			return resourceSchema.Schema{}, tftypes.Value{}
+		}
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	diags.Append(schemaResp.Diagnostics...)
+	if diags.HasError() {
+		return resourceSchema.Schema{}, tftypes.Value{}
+	}
+
+	importResp := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	importResp.Private = newPrivateState(importResp.Private)
+	importer.ImportState(ctx, resource.ImportStateRequest{ID: importId}, &importResp)
+	diags.Append(importResp.Diagnostics...)
+	if diags.HasError() {
+		return resourceSchema.Schema{}, tftypes.Value{}
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State, Private: importResp.Private}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State, Private: importResp.Private}, &readResp)
+	diags.Append(readResp.Diagnostics...)
+	if diags.HasError() {
+		return resourceSchema.Schema{}, tftypes.Value{}
+	}
+
+	if readResp.State.Raw.IsNull() {
+		diags.AddError("object not found", fmt.Sprintf("%s with import ID %q no longer exists", resourceType, importId))
+		return resourceSchema.Schema{}, tftypes.Value{}
+	}
+
+	return schemaResp.Schema, readResp.State.Raw
+}
+
+// newPrivateState returns a pointer to a new, empty private state. The type of
+// the private state is internal to the plugin framework, so it is inferred from
+// the (nil) private state field of a response.
+func newPrivateState[T any](_ *T) *T {
+	return new(T)
+}
+
+func (o *dataSourceBlueprintImportConfig) setClient(client *apstra.Client) {
+	o.client = client
+}
+
+func (o *dataSourceBlueprintImportConfig) setBpClientFunc(f func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)) {
+	o.getBpClientFunc = f
+}
@@ -0,0 +1,67 @@
+package tfapstra
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &dataSourceBlueprintMutex{}
+	_ datasourceWithSetClient            = &dataSourceBlueprintMutex{}
+)
+
+type dataSourceBlueprintMutex struct {
+	client *apstra.Client
+}
+
+func (o *dataSourceBlueprintMutex) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_mutex"
+}
+
+func (o *dataSourceBlueprintMutex) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	configureDataSource(ctx, o, req, resp)
+}
+
+func (o *dataSourceBlueprintMutex) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryRefDesignAny + "This data source reports whether a Blueprint is locked by a " +
+			"Blueprint mutex, who holds the mutex, and the details of its lease, if any. [More info about Blueprint " +
+			"mutexes here](https://github.com/Juniper/terraform-provider-apstra/blob/main/kb/blueprint_mutex.md).",
+		Attributes: blueprint.MutexStatus{}.DataSourceAttributes(),
+	}
+}
+
+func (o *dataSourceBlueprintMutex) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config blueprint.MutexStatus
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// When this provider holds the mutex, its message identifies the mutex tag.
+	message, _ := heldBlueprintMutexMessage(config.BlueprintId.ValueString())
+	tag, err := findBlueprintMutexTag(ctx, o.client, config.BlueprintId.ValueString(), message)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to determine Blueprint mutex status", err.Error())
+		return
+	}
+
+	config.LoadApiData(ctx, tag, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.HeldByThisProvider = types.BoolValue(tag != nil && blueprintMutexHeld(config.BlueprintId.ValueString()))
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (o *dataSourceBlueprintMutex) setClient(client *apstra.Client) {
+	o.client = client
+}
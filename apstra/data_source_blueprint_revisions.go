@@ -0,0 +1,63 @@
+package tfapstra
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &dataSourceBlueprintRevisions{}
+	_ datasourceWithSetClient            = &dataSourceBlueprintRevisions{}
+)
+
+type dataSourceBlueprintRevisions struct {
+	client *apstra.Client
+}
+
+func (o *dataSourceBlueprintRevisions) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_revisions"
+}
+
+func (o *dataSourceBlueprintRevisions) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	configureDataSource(ctx, o, req, resp)
+}
+
+func (o *dataSourceBlueprintRevisions) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryRefDesignAny + "This data source lists the previously deployed " +
+			"revisions of a Blueprint retained by Apstra, along with their commit comments and timestamps. " +
+			"Any of these revisions may be restored with the `apstra_blueprint_rollback` resource. Requires " +
+			"Apstra " + compatibility.BlueprintRevisionsOK.String() + ".",
+		Attributes: blueprint.Revisions{}.DataSourceAttributes(),
+	}
+}
+
+func (o *dataSourceBlueprintRevisions) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config blueprint.Revisions
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compatibility.RequireApiVersion(o.client.ApiVersion(), compatibility.BlueprintRevisionsOK, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ReadFromApi(ctx, o.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (o *dataSourceBlueprintRevisions) setClient(client *apstra.Client) {
+	o.client = client
+}
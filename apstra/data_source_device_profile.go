@@ -0,0 +1,111 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	sdkdevice "github.com/Juniper/apstra-go-sdk/device"
+	"github.com/Juniper/terraform-provider-apstra/apstra/device"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &dataSourceDeviceProfile{}
+	_ datasourceWithSetClient            = &dataSourceDeviceProfile{}
+)
+
+type dataSourceDeviceProfile struct {
+	client *apstra.Client
+}
+
+func (o *dataSourceDeviceProfile) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_profile"
+}
+
+func (o *dataSourceDeviceProfile) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	configureDataSource(ctx, o, req, resp)
+}
+
+func (o *dataSourceDeviceProfile) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryDevices + "This data source provides details of a specific (non-modular) " +
+			"Device Profile, including both predefined and user-created Device Profiles.\n\n" +
+			"At least one optional attribute is required.",
+		Attributes: device.Profile{}.DataSourceAttributes(),
+	}
+}
+
+func (o *dataSourceDeviceProfile) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config device.Profile
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var api sdkdevice.Profile
+
+	switch {
+	case !config.Name.IsNull():
+		profiles, err := device.GetProfilesByName(ctx, o.client, config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving Device Profiles", err.Error())
+			return
+		}
+		switch len(profiles) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Device Profile not found",
+				fmt.Sprintf("Device Profile with name %q does not exist", config.Name.ValueString()))
+			return
+		case 1:
+			api = profiles[0]
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"multiple Device Profiles found",
+				fmt.Sprintf("%d Device Profiles have name %q", len(profiles), config.Name.ValueString()))
+			return
+		}
+	case !config.Id.IsNull():
+		var err error
+		api, err = o.client.GetDeviceProfile(ctx, config.Id.ValueString())
+		if err != nil {
+			if utils.IsApstra404(err) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("id"),
+					"Device Profile not found",
+					fmt.Sprintf("Device Profile with id %q does not exist", config.Id.ValueString()))
+				return
+			}
+			resp.Diagnostics.AddError("Error retrieving Device Profile", err.Error())
+			return
+		}
+		if device.IsModular(api) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Device Profile is modular",
+				fmt.Sprintf("Device Profile %q describes a chassis - this data source supports only "+
+					"non-modular Device Profiles", config.Id.ValueString()))
+			return
+		}
+	}
+
+	// create new state object
+	var state device.Profile
+	state.LoadApiData(ctx, api, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (o *dataSourceDeviceProfile) setClient(client *apstra.Client) {
+	o.client = client
+}
@@ -0,0 +1,130 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/device"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &dataSourceDeviceProfiles{}
+	_ datasourceWithSetClient            = &dataSourceDeviceProfiles{}
+)
+
+type dataSourceDeviceProfiles struct {
+	client *apstra.Client
+}
+
+func (o *dataSourceDeviceProfiles) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_profiles"
+}
+
+func (o *dataSourceDeviceProfiles) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	configureDataSource(ctx, o, req, resp)
+}
+
+func (o *dataSourceDeviceProfiles) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryDevices + "This data source returns the IDs of (non-modular) Device Profiles. " +
+			"The optional filter attributes are compared with each Device Profile's `selector`.",
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "A set of Apstra object ID numbers.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"manufacturer": schema.StringAttribute{
+				MarkdownDescription: "Optional filter to select only Device Profiles with the specified manufacturer.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Optional filter to select only Device Profiles with the specified model. " +
+					"The model selector of a Device Profile is a regular expression, and must match this value exactly.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"os": schema.StringAttribute{
+				MarkdownDescription: "Optional filter to select only Device Profiles with the specified operating system.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"os_version": schema.StringAttribute{
+				MarkdownDescription: "Optional filter to select only Device Profiles with the specified operating " +
+					"system version. The version selector of a Device Profile is a regular expression, and must " +
+					"match this value exactly.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	}
+}
+
+func (o *dataSourceDeviceProfiles) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config deviceProfiles
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profiles, err := o.client.GetDeviceProfiles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("error retrieving Device Profiles", err.Error())
+		return
+	}
+
+	ids := make([]string, 0, len(profiles))
+	for _, dp := range profiles {
+		if device.IsModular(dp) {
+			continue
+		}
+		if !config.Manufacturer.IsNull() && config.Manufacturer.ValueString() != dp.Selector.Manufacturer {
+			continue
+		}
+		if !config.Model.IsNull() && config.Model.ValueString() != dp.Selector.Model {
+			continue
+		}
+		if !config.Os.IsNull() && config.Os.ValueString() != dp.Selector.OS {
+			continue
+		}
+		if !config.OsVersion.IsNull() && config.OsVersion.ValueString() != dp.Selector.OSVersion {
+			continue
+		}
+		if dp.ID() == nil {
+			resp.Diagnostics.AddError("failed fetching Device Profiles", fmt.Sprintf("Device Profile %q has no ID", dp.Label))
+			return
+		}
+		ids = append(ids, *dp.ID())
+	}
+
+	idSet, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// save the list of IDs in the config object
+	config.Ids = idSet
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+type deviceProfiles struct {
+	Ids          types.Set    `tfsdk:"ids"`
+	Manufacturer types.String `tfsdk:"manufacturer"`
+	Model        types.String `tfsdk:"model"`
+	Os           types.String `tfsdk:"os"`
+	OsVersion    types.String `tfsdk:"os_version"`
+}
+
+func (o *dataSourceDeviceProfiles) setClient(client *apstra.Client) {
+	o.client = client
+}
@@ -0,0 +1,99 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/design"
+	"github.com/Juniper/terraform-provider-apstra/apstra/device"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &dataSourceInterfaceMapGenerator{}
+	_ datasourceWithSetClient            = &dataSourceInterfaceMapGenerator{}
+)
+
+type dataSourceInterfaceMapGenerator struct {
+	client *apstra.Client
+}
+
+func (o *dataSourceInterfaceMapGenerator) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface_map_generator"
+}
+
+func (o *dataSourceInterfaceMapGenerator) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	configureDataSource(ctx, o, req, resp)
+}
+
+func (o *dataSourceInterfaceMapGenerator) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryDesign + "This data source generates the complete set of interface " +
+			"mappings between a Logical Device and a Device Profile, in the manner of the Apstra web UI's " +
+			"auto-mapping feature. The resulting `interfaces` attribute can be used directly in an " +
+			"`apstra_interface_map` resource.\n\n" +
+			"Logical Device ports are allocated in order of speed, and then in panel/port order. Each is mapped " +
+			"to the first available Device Profile interface of the same speed, with remaining members of " +
+			"previously selected breakout transformations consumed before new ports. The optional attributes " +
+			"influence which Device Profile ports and transformations are selected.",
+		Attributes: design.InterfaceMapGenerator{}.DataSourceAttributes(),
+	}
+}
+
+func (o *dataSourceInterfaceMapGenerator) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config design.InterfaceMapGenerator
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ld, err := o.client.GetLogicalDevice(ctx, apstra.ObjectId(config.LogicalDeviceId.ValueString()))
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("logical_device_id"),
+				"Logical Device not found",
+				fmt.Sprintf("Logical Device with ID %q not found", config.LogicalDeviceId.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("failed to fetch Logical Device", err.Error())
+		return
+	}
+
+	dp, err := o.client.GetDeviceProfile(ctx, config.DeviceProfileId.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("device_profile_id"),
+				"Device Profile not found",
+				fmt.Sprintf("Device Profile with ID %q not found", config.DeviceProfileId.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("failed to fetch Device Profile", err.Error())
+		return
+	}
+	if device.IsModular(dp) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("device_profile_id"),
+			"Device Profile is modular",
+			fmt.Sprintf("Device Profile %q describes a chassis - interface maps can be generated only for "+
+				"non-modular Device Profiles", config.DeviceProfileId.ValueString()))
+		return
+	}
+
+	config.Generate(ctx, ld, &dp, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (o *dataSourceInterfaceMapGenerator) setClient(client *apstra.Client) {
+	o.client = client
+}
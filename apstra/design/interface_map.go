@@ -11,6 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// InterfaceMapLdPortSep separates the panel and port numbers in the Logical
+// Device port names used by Interface Maps, e.g. "1/2".
+const InterfaceMapLdPortSep = "/"
+
 type InterfaceMap struct {
 	Id            types.String `tfsdk:"id"`
 	Name          types.String `tfsdk:"name"`
@@ -44,8 +48,9 @@ func (o InterfaceMap) DataSourceAttributes() map[string]dataSourceSchema.Attribu
 			Computed:            true,
 		},
 		"device_profile_id": dataSourceSchema.StringAttribute{
-			MarkdownDescription: "ID of Device Profile referenced by this interface map.",
-			Computed:            true,
+			MarkdownDescription: "ID of Device Profile referenced by this interface map. Details of the Device " +
+				"Profile are available from the `apstra_device_profile` data source.",
+			Computed: true,
 		},
 		"interfaces": dataSourceSchema.SetNestedAttribute{
 			MarkdownDescription: "Detailed mapping of each physical interface to its role in the logical device",
@@ -63,3 +68,21 @@ func (o *InterfaceMap) LoadApiData(ctx context.Context, in *apstra.InterfaceMapD
 	o.DeviceProfile = types.StringValue(string(in.DeviceProfileId))
 	o.Interfaces = NewInterfaceMapInterfaceSet(ctx, in.Interfaces, diags)
 }
+
+// InterfaceMapIdsByDeviceProfile returns the IDs of Interface Maps which
+// reference the specified Device Profile.
+func InterfaceMapIdsByDeviceProfile(ctx context.Context, client *apstra.Client, deviceProfileId string) ([]string, error) {
+	interfaceMaps, err := client.GetAllInterfaceMaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, interfaceMap := range interfaceMaps {
+		if interfaceMap.Data.DeviceProfileId.String() == deviceProfileId {
+			result = append(result, interfaceMap.Id.String())
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,440 @@
+package design
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/apstra-go-sdk/device"
+	"github.com/Juniper/apstra-go-sdk/enum"
+	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	interfaceMapGeneratorBreakoutAvoid  = "avoid"
+	interfaceMapGeneratorBreakoutPrefer = "prefer"
+	interfaceMapGeneratorBreakoutNever  = "never"
+
+	interfaceMapGeneratorSpeedOrderDescending = "descending"
+	interfaceMapGeneratorSpeedOrderAscending  = "ascending"
+)
+
+type InterfaceMapGenerator struct {
+	LogicalDeviceId   types.String `tfsdk:"logical_device_id"`
+	DeviceProfileId   types.String `tfsdk:"device_profile_id"`
+	PanelOrder        types.List   `tfsdk:"panel_order"`
+	Breakout          types.String `tfsdk:"breakout"`
+	SpeedOrder        types.String `tfsdk:"speed_order"`
+	ExcludeInterfaces types.Set    `tfsdk:"exclude_interfaces"`
+	Interfaces        types.Set    `tfsdk:"interfaces"`
+	Mappings          types.Map    `tfsdk:"mappings"`
+}
+
+func (o InterfaceMapGenerator) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"logical_device_id": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "ID of the Logical Device to be mapped.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"device_profile_id": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "ID of the (non-modular) Device Profile to be mapped.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"panel_order": dataSourceSchema.ListAttribute{
+			MarkdownDescription: "Device Profile panel IDs in the order in which their ports should be allocated. " +
+				"Panels omitted from this list are not used. When omitted, all panels are used in order of panel ID.",
+			Optional:    true,
+			ElementType: types.Int64Type,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+			},
+		},
+		"breakout": dataSourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Controls the use of breakout transformations (transformations which "+
+				"create more than one interface). `%s` uses breakout transformations only when no other port can "+
+				"satisfy a Logical Device port, `%s` uses breakout transformations whenever possible, and `%s` never "+
+				"uses them. Default: `%s`",
+				interfaceMapGeneratorBreakoutAvoid, interfaceMapGeneratorBreakoutPrefer,
+				interfaceMapGeneratorBreakoutNever, interfaceMapGeneratorBreakoutAvoid),
+			Optional: true,
+			Validators: []validator.String{stringvalidator.OneOf(
+				interfaceMapGeneratorBreakoutAvoid,
+				interfaceMapGeneratorBreakoutPrefer,
+				interfaceMapGeneratorBreakoutNever,
+			)},
+		},
+		"speed_order": dataSourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Order in which Logical Device ports are allocated according to "+
+				"their speed. Allocating the fastest ports first (`%s`) prevents slower ports from consuming "+
+				"high-speed physical ports by way of breakout transformations. Must be one of `%s` or `%s`. "+
+				"Default: `%s`",
+				interfaceMapGeneratorSpeedOrderDescending, interfaceMapGeneratorSpeedOrderDescending,
+				interfaceMapGeneratorSpeedOrderAscending, interfaceMapGeneratorSpeedOrderDescending),
+			Optional: true,
+			Validators: []validator.String{stringvalidator.OneOf(
+				interfaceMapGeneratorSpeedOrderDescending,
+				interfaceMapGeneratorSpeedOrderAscending,
+			)},
+		},
+		"exclude_interfaces": dataSourceSchema.SetAttribute{
+			MarkdownDescription: "Device Profile interface names (e.g. `et-0/0/31`) which must not be allocated.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"interfaces": dataSourceSchema.SetNestedAttribute{
+			MarkdownDescription: "Generated interface mappings, suitable for use as the `interfaces` attribute " +
+				"of an `apstra_interface_map` resource.",
+			Computed: true,
+			NestedObject: dataSourceSchema.NestedAttributeObject{
+				Attributes: map[string]dataSourceSchema.Attribute{
+					"physical_interface_name": dataSourceSchema.StringAttribute{
+						MarkdownDescription: "Interface name found in the Device Profile, e.g. \"et-0/0/1:2\"",
+						Computed:            true,
+					},
+					"logical_device_port": dataSourceSchema.StringAttribute{
+						MarkdownDescription: "Panel and Port number of logical device expressed in the form " +
+							"\"<panel>/<port>\".",
+						Computed: true,
+					},
+					"transformation_id": dataSourceSchema.Int64Attribute{
+						MarkdownDescription: "Transformation ID number identifying the selected port behavior, " +
+							"as found in the Device Profile.",
+						Computed: true,
+					},
+				},
+			},
+		},
+		"mappings": dataSourceSchema.MapNestedAttribute{
+			MarkdownDescription: "Detailed mapping info for each generated interface, keyed by physical interface name.",
+			Computed:            true,
+			NestedObject: dataSourceSchema.NestedAttributeObject{
+				Attributes: InterfaceMapMapping{}.DataSourceAttributes(),
+			},
+		},
+	}
+}
+
+func (o InterfaceMapGenerator) interfaceAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"physical_interface_name": types.StringType,
+		"logical_device_port":     types.StringType,
+		"transformation_id":       types.Int64Type,
+	}
+}
+
+// Generate allocates Device Profile interfaces to every port of the Logical
+// Device, and loads the result into o.Interfaces and o.Mappings.
+func (o *InterfaceMapGenerator) Generate(ctx context.Context, ld *apstra.LogicalDevice, dp *device.Profile, diags *diag.Diagnostics) {
+	ldPorts := o.ldPorts(ctx, ld, diags)
+	if diags.HasError() {
+		return
+	}
+
+	dpPorts := o.dpPorts(ctx, dp, diags)
+	if diags.HasError() {
+		return
+	}
+
+	allocations, err := allocateInterfaces(ldPorts, dpPorts, o.Breakout.ValueString())
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("cannot map Logical Device %q to Device Profile %q", o.LogicalDeviceId.ValueString(), o.DeviceProfileId.ValueString()),
+			err.Error())
+		return
+	}
+
+	type iface struct {
+		PhysicalInterfaceName types.String `tfsdk:"physical_interface_name"`
+		LogicalDevicePort     types.String `tfsdk:"logical_device_port"`
+		TransformationId      types.Int64  `tfsdk:"transformation_id"`
+	}
+
+	interfaces := make([]iface, len(allocations))
+	mappings := make(map[string]attr.Value, len(allocations))
+	for i, a := range allocations {
+		interfaces[i] = iface{
+			PhysicalInterfaceName: types.StringValue(a.name),
+			LogicalDevicePort:     types.StringValue(fmt.Sprintf("%d%s%d", a.ldPanel, InterfaceMapLdPortSep, a.ldPort)),
+			TransformationId:      types.Int64Value(a.dpTransformationId),
+		}
+		mappings[a.name] = NewInterfaceMapMappingObject(ctx, &apstra.InterfaceMapMapping{
+			DPPortId:      int(a.dpPortId),
+			DPTransformId: int(a.dpTransformationId),
+			DPInterfaceId: int(a.dpInterfaceId),
+			LDPanel:       a.ldPanel,
+			LDPort:        a.ldPort,
+		}, diags)
+	}
+	if diags.HasError() {
+		return
+	}
+
+	o.Interfaces = value.SetOrNull(ctx, types.ObjectType{AttrTypes: o.interfaceAttrTypes()}, interfaces, diags)
+	o.Mappings = types.MapValueMust(types.ObjectType{AttrTypes: InterfaceMapMapping{}.AttrTypes()}, mappings)
+}
+
+// ldPorts returns the Logical Device ports in the order in which they should
+// be allocated.
+func (o *InterfaceMapGenerator) ldPorts(ctx context.Context, ld *apstra.LogicalDevice, diags *diag.Diagnostics) []generatorLdPort {
+	var result []generatorLdPort
+	for i, apiPanel := range ld.Data.Panels {
+		var panel LogicalDevicePanel
+		panel.LoadApiData(ctx, &apiPanel, diags)
+		portGroups := panel.GetPortGroups(ctx, diags)
+		if diags.HasError() {
+			return nil
+		}
+
+		port := 1
+		for _, portGroup := range portGroups {
+			mbps, err := apstravalidator.SpeedMbps(portGroup.PortSpeed.ValueString())
+			if err != nil {
+				diags.AddError("failed to parse Logical Device port speed",
+					fmt.Sprintf("Logical Device %q panel %d uses unexpected port speed: %s", ld.Id, i+1, err))
+				return nil
+			}
+
+			for range portGroup.PortCount.ValueInt64() {
+				result = append(result, generatorLdPort{panel: i + 1, port: port, speed: portGroup.PortSpeed.ValueString(), mbps: mbps})
+				port++
+			}
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if o.SpeedOrder.ValueString() == interfaceMapGeneratorSpeedOrderAscending {
+			return result[i].mbps < result[j].mbps
+		}
+		return result[i].mbps > result[j].mbps
+	})
+
+	return result
+}
+
+// dpPorts returns the Device Profile ports eligible for allocation in the
+// order in which they should be considered. Only active interfaces which
+// have not been excluded are included.
+func (o *InterfaceMapGenerator) dpPorts(ctx context.Context, dp *device.Profile, diags *diag.Diagnostics) []*generatorDpPort {
+	var panelOrder []int64
+	if !o.PanelOrder.IsNull() {
+		diags.Append(o.PanelOrder.ElementsAs(ctx, &panelOrder, false)...)
+	}
+
+	var excluded []string
+	if !o.ExcludeInterfaces.IsNull() {
+		diags.Append(o.ExcludeInterfaces.ElementsAs(ctx, &excluded, false)...)
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+
+	// panelRank determines the order in which panels are used
+	panelRank := func(panelId int64) (int, bool) {
+		if panelOrder == nil {
+			return int(panelId), true
+		}
+		i := slices.Index(panelOrder, panelId)
+		return i, i >= 0
+	}
+
+	var result []*generatorDpPort
+	for _, port := range dp.Ports {
+		rank, ok := panelRank(int64(port.PanelID))
+		if !ok {
+			continue
+		}
+
+		p := generatorDpPort{
+			id:   int64(port.ID),
+			rank: rank,
+			used: make(map[int64]struct{}),
+		}
+
+		for _, transformation := range port.Transformations {
+			t := generatorTransformation{
+				id:        int64(transformation.ID),
+				isDefault: transformation.IsDefault,
+				breakout:  len(transformation.Interfaces) > 1,
+			}
+			for _, intf := range transformation.Interfaces {
+				if intf.State != enum.InterfaceStateActive || slices.Contains(excluded, intf.Name) {
+					continue
+				}
+				mbps, err := apstravalidator.SpeedMbps(string(intf.Speed))
+				if err != nil {
+					diags.AddError("failed to parse Device Profile interface speed",
+						fmt.Sprintf("Device Profile %q interface %q uses unexpected speed: %s",
+							o.DeviceProfileId.ValueString(), intf.Name, err))
+					return nil
+				}
+				t.interfaces = append(t.interfaces, generatorDpInterface{
+					id:   int64(intf.ID),
+					name: intf.Name,
+					mbps: mbps,
+				})
+			}
+			sort.Slice(t.interfaces, func(i, j int) bool { return t.interfaces[i].id < t.interfaces[j].id })
+			p.transformations = append(p.transformations, t)
+		}
+
+		// default transformation first, then by ID
+		sort.Slice(p.transformations, func(i, j int) bool {
+			if p.transformations[i].isDefault != p.transformations[j].isDefault {
+				return p.transformations[i].isDefault
+			}
+			return p.transformations[i].id < p.transformations[j].id
+		})
+
+		result = append(result, &p)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].rank != result[j].rank {
+			return result[i].rank < result[j].rank
+		}
+		return result[i].id < result[j].id
+	})
+
+	return result
+}
+
+type generatorLdPort struct {
+	panel int
+	port  int
+	speed string
+	mbps  int64
+}
+
+type generatorDpInterface struct {
+	id   int64
+	name string
+	mbps int64
+}
+
+type generatorTransformation struct {
+	id         int64
+	isDefault  bool
+	breakout   bool
+	interfaces []generatorDpInterface
+}
+
+// freeInterface returns the first interface with the specified speed which
+// does not appear in used.
+func (o *generatorTransformation) freeInterface(mbps int64, used map[int64]struct{}) *generatorDpInterface {
+	for i, intf := range o.interfaces {
+		if _, ok := used[intf.id]; ok || intf.mbps != mbps {
+			continue
+		}
+		return &o.interfaces[i]
+	}
+	return nil
+}
+
+type generatorDpPort struct {
+	id              int64
+	rank            int
+	transformations []generatorTransformation
+	selected        *generatorTransformation
+	used            map[int64]struct{}
+}
+
+type generatorAllocation struct {
+	name               string
+	ldPanel            int
+	ldPort             int
+	dpPortId           int64
+	dpTransformationId int64
+	dpInterfaceId      int64
+}
+
+// allocateInterfaces assigns a Device Profile interface to each Logical
+// Device port. Interfaces remaining within an already-selected transformation
+// (unused members of a breakout) are always consumed before a new port is
+// selected. The breakout argument determines whether breakout transformations
+// are preferred, avoided or forbidden when selecting a new port.
+func allocateInterfaces(ldPorts []generatorLdPort, dpPorts []*generatorDpPort, breakout string) ([]generatorAllocation, error) {
+	var breakoutPasses []bool
+	switch breakout {
+	case interfaceMapGeneratorBreakoutPrefer:
+		breakoutPasses = []bool{true, false}
+	case interfaceMapGeneratorBreakoutNever:
+		breakoutPasses = []bool{false}
+	default:
+		breakoutPasses = []bool{false, true}
+	}
+
+	find := func(mbps int64) (*generatorDpPort, *generatorTransformation, *generatorDpInterface) {
+		// first look for leftovers within previously selected transformations
+		for _, port := range dpPorts {
+			if port.selected == nil {
+				continue
+			}
+			if intf := port.selected.freeInterface(mbps, port.used); intf != nil {
+				return port, port.selected, intf
+			}
+		}
+
+		// now look for a new port
+		for _, wantBreakout := range breakoutPasses {
+			for _, port := range dpPorts {
+				if port.selected != nil {
+					continue
+				}
+				for i, transformation := range port.transformations {
+					if transformation.breakout != wantBreakout {
+						continue
+					}
+					if intf := port.transformations[i].freeInterface(mbps, port.used); intf != nil {
+						return port, &port.transformations[i], intf
+					}
+				}
+			}
+		}
+
+		return nil, nil, nil
+	}
+
+	result := make([]generatorAllocation, len(ldPorts))
+	for i, ldPort := range ldPorts {
+		port, transformation, intf := find(ldPort.mbps)
+		if port == nil {
+			return nil, fmt.Errorf("no available Device Profile interface satisfies Logical Device port "+
+				"%d%s%d at %s", ldPort.panel, InterfaceMapLdPortSep, ldPort.port, ldPort.speed)
+		}
+
+		port.selected = transformation
+		port.used[intf.id] = struct{}{}
+
+		result[i] = generatorAllocation{
+			name:               intf.name,
+			ldPanel:            ldPort.panel,
+			ldPort:             ldPort.port,
+			dpPortId:           port.id,
+			dpTransformationId: transformation.id,
+			dpInterfaceId:      intf.id,
+		}
+	}
+
+	return result, nil
+}
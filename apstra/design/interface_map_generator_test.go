@@ -0,0 +1,115 @@
+package design
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocateInterfaces(t *testing.T) {
+	// newPorts returns a fresh device profile with two 10G ports (1-2) and
+	// two 100G ports (3-4) which can be broken out to 4x25G.
+	newPorts := func() []*generatorDpPort {
+		var result []*generatorDpPort
+		for _, id := range []int64{1, 2} {
+			result = append(result, &generatorDpPort{
+				id: id,
+				transformations: []generatorTransformation{
+					{id: 1, isDefault: true, interfaces: []generatorDpInterface{{id: 1, name: fmt.Sprintf("xe-0/0/%d", id-1), mbps: 10000}}},
+				},
+				used: make(map[int64]struct{}),
+			})
+		}
+		for _, id := range []int64{3, 4} {
+			name := fmt.Sprintf("et-0/0/%d", id-1)
+			result = append(result, &generatorDpPort{
+				id: id,
+				transformations: []generatorTransformation{
+					{id: 1, isDefault: true, interfaces: []generatorDpInterface{{id: 1, name: name, mbps: 100000}}},
+					{id: 2, breakout: true, interfaces: []generatorDpInterface{
+						{id: 1, name: name + ":0", mbps: 25000},
+						{id: 2, name: name + ":1", mbps: 25000},
+						{id: 3, name: name + ":2", mbps: 25000},
+						{id: 4, name: name + ":3", mbps: 25000},
+					}},
+				},
+				used: make(map[int64]struct{}),
+			})
+		}
+		return result
+	}
+
+	type testCase struct {
+		ldPorts  []generatorLdPort
+		breakout string
+		expNames []string
+		expErr   bool
+	}
+
+	testCases := map[string]testCase{
+		"native_ports": {
+			ldPorts: []generatorLdPort{
+				{panel: 1, port: 1, mbps: 100000},
+				{panel: 1, port: 2, mbps: 10000},
+				{panel: 1, port: 3, mbps: 10000},
+			},
+			expNames: []string{"et-0/0/2", "xe-0/0/0", "xe-0/0/1"},
+		},
+		"breakout_fills_first_port": {
+			ldPorts: []generatorLdPort{
+				{panel: 1, port: 1, mbps: 25000},
+				{panel: 1, port: 2, mbps: 25000},
+				{panel: 1, port: 3, mbps: 100000},
+			},
+			expNames: []string{"et-0/0/2:0", "et-0/0/2:1", "et-0/0/3"},
+		},
+		"breakout_never": {
+			ldPorts:  []generatorLdPort{{panel: 1, port: 1, mbps: 25000}},
+			breakout: interfaceMapGeneratorBreakoutNever,
+			expErr:   true,
+		},
+		"breakout_prefer": {
+			ldPorts: []generatorLdPort{
+				{panel: 1, port: 1, mbps: 100000},
+			},
+			breakout: interfaceMapGeneratorBreakoutPrefer,
+			expNames: []string{"et-0/0/2"},
+		},
+		"exhausted": {
+			ldPorts: []generatorLdPort{
+				{panel: 1, port: 1, mbps: 100000},
+				{panel: 1, port: 2, mbps: 100000},
+				{panel: 1, port: 3, mbps: 100000},
+			},
+			expErr: true,
+		},
+		"breakout_consumes_100g_port": {
+			ldPorts: []generatorLdPort{
+				{panel: 1, port: 1, mbps: 25000},
+				{panel: 1, port: 2, mbps: 100000},
+				{panel: 1, port: 3, mbps: 100000},
+			},
+			expErr: true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := allocateInterfaces(tCase.ldPorts, newPorts(), tCase.breakout)
+			if tCase.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			names := make([]string, len(result))
+			for i, allocation := range result {
+				names[i] = allocation.name
+			}
+			require.Equal(t, tCase.expNames, names)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package device
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/device"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type Profile struct {
+	Id                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Selector             types.Object `tfsdk:"selector"`
+	HardwareCapabilities types.Object `tfsdk:"hardware_capabilities"`
+	SoftwareCapabilities types.Object `tfsdk:"software_capabilities"`
+	Ports                types.List   `tfsdk:"ports"`
+}
+
+func (o Profile) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"id": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the Device Profile. Required when `name` is omitted.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.Expressions{
+					path.MatchRelative(),
+					path.MatchRoot("name"),
+				}...),
+			},
+		},
+		"name": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Web UI name of the Device Profile. Required when `id` is omitted.",
+			Optional:            true,
+			Computed:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"selector": dataSourceSchema.SingleNestedAttribute{
+			MarkdownDescription: "Criteria used to match the Device Profile with devices.",
+			Computed:            true,
+			Attributes:          profileSelector{}.dataSourceAttributes(),
+		},
+		"hardware_capabilities": dataSourceSchema.SingleNestedAttribute{
+			MarkdownDescription: "Hardware capabilities of devices matched by the Device Profile.",
+			Computed:            true,
+			Attributes:          profileHardwareCapabilities{}.dataSourceAttributes(),
+		},
+		"software_capabilities": dataSourceSchema.SingleNestedAttribute{
+			MarkdownDescription: "Software capabilities of devices matched by the Device Profile.",
+			Computed:            true,
+			Attributes:          profileSoftwareCapabilities{}.dataSourceAttributes(),
+		},
+		"ports": dataSourceSchema.ListNestedAttribute{
+			MarkdownDescription: "Physical ports of devices matched by the Device Profile.",
+			Computed:            true,
+			NestedObject: dataSourceSchema.NestedAttributeObject{
+				Attributes: profilePort{}.dataSourceAttributes(),
+			},
+		},
+	}
+}
+
+func (o Profile) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the Device Profile.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"name": resourceSchema.StringAttribute{
+			MarkdownDescription: "Device Profile name displayed in the Apstra web UI.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"selector": resourceSchema.SingleNestedAttribute{
+			MarkdownDescription: "Criteria used to match the Device Profile with devices.",
+			Required:            true,
+			Attributes:          profileSelector{}.resourceAttributes(),
+		},
+		"hardware_capabilities": resourceSchema.SingleNestedAttribute{
+			MarkdownDescription: "Hardware capabilities of devices matched by the Device Profile.",
+			Required:            true,
+			Attributes:          profileHardwareCapabilities{}.resourceAttributes(),
+		},
+		"software_capabilities": resourceSchema.SingleNestedAttribute{
+			MarkdownDescription: "Software capabilities of devices matched by the Device Profile.",
+			Optional:            true,
+			Computed:            true,
+			Default:             objectdefault.StaticValue(profileSoftwareCapabilities{}.defaultObject()),
+			Attributes:          profileSoftwareCapabilities{}.resourceAttributes(),
+		},
+		"ports": resourceSchema.ListNestedAttribute{
+			MarkdownDescription: "Physical ports of devices matched by the Device Profile.",
+			Required:            true,
+			Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+			NestedObject: resourceSchema.NestedAttributeObject{
+				Attributes: profilePort{}.resourceAttributes(),
+			},
+		},
+	}
+}
+
+// Request returns a copy of existing with the fields modeled by o replaced by
+// the planned values. When updating a Device Profile, existing should be the
+// Device Profile currently found in Apstra, so that fields not modeled by the
+// provider (and the ID) are sent back unchanged. Ports, transformations and
+// interfaces are matched with their existing counterparts by ID. existing is
+// nil when creating a Device Profile.
+func (o *Profile) Request(ctx context.Context, existing *device.Profile, diags *diag.Diagnostics) *device.Profile {
+	var selector profileSelector
+	diags.Append(o.Selector.As(ctx, &selector, basetypes.ObjectAsOptions{})...)
+
+	var hardwareCapabilities profileHardwareCapabilities
+	diags.Append(o.HardwareCapabilities.As(ctx, &hardwareCapabilities, basetypes.ObjectAsOptions{})...)
+
+	var softwareCapabilities profileSoftwareCapabilities
+	diags.Append(o.SoftwareCapabilities.As(ctx, &softwareCapabilities, basetypes.ObjectAsOptions{})...)
+
+	var ports []profilePort
+	diags.Append(o.Ports.ElementsAs(ctx, &ports, false)...)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	var result device.Profile
+	if existing != nil {
+		result = *existing
+	}
+
+	existingPorts := make(map[int]device.Port, len(result.Ports))
+	for _, port := range result.Ports {
+		existingPorts[port.ID] = port
+	}
+
+	result.Label = o.Name.ValueString()
+	selector.copyTo(ctx, &result.Selector, diags)
+	hardwareCapabilities.copyTo(ctx, &result.HardwareCapabilities, diags)
+	softwareCapabilities.copyTo(ctx, &result.SoftwareCapabilities, diags)
+	result.Ports = make([]device.Port, len(ports))
+	for i, port := range ports {
+		result.Ports[i] = existingPorts[int(port.PortId.ValueInt64())]
+		port.copyTo(ctx, &result.Ports[i], diags)
+	}
+	if diags.HasError() {
+		return nil
+	}
+
+	return &result
+}
+
+func (o *Profile) LoadApiData(ctx context.Context, in device.Profile, diags *diag.Diagnostics) {
+	var selector profileSelector
+	selector.loadApiData(ctx, in.Selector, diags)
+
+	var hardwareCapabilities profileHardwareCapabilities
+	hardwareCapabilities.loadApiData(ctx, in.HardwareCapabilities, diags)
+
+	var softwareCapabilities profileSoftwareCapabilities
+	softwareCapabilities.loadApiData(ctx, in.SoftwareCapabilities, diags)
+
+	ports := make([]profilePort, len(in.Ports))
+	for i, port := range in.Ports {
+		ports[i].loadApiData(ctx, port, diags)
+	}
+
+	if diags.HasError() {
+		return
+	}
+
+	o.Id = types.StringPointerValue(in.ID())
+	o.Name = types.StringValue(in.Label)
+	o.Selector = value.ObjectOrNull(ctx, profileSelector{}.attrTypes(), &selector, diags)
+	o.HardwareCapabilities = value.ObjectOrNull(ctx, profileHardwareCapabilities{}.attrTypes(), &hardwareCapabilities, diags)
+	o.SoftwareCapabilities = value.ObjectOrNull(ctx, profileSoftwareCapabilities{}.attrTypes(), &softwareCapabilities, diags)
+	o.Ports = value.ListOrNull(ctx, types.ObjectType{AttrTypes: profilePort{}.attrTypes()}, ports, diags)
+}
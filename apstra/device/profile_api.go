@@ -0,0 +1,33 @@
+package device
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/apstra-go-sdk/device"
+	"github.com/Juniper/apstra-go-sdk/enum"
+)
+
+// IsModular returns true when the Device Profile describes a chassis. These
+// are managed by the apstra_modular_device_profile resource.
+func IsModular(in device.Profile) bool {
+	return in.DeviceProfileType == enum.DeviceProfileTypeModular
+}
+
+// GetProfilesByName returns every non-modular Device Profile with the
+// specified name.
+func GetProfilesByName(ctx context.Context, client *apstra.Client, name string) ([]device.Profile, error) {
+	profiles, err := client.GetDeviceProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []device.Profile
+	for _, profile := range profiles {
+		if profile.Label == name && !IsModular(profile) {
+			result = append(result, profile)
+		}
+	}
+
+	return result, nil
+}
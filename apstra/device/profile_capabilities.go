@@ -0,0 +1,224 @@
+package device
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Juniper/apstra-go-sdk/device"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	profileConfigApplySupportCompleteOnly           = "complete_only"
+	profileConfigApplySupportCompleteAndIncremental = "complete_and_incremental"
+)
+
+type profileHardwareCapabilities struct {
+	Cpu        types.String `tfsdk:"cpu"`
+	Ram        types.Int64  `tfsdk:"ram"`
+	FormFactor types.String `tfsdk:"form_factor"`
+	Asic       types.String `tfsdk:"asic"`
+	Userland   types.Int64  `tfsdk:"userland"`
+	EcmpLimit  types.Int64  `tfsdk:"ecmp_limit"`
+}
+
+func (o profileHardwareCapabilities) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"cpu":         types.StringType,
+		"ram":         types.Int64Type,
+		"form_factor": types.StringType,
+		"asic":        types.StringType,
+		"userland":    types.Int64Type,
+		"ecmp_limit":  types.Int64Type,
+	}
+}
+
+func (o profileHardwareCapabilities) dataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"cpu": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "CPU architecture.",
+			Computed:            true,
+		},
+		"ram": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Memory in GB.",
+			Computed:            true,
+		},
+		"form_factor": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Physical form factor.",
+			Computed:            true,
+		},
+		"asic": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Switching ASIC.",
+			Computed:            true,
+		},
+		"userland": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Userland word size in bits.",
+			Computed:            true,
+		},
+		"ecmp_limit": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Maximum number of ECMP paths.",
+			Computed:            true,
+		},
+	}
+}
+
+func (o profileHardwareCapabilities) resourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"cpu": resourceSchema.StringAttribute{
+			MarkdownDescription: "CPU architecture (e.g. `x86`).",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"ram": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Memory in GB.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"form_factor": resourceSchema.StringAttribute{
+			MarkdownDescription: "Physical form factor (e.g. `1RU`).",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"asic": resourceSchema.StringAttribute{
+			MarkdownDescription: "Switching ASIC (e.g. `TD3`).",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"userland": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Userland word size in bits.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.OneOf(32, 64)},
+		},
+		"ecmp_limit": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Maximum number of ECMP paths.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+	}
+}
+
+func (o profileHardwareCapabilities) copyTo(_ context.Context, out *device.HardwareCapabilities, _ *diag.Diagnostics) {
+	out.CPU = o.Cpu.ValueString()
+	out.RAM = int(o.Ram.ValueInt64())
+	out.FormFactor = o.FormFactor.ValueString()
+	out.ASIC = o.Asic.ValueString()
+	out.Userland = int(o.Userland.ValueInt64())
+	out.ECMPLimit = int(o.EcmpLimit.ValueInt64())
+}
+
+func (o *profileHardwareCapabilities) loadApiData(ctx context.Context, in device.HardwareCapabilities, diags *diag.Diagnostics) {
+	o.Cpu = types.StringValue(in.CPU)
+	o.Ram = types.Int64Value(int64(in.RAM))
+	o.FormFactor = types.StringValue(in.FormFactor)
+	o.Asic = value.StringOrNull(ctx, in.ASIC, diags)
+	o.Userland = types.Int64Value(int64(in.Userland))
+	o.EcmpLimit = types.Int64Value(int64(in.ECMPLimit))
+}
+
+type profileSoftwareCapabilities struct {
+	Onie               types.Bool   `tfsdk:"onie"`
+	ConfigApplySupport types.String `tfsdk:"config_apply_support"`
+	LxcSupport         types.Bool   `tfsdk:"lxc_support"`
+	LldpSupport        types.Bool   `tfsdk:"lldp_support"`
+}
+
+func (o profileSoftwareCapabilities) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"onie":                 types.BoolType,
+		"config_apply_support": types.StringType,
+		"lxc_support":          types.BoolType,
+		"lldp_support":         types.BoolType,
+	}
+}
+
+// defaultObject returns the value used when software_capabilities is omitted
+// from the configuration.
+func (o profileSoftwareCapabilities) defaultObject() types.Object {
+	return types.ObjectValueMust(o.attrTypes(), map[string]attr.Value{
+		"onie":                 types.BoolValue(false),
+		"config_apply_support": types.StringValue(profileConfigApplySupportCompleteOnly),
+		"lxc_support":          types.BoolValue(false),
+		"lldp_support":         types.BoolValue(true),
+	})
+}
+
+func (o profileSoftwareCapabilities) dataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"onie": dataSourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether the device is an ONIE device.",
+			Computed:            true,
+		},
+		"config_apply_support": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Configuration application method supported by the device.",
+			Computed:            true,
+		},
+		"lxc_support": dataSourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether the device supports LXC containers.",
+			Computed:            true,
+		},
+		"lldp_support": dataSourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether the device supports LLDP.",
+			Computed:            true,
+		},
+	}
+}
+
+func (o profileSoftwareCapabilities) resourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"onie": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether the device is an ONIE device. Default: `false`",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"config_apply_support": resourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Configuration application method supported by the device. "+
+				"Must be one of `%s` or `%s`. Default: `%s`",
+				profileConfigApplySupportCompleteOnly, profileConfigApplySupportCompleteAndIncremental,
+				profileConfigApplySupportCompleteOnly),
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(profileConfigApplySupportCompleteOnly),
+			Validators: []validator.String{stringvalidator.OneOf(
+				profileConfigApplySupportCompleteOnly,
+				profileConfigApplySupportCompleteAndIncremental,
+			)},
+		},
+		"lxc_support": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether the device supports LXC containers. Default: `false`",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"lldp_support": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether the device supports LLDP. Default: `true`",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+	}
+}
+
+func (o profileSoftwareCapabilities) copyTo(_ context.Context, out *device.SoftwareCapabilities, _ *diag.Diagnostics) {
+	out.ONIE = o.Onie.ValueBool()
+	out.ConfigApplySupport = o.ConfigApplySupport.ValueString()
+	out.LXCSupport = o.LxcSupport.ValueBool()
+	out.LLDPSupport = o.LldpSupport.ValueBool()
+}
+
+func (o *profileSoftwareCapabilities) loadApiData(_ context.Context, in device.SoftwareCapabilities, _ *diag.Diagnostics) {
+	o.Onie = types.BoolValue(in.ONIE)
+	o.ConfigApplySupport = types.StringValue(in.ConfigApplySupport)
+	o.LxcSupport = types.BoolValue(in.LXCSupport)
+	o.LldpSupport = types.BoolValue(in.LLDPSupport)
+}
@@ -0,0 +1,179 @@
+package device
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/device"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type profilePort struct {
+	PortId          types.Int64  `tfsdk:"port_id"`
+	PanelId         types.Int64  `tfsdk:"panel_id"`
+	RowId           types.Int64  `tfsdk:"row_id"`
+	ColumnId        types.Int64  `tfsdk:"column_id"`
+	SlotId          types.Int64  `tfsdk:"slot_id"`
+	FailureDomainId types.Int64  `tfsdk:"failure_domain_id"`
+	ConnectorType   types.String `tfsdk:"connector_type"`
+	Transformations types.List   `tfsdk:"transformations"`
+}
+
+func (o profilePort) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"port_id":           types.Int64Type,
+		"panel_id":          types.Int64Type,
+		"row_id":            types.Int64Type,
+		"column_id":         types.Int64Type,
+		"slot_id":           types.Int64Type,
+		"failure_domain_id": types.Int64Type,
+		"connector_type":    types.StringType,
+		"transformations":   types.ListType{ElemType: types.ObjectType{AttrTypes: profilePortTransformation{}.attrTypes()}},
+	}
+}
+
+func (o profilePort) dataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"port_id": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Port ID, unique within the Device Profile.",
+			Computed:            true,
+		},
+		"panel_id": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "ID of the panel on which the port is found.",
+			Computed:            true,
+		},
+		"row_id": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Row (within the panel) in which the port is found.",
+			Computed:            true,
+		},
+		"column_id": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Column (within the panel) in which the port is found.",
+			Computed:            true,
+		},
+		"slot_id": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Slot in which the port is found.",
+			Computed:            true,
+		},
+		"failure_domain_id": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Failure domain to which the port belongs.",
+			Computed:            true,
+		},
+		"connector_type": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Physical connector type (e.g. `sfp`, `qsfp28`).",
+			Computed:            true,
+		},
+		"transformations": dataSourceSchema.ListNestedAttribute{
+			MarkdownDescription: "Supported configurations (speed, breakout, etc.) of the port.",
+			Computed:            true,
+			NestedObject: dataSourceSchema.NestedAttributeObject{
+				Attributes: profilePortTransformation{}.dataSourceAttributes(),
+			},
+		},
+	}
+}
+
+func (o profilePort) resourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"port_id": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Port ID, unique within the Device Profile.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"panel_id": resourceSchema.Int64Attribute{
+			MarkdownDescription: "ID of the panel on which the port is found.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"row_id": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Row (within the panel) in which the port is found.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"column_id": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Column (within the panel) in which the port is found.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"slot_id": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Slot in which the port is found. Default: `0`",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+			Validators:          []validator.Int64{int64validator.AtLeast(0)},
+		},
+		"failure_domain_id": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Failure domain to which the port belongs. Default: `1`",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(1),
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"connector_type": resourceSchema.StringAttribute{
+			MarkdownDescription: "Physical connector type (e.g. `sfp`, `qsfp28`).",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"transformations": resourceSchema.ListNestedAttribute{
+			MarkdownDescription: "Supported configurations (speed, breakout, etc.) of the port. Exactly one " +
+				"transformation should be marked `is_default`.",
+			Required:   true,
+			Validators: []validator.List{listvalidator.SizeAtLeast(1)},
+			NestedObject: resourceSchema.NestedAttributeObject{
+				Attributes: profilePortTransformation{}.resourceAttributes(),
+			},
+		},
+	}
+}
+
+// copyTo sets the fields of out modeled by o. Transformations are matched with
+// those already in out by ID.
+func (o profilePort) copyTo(ctx context.Context, out *device.Port, diags *diag.Diagnostics) {
+	var transformations []profilePortTransformation
+	diags.Append(o.Transformations.ElementsAs(ctx, &transformations, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	existing := make(map[int]device.Transformation, len(out.Transformations))
+	for _, transformation := range out.Transformations {
+		existing[transformation.ID] = transformation
+	}
+
+	out.ID = int(o.PortId.ValueInt64())
+	out.PanelID = int(o.PanelId.ValueInt64())
+	out.RowID = int(o.RowId.ValueInt64())
+	out.ColumnID = int(o.ColumnId.ValueInt64())
+	out.SlotID = int(o.SlotId.ValueInt64())
+	out.FailureDomainID = int(o.FailureDomainId.ValueInt64())
+	out.ConnectorType = o.ConnectorType.ValueString()
+	out.Transformations = make([]device.Transformation, len(transformations))
+	for i, transformation := range transformations {
+		out.Transformations[i] = existing[int(transformation.TransformationId.ValueInt64())]
+		transformation.copyTo(ctx, &out.Transformations[i], diags)
+	}
+}
+
+func (o *profilePort) loadApiData(ctx context.Context, in device.Port, diags *diag.Diagnostics) {
+	transformations := make([]profilePortTransformation, len(in.Transformations))
+	for i, transformation := range in.Transformations {
+		transformations[i].loadApiData(ctx, transformation, diags)
+	}
+
+	o.PortId = types.Int64Value(int64(in.ID))
+	o.PanelId = types.Int64Value(int64(in.PanelID))
+	o.RowId = types.Int64Value(int64(in.RowID))
+	o.ColumnId = types.Int64Value(int64(in.ColumnID))
+	o.SlotId = types.Int64Value(int64(in.SlotID))
+	o.FailureDomainId = types.Int64Value(int64(in.FailureDomainID))
+	o.ConnectorType = types.StringValue(in.ConnectorType)
+	o.Transformations = value.ListOrNull(ctx, types.ObjectType{AttrTypes: profilePortTransformation{}.attrTypes()}, transformations, diags)
+}
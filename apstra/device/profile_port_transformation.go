@@ -0,0 +1,227 @@
+package device
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Juniper/apstra-go-sdk/device"
+	"github.com/Juniper/apstra-go-sdk/enum"
+	"github.com/Juniper/apstra-go-sdk/speed"
+	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	profileInterfaceStateActive   = "active"
+	profileInterfaceStateInactive = "inactive"
+)
+
+type profilePortTransformation struct {
+	TransformationId types.Int64 `tfsdk:"transformation_id"`
+	IsDefault        types.Bool  `tfsdk:"is_default"`
+	Interfaces       types.List  `tfsdk:"interfaces"`
+}
+
+func (o profilePortTransformation) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"transformation_id": types.Int64Type,
+		"is_default":        types.BoolType,
+		"interfaces":        types.ListType{ElemType: types.ObjectType{AttrTypes: profilePortInterface{}.attrTypes()}},
+	}
+}
+
+func (o profilePortTransformation) dataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"transformation_id": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Transformation ID, unique within the port. Referenced by the " +
+				"`transformation_id` attribute of `apstra_interface_map` interfaces.",
+			Computed: true,
+		},
+		"is_default": dataSourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether this is the port's default transformation.",
+			Computed:            true,
+		},
+		"interfaces": dataSourceSchema.ListNestedAttribute{
+			MarkdownDescription: "Interfaces created by the transformation. Breakout transformations " +
+				"create more than one interface.",
+			Computed: true,
+			NestedObject: dataSourceSchema.NestedAttributeObject{
+				Attributes: profilePortInterface{}.dataSourceAttributes(),
+			},
+		},
+	}
+}
+
+func (o profilePortTransformation) resourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"transformation_id": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Transformation ID, unique within the port. Referenced by the " +
+				"`transformation_id` attribute of `apstra_interface_map` interfaces.",
+			Required:   true,
+			Validators: []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"is_default": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Indicates whether this is the port's default transformation. Default: `false`",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"interfaces": resourceSchema.ListNestedAttribute{
+			MarkdownDescription: "Interfaces created by the transformation. Breakout transformations " +
+				"create more than one interface.",
+			Required:   true,
+			Validators: []validator.List{listvalidator.SizeAtLeast(1)},
+			NestedObject: resourceSchema.NestedAttributeObject{
+				Attributes: profilePortInterface{}.resourceAttributes(),
+			},
+		},
+	}
+}
+
+// copyTo sets the fields of out modeled by o. Interfaces are matched with
+// those already in out by ID.
+func (o profilePortTransformation) copyTo(ctx context.Context, out *device.Transformation, diags *diag.Diagnostics) {
+	var interfaces []profilePortInterface
+	diags.Append(o.Interfaces.ElementsAs(ctx, &interfaces, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	existing := make(map[int]device.Interface, len(out.Interfaces))
+	for _, intf := range out.Interfaces {
+		existing[intf.ID] = intf
+	}
+
+	out.ID = int(o.TransformationId.ValueInt64())
+	out.IsDefault = o.IsDefault.ValueBool()
+	out.Interfaces = make([]device.Interface, len(interfaces))
+	for i, intf := range interfaces {
+		out.Interfaces[i] = existing[int(intf.InterfaceId.ValueInt64())]
+		intf.copyTo(ctx, &out.Interfaces[i], diags)
+	}
+}
+
+func (o *profilePortTransformation) loadApiData(ctx context.Context, in device.Transformation, diags *diag.Diagnostics) {
+	interfaces := make([]profilePortInterface, len(in.Interfaces))
+	for i, intf := range in.Interfaces {
+		interfaces[i].loadApiData(ctx, intf, diags)
+	}
+
+	o.TransformationId = types.Int64Value(int64(in.ID))
+	o.IsDefault = types.BoolValue(in.IsDefault)
+	o.Interfaces = value.ListOrNull(ctx, types.ObjectType{AttrTypes: profilePortInterface{}.attrTypes()}, interfaces, diags)
+}
+
+type profilePortInterface struct {
+	InterfaceId types.Int64          `tfsdk:"interface_id"`
+	Name        types.String         `tfsdk:"name"`
+	State       types.String         `tfsdk:"state"`
+	Speed       types.String         `tfsdk:"speed"`
+	Setting     jsontypes.Normalized `tfsdk:"setting"`
+}
+
+func (o profilePortInterface) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"interface_id": types.Int64Type,
+		"name":         types.StringType,
+		"state":        types.StringType,
+		"speed":        types.StringType,
+		"setting":      jsontypes.NormalizedType{},
+	}
+}
+
+func (o profilePortInterface) dataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"interface_id": dataSourceSchema.Int64Attribute{
+			MarkdownDescription: "Interface ID, unique within the transformation.",
+			Computed:            true,
+		},
+		"name": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Interface name (e.g. `xe-0/0/0`).",
+			Computed:            true,
+		},
+		"state": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Interface state (`active` or `inactive`).",
+			Computed:            true,
+		},
+		"speed": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Interface speed (e.g. `10G`).",
+			Computed:            true,
+		},
+		"setting": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Platform-specific interface settings in JSON format.",
+			CustomType:          jsontypes.NormalizedType{},
+			Computed:            true,
+		},
+	}
+}
+
+func (o profilePortInterface) resourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"interface_id": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Interface ID, unique within the transformation.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"name": resourceSchema.StringAttribute{
+			MarkdownDescription: "Interface name (e.g. `xe-0/0/0`).",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"state": resourceSchema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Interface state. Must be one of `%s` or `%s`. Default: `%s`",
+				profileInterfaceStateActive, profileInterfaceStateInactive, profileInterfaceStateActive),
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(profileInterfaceStateActive),
+			Validators: []validator.String{
+				stringvalidator.OneOf(profileInterfaceStateActive, profileInterfaceStateInactive),
+			},
+		},
+		"speed": resourceSchema.StringAttribute{
+			MarkdownDescription: "Interface speed (e.g. `10G`).",
+			Required:            true,
+			Validators:          []validator.String{apstravalidator.ParseSpeed()},
+		},
+		"setting": resourceSchema.StringAttribute{
+			MarkdownDescription: "Platform-specific interface settings in JSON format.",
+			CustomType:          jsontypes.NormalizedType{},
+			Optional:            true,
+		},
+	}
+}
+
+func (o profilePortInterface) copyTo(_ context.Context, out *device.Interface, diags *diag.Diagnostics) {
+	var state enum.InterfaceState
+	err := state.FromString(o.State.ValueString())
+	if err != nil {
+		diags.AddError("failed to parse interface state", err.Error()) // should have been caught by the validator
+		return
+	}
+
+	out.ID = int(o.InterfaceId.ValueInt64())
+	out.Name = o.Name.ValueString()
+	out.State = state
+	out.Speed = speed.Speed(o.Speed.ValueString())
+	out.Setting = o.Setting.ValueStringPointer()
+}
+
+func (o *profilePortInterface) loadApiData(_ context.Context, in device.Interface, _ *diag.Diagnostics) {
+	o.InterfaceId = types.Int64Value(int64(in.ID))
+	o.Name = types.StringValue(in.Name)
+	o.State = types.StringValue(in.State.String())
+	o.Speed = types.StringValue(string(in.Speed))
+	o.Setting = jsontypes.NewNormalizedPointerValue(in.Setting)
+}
@@ -0,0 +1,91 @@
+package device
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/device"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type profileSelector struct {
+	Manufacturer types.String `tfsdk:"manufacturer"`
+	Model        types.String `tfsdk:"model"`
+	Os           types.String `tfsdk:"os"`
+	OsVersion    types.String `tfsdk:"os_version"`
+}
+
+func (o profileSelector) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"manufacturer": types.StringType,
+		"model":        types.StringType,
+		"os":           types.StringType,
+		"os_version":   types.StringType,
+	}
+}
+
+func (o profileSelector) dataSourceAttributes() map[string]dataSourceSchema.Attribute {
+	return map[string]dataSourceSchema.Attribute{
+		"manufacturer": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Manufacturer reported by devices matched by this Device Profile.",
+			Computed:            true,
+		},
+		"model": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Regular expression matching the model reported by devices.",
+			Computed:            true,
+		},
+		"os": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Operating system reported by devices matched by this Device Profile.",
+			Computed:            true,
+		},
+		"os_version": dataSourceSchema.StringAttribute{
+			MarkdownDescription: "Regular expression matching the operating system version reported by devices.",
+			Computed:            true,
+		},
+	}
+}
+
+func (o profileSelector) resourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"manufacturer": resourceSchema.StringAttribute{
+			MarkdownDescription: "Manufacturer reported by devices matched by this Device Profile (e.g. `Juniper`).",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"model": resourceSchema.StringAttribute{
+			MarkdownDescription: "Regular expression matching the model reported by devices (e.g. `QFX5120-48Y`).",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"os": resourceSchema.StringAttribute{
+			MarkdownDescription: "Operating system reported by devices matched by this Device Profile (e.g. `Junos`).",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"os_version": resourceSchema.StringAttribute{
+			MarkdownDescription: "Regular expression matching the operating system version reported by devices " +
+				"(e.g. `.*`).",
+			Required:   true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	}
+}
+
+func (o profileSelector) copyTo(_ context.Context, out *device.Selector, _ *diag.Diagnostics) {
+	out.Manufacturer = o.Manufacturer.ValueString()
+	out.Model = o.Model.ValueString()
+	out.OS = o.Os.ValueString()
+	out.OSVersion = o.OsVersion.ValueString()
+}
+
+func (o *profileSelector) loadApiData(_ context.Context, in device.Selector, _ *diag.Diagnostics) {
+	o.Manufacturer = types.StringValue(in.Manufacturer)
+	o.Model = types.StringValue(in.Model)
+	o.Os = types.StringValue(in.OS)
+	o.OsVersion = types.StringValue(in.OSVersion)
+}
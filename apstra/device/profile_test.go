@@ -0,0 +1,84 @@
+package device
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Juniper/apstra-go-sdk/device"
+	"github.com/Juniper/apstra-go-sdk/enum"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileRequest(t *testing.T) {
+	ctx := context.Background()
+
+	setting := `{"global":{"speed":"10g"}}`
+	existing := device.Profile{
+		Label: "old name",
+		Ports: []device.Port{
+			{
+				ID:            1,
+				ConnectorType: "sfp",
+				Transformations: []device.Transformation{{
+					ID:        1,
+					IsDefault: true,
+					Interfaces: []device.Interface{{
+						ID:      1,
+						Name:    "xe-0/0/0",
+						State:   enum.InterfaceStateActive,
+						Speed:   "10G",
+						Setting: &setting,
+					}},
+				}},
+			},
+			{
+				ID:            2,
+				ConnectorType: "sfp",
+				Transformations: []device.Transformation{{
+					ID:        1,
+					IsDefault: true,
+					Interfaces: []device.Interface{{
+						ID:    1,
+						Name:  "xe-0/0/1",
+						State: enum.InterfaceStateActive,
+						Speed: "10G",
+					}},
+				}},
+			},
+		},
+	}
+	require.NoError(t, existing.SetID("dp_id"))
+
+	var diags diag.Diagnostics
+	var plan Profile
+	plan.LoadApiData(ctx, existing, &diags)
+	require.False(t, diags.HasError())
+	require.Equal(t, "dp_id", plan.Id.ValueString())
+
+	// rename the profile and drop the second port
+	plan.Name = types.StringValue("new name")
+	plan.Ports = types.ListValueMust(types.ObjectType{AttrTypes: profilePort{}.attrTypes()}, plan.Ports.Elements()[:1])
+
+	result := plan.Request(ctx, &existing, &diags)
+	require.False(t, diags.HasError())
+	require.NotNil(t, result)
+
+	// the ID and unchanged values are carried over from the existing profile
+	require.NotNil(t, result.ID())
+	require.Equal(t, "dp_id", *result.ID())
+	require.Equal(t, "new name", result.Label)
+	require.Len(t, result.Ports, 1)
+	require.Equal(t, existing.Ports[0], result.Ports[0])
+
+	// the existing profile is not modified
+	require.Equal(t, "old name", existing.Label)
+	require.Len(t, existing.Ports, 2)
+
+	// without an existing profile, the request has no ID
+	result = plan.Request(ctx, nil, &diags)
+	require.False(t, diags.HasError())
+	require.Nil(t, result.ID())
+	require.Equal(t, "new name", result.Label)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
 	"github.com/Juniper/terraform-provider-apstra/internal/pointer"
 	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -151,3 +152,45 @@ func (o *agentProfile) loadApiData(ctx context.Context, in *apstra.AgentProfile,
 	o.Packages = value.MapOrNull(ctx, types.StringType, in.Packages, diags)
 	o.OpenOptions = value.MapOrNull(ctx, types.StringType, in.OpenOptions, diags)
 }
+
+// agentProfileWithCredentials is the model of the apstra_agent_profile
+// resource. It extends agentProfile, which is shared with data sources, with
+// the write-only credential attributes.
+type agentProfileWithCredentials struct {
+	agentProfile
+	UsernameWo           types.String `tfsdk:"username_wo"`
+	PasswordWo           types.String `tfsdk:"password_wo"`
+	CredentialsWoVersion types.Int64  `tfsdk:"credentials_wo_version"`
+}
+
+func (o agentProfileWithCredentials) resourceAttributes() map[string]resourceSchema.Attribute {
+	result := o.agentProfile.resourceAttributes()
+	result["username_wo"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Username used by agents deployed with this profile to log in to devices. This " +
+			"attribute is write-only: its value is sent to Apstra when the Agent Profile is created (and " +
+			"whenever `credentials_wo_version` changes), but is never saved in the Terraform plan or state. " +
+			"The value may be supplied by an ephemeral resource. Requires Terraform 1.11 or later.",
+		Optional:   true,
+		WriteOnly:  true,
+		Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	result["password_wo"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Password used by agents deployed with this profile to log in to devices. This " +
+			"attribute is write-only: its value is sent to Apstra when the Agent Profile is created (and " +
+			"whenever `credentials_wo_version` changes), but is never saved in the Terraform plan or state. " +
+			"The value may be supplied by an ephemeral resource. Requires Terraform 1.11 or later.",
+		Optional:   true,
+		WriteOnly:  true,
+		Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	result["credentials_wo_version"] = resourceSchema.Int64Attribute{
+		MarkdownDescription: utils.WriteOnlyVersionDescription("username_wo", "password_wo") +
+			" Requires both `username_wo` and `password_wo`.",
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+			int64validator.AlsoRequires(path.MatchRoot("username_wo"), path.MatchRoot("password_wo")),
+		},
+	}
+	return result
+}
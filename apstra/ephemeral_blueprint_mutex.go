@@ -0,0 +1,98 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/Juniper/terraform-provider-apstra/apstra/private"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*ephemeralBlueprintMutex)(nil)
+	_ ephemeral.EphemeralResourceWithClose     = (*ephemeralBlueprintMutex)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*ephemeralBlueprintMutex)(nil)
+	_ ephemeralWithSetBpMutexLocker            = (*ephemeralBlueprintMutex)(nil)
+)
+
+type ephemeralBlueprintMutex struct {
+	locker *blueprintMutexLocker
+}
+
+func (o *ephemeralBlueprintMutex) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_mutex"
+}
+
+func (o *ephemeralBlueprintMutex) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryRefDesignAny + "This Ephemeral Resource locks a Blueprint mutex on Open " +
+			"and unlocks it on Close, so that the Blueprint is locked for the duration of each `terraform plan` " +
+			"or `terraform apply` run which references it. Opening this resource waits for the mutex according " +
+			"to the `blueprint_mutex_timeout` and `blueprint_mutex_ttl` provider attributes, whether or not " +
+			"`blueprint_mutex_enabled` is set.\n\n" +
+			"When `blueprint_mutex_enabled` is `true`, other resources in the same run treat the mutex as their " +
+			"own rather than waiting for it. A mutex which has already been unlocked (e.g. by an " +
+			"`apstra_blueprint_deployment` resource) when this resource is closed is not an error. [More info " +
+			"about Blueprint mutexes here](https://github.com/Juniper/terraform-provider-apstra/blob/main/kb/blueprint_mutex.md).",
+		Attributes: blueprint.Mutex{}.EphemeralAttributes(),
+	}
+}
+
+func (o *ephemeralBlueprintMutex) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	configureEphemeral(ctx, o, req, resp)
+}
+
+func (o *ephemeralBlueprintMutex) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config blueprint.Mutex
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lock the mutex
+	tag, err := o.locker.lockAndHold(ctx, config.BlueprintId.ValueString(), config.Message.ValueString(), &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error locking blueprint %q mutex", config.BlueprintId.ValueString()), err.Error())
+		return
+	}
+
+	// Load the computed values
+	config.LoadApiData(ctx, tag, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// save the private state so that Close() can find the mutex
+	privateEphemeralBlueprintMutex := private.EphemeralBlueprintMutex{
+		BlueprintId: config.BlueprintId.ValueString(),
+		TagId:       config.TagId.ValueString(),
+	}
+	privateEphemeralBlueprintMutex.SetPrivateState(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set the result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+func (o *ephemeralBlueprintMutex) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	// extract the private state data
+	var privateEphemeralBlueprintMutex private.EphemeralBlueprintMutex
+	privateEphemeralBlueprintMutex.LoadPrivateState(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := o.locker.release(ctx, privateEphemeralBlueprintMutex.BlueprintId, privateEphemeralBlueprintMutex.TagId)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error unlocking blueprint %q mutex", privateEphemeralBlueprintMutex.BlueprintId), err.Error())
+		return
+	}
+}
+
+func (o *ephemeralBlueprintMutex) setBpMutexLocker(locker *blueprintMutexLocker) {
+	o.locker = locker
+}
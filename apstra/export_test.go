@@ -55,12 +55,24 @@ var (
 	ResourceIntegerPool                                    = resourceIntegerPool{}
 	ResourceIpv4Pool                                       = resourceIpv4Pool{}
 	ResourceIpv6Pool                                       = resourceIpv6Pool{}
+	ResourceManagedDevice                                  = resourceManagedDevice{}
 	ResourceTelemetryServiceRegistryEntry                  = resourceTelemetryServiceRegistryEntry{}
 	ResourceTemplateCollapsed                              = resourceTemplateCollapsed{}
 	ResourceTemplatePodBased                               = resourceTemplatePodBased{}
 	ResourceVniPool                                        = resourceVniPool{}
 )
 
+// ForgetBlueprintMutexes discards this process' record of the blueprint
+// mutexes held by the provider, as happens when terraform starts a new
+// provider process.
+func ForgetBlueprintMutexes() {
+	blueprintMutexesMutex.Lock()
+	defer blueprintMutexesMutex.Unlock()
+
+	blueprintMutexes = nil
+	clear(blueprintMutexMessages)
+}
+
 func DatasourceName(ctx context.Context, d datasource.DataSource) string {
 	var pMdReq provider.MetadataRequest
 	var pMdResp provider.MetadataResponse
@@ -0,0 +1,73 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*functionCidrWithin)(nil)
+
+type functionCidrWithin struct{}
+
+func (o *functionCidrWithin) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_within"
+}
+
+func (o *functionCidrWithin) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Determine whether an IP address falls within a CIDR block.",
+		MarkdownDescription: "Returns `true` when `address` falls within `cidr_block`, using the same logic " +
+			"this provider uses to validate addresses which must fall within a subnet (e.g. a virtual " +
+			"network's gateway address). The first (\"all zeros\") and last (\"all ones\") addresses of the " +
+			"block are considered to be within it only if permitted by the corresponding arguments. Works " +
+			"with both IPv4 and IPv6.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "address",
+				MarkdownDescription: "IP address to test, e.g. `192.0.2.1`.",
+			},
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "CIDR block, e.g. `192.0.2.0/24`.",
+			},
+			function.BoolParameter{
+				Name:                "all_zeros_ok",
+				MarkdownDescription: "Whether the first address of the block (the address portion of `cidr_block`) is acceptable.",
+			},
+			function.BoolParameter{
+				Name:                "all_ones_ok",
+				MarkdownDescription: "Whether the last (broadcast) address of the block is acceptable.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (o *functionCidrWithin) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var address, cidrBlock string
+	var allZerosOk, allOnesOk bool
+	resp.Error = req.Arguments.Get(ctx, &address, &cidrBlock, &allZerosOk, &allOnesOk)
+	if resp.Error != nil {
+		return
+	}
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("cannot parse %q as an IP address", address))
+		return
+	}
+
+	_, _, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("cannot parse %q as a CIDR block: %s", cidrBlock, err))
+		return
+	}
+
+	within := apstravalidator.IpFallsWithinCidr(ip, cidrBlock, allZerosOk, allOnesOk) == nil
+
+	resp.Error = resp.Result.Set(ctx, within)
+}
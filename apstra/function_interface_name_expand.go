@@ -0,0 +1,50 @@
+package tfapstra
+
+import (
+	"context"
+
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*functionInterfaceNameExpand)(nil)
+
+type functionInterfaceNameExpand struct{}
+
+func (o *functionInterfaceNameExpand) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interface_name_expand"
+}
+
+func (o *functionInterfaceNameExpand) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Expand a Junos or EOS interface range into individual interface names.",
+		MarkdownDescription: "Expands an interface range into a list of interface names. The final numeric " +
+			"component of the name may be a range in Junos style (`ge-0/0/[0-3]`, `xe-0/0/0:[0-3]` or " +
+			"`xe-0/0/0-3`) or EOS style (`Ethernet1/1-4` or `Ethernet1-4,7`). A name without a range " +
+			"expands to a single-element list. Names are returned in the order specified.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "interface_range",
+				MarkdownDescription: "Interface name or range, e.g. `ge-0/0/[0-3]` or `Ethernet1/1-4`.",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (o *functionInterfaceNameExpand) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var in string
+	resp.Error = req.Arguments.Get(ctx, &in)
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := utils.ExpandInterfaceNames(in)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
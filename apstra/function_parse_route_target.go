@@ -0,0 +1,72 @@
+package tfapstra
+
+import (
+	"context"
+
+	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*functionParseRouteTarget)(nil)
+
+type functionParseRouteTarget struct{}
+
+func (o *functionParseRouteTarget) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_route_target"
+}
+
+func (o *functionParseRouteTarget) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":            types.StringType,
+		"administrator":   types.StringType,
+		"assigned_number": types.Int64Type,
+	}
+}
+
+func (o *functionParseRouteTarget) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a Route Target string into its component parts.",
+		MarkdownDescription: "Validates a Route Target string using the same rules applied to Route Target " +
+			"attributes elsewhere in this provider, and returns an object with attributes `type` (one of `" +
+			apstravalidator.RouteTargetTypeTwoOctetAs + "`, `" + apstravalidator.RouteTargetTypeFourOctetAs +
+			"` or `" + apstravalidator.RouteTargetTypeIpv4 + "`), `administrator` (the ASN or IPv4 address " +
+			"portion, as a string) and `assigned_number`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "route_target",
+				MarkdownDescription: "Route Target in one of the forms `<2-byte-value>:<4-byte-value>`, " +
+					"`<4-byte-value>:<2-byte-value>` or `<IPv4-address>:<2-byte-value>`. Leading zeros are " +
+					"not permitted.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: o.attrTypes()},
+	}
+}
+
+func (o *functionParseRouteTarget) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var in string
+	resp.Error = req.Arguments.Get(ctx, &in)
+	if resp.Error != nil {
+		return
+	}
+
+	rt, err := apstravalidator.ParseRouteTarget(in)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, d := types.ObjectValue(o.attrTypes(), map[string]attr.Value{
+		"type":            types.StringValue(rt.Type),
+		"administrator":   types.StringValue(rt.Administrator),
+		"assigned_number": types.Int64Value(int64(rt.AssignedNumber)),
+	})
+	if d.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, d)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,50 @@
+package tfapstra
+
+import (
+	"context"
+	"strings"
+
+	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*functionSpeedNormalize)(nil)
+
+type functionSpeedNormalize struct{}
+
+func (o *functionSpeedNormalize) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "speed_normalize"
+}
+
+func (o *functionSpeedNormalize) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a link speed to the format expected by Apstra.",
+		MarkdownDescription: "Converts a loosely formatted link speed such as `10g`, `25 Gbps`, `40Gb/s` or " +
+			"`1000M` to the canonical form accepted by speed attributes elsewhere in this provider (`10G`, " +
+			"`25G`, `40G`, `1G`). Supported speeds are: `" + strings.Join(apstravalidator.ValidSpeeds, "`, `") +
+			"`. An error is produced for any other speed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "speed",
+				MarkdownDescription: "Link speed: a number followed by a unit of `M`, `G` or `T`, optionally followed by `bps` or `b/s`. Case insensitive.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (o *functionSpeedNormalize) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var in string
+	resp.Error = req.Arguments.Get(ctx, &in)
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := apstravalidator.NormalizeSpeed(in)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,55 @@
+package tfapstra
+
+import (
+	"context"
+
+	"github.com/Juniper/terraform-provider-apstra/apstra/design"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*functionVlanRange)(nil)
+
+type functionVlanRange struct{}
+
+func (o *functionVlanRange) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vlan_range"
+}
+
+func (o *functionVlanRange) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Expand a VLAN range string into a list of VLAN IDs.",
+		MarkdownDescription: "Expands a comma-separated list of VLAN IDs and hyphenated ranges (e.g. " +
+			"`10-12,20`) into a list of numbers (`[10, 11, 12, 20]`). VLAN IDs must fall between 1 and " +
+			"4094. VLANs are returned in the order specified; specifying a VLAN more than once is an error.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vlan_range",
+				MarkdownDescription: "VLAN range string, e.g. `10-12,20`.",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.Int64Type},
+	}
+}
+
+func (o *functionVlanRange) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var in string
+	resp.Error = req.Arguments.Get(ctx, &in)
+	if resp.Error != nil {
+		return
+	}
+
+	vlans, err := utils.ExpandIntRanges(in, design.VlanMin, design.VlanMax)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result := make([]int64, len(vlans))
+	for i, vlan := range vlans {
+		result[i] = int64(vlan)
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,115 @@
+package tfapstra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderFunctions(t *testing.T) {
+	ctx := context.Background()
+
+	type testCase struct {
+		function function.Function
+		args     []attr.Value
+		expected attr.Value
+		expErr   bool
+	}
+
+	rtAttrTypes := new(functionParseRouteTarget).attrTypes()
+
+	testCases := map[string]testCase{
+		"parse_route_target": {
+			function: new(functionParseRouteTarget),
+			args:     []attr.Value{types.StringValue("192.0.2.1:100")},
+			expected: types.ObjectValueMust(rtAttrTypes, map[string]attr.Value{
+				"type":            types.StringValue("ipv4"),
+				"administrator":   types.StringValue("192.0.2.1"),
+				"assigned_number": types.Int64Value(100),
+			}),
+		},
+		"parse_route_target_invalid": {
+			function: new(functionParseRouteTarget),
+			args:     []attr.Value{types.StringValue("65536:65536")},
+			expErr:   true,
+		},
+		"cidr_within": {
+			function: new(functionCidrWithin),
+			args:     []attr.Value{types.StringValue("192.0.2.1"), types.StringValue("192.0.2.0/24"), types.BoolValue(false), types.BoolValue(false)},
+			expected: types.BoolValue(true),
+		},
+		"cidr_within_all_ones": {
+			function: new(functionCidrWithin),
+			args:     []attr.Value{types.StringValue("192.0.2.255"), types.StringValue("192.0.2.0/24"), types.BoolValue(false), types.BoolValue(false)},
+			expected: types.BoolValue(false),
+		},
+		"cidr_within_outside": {
+			function: new(functionCidrWithin),
+			args:     []attr.Value{types.StringValue("198.51.100.1"), types.StringValue("192.0.2.0/24"), types.BoolValue(true), types.BoolValue(true)},
+			expected: types.BoolValue(false),
+		},
+		"cidr_within_bad_cidr": {
+			function: new(functionCidrWithin),
+			args:     []attr.Value{types.StringValue("192.0.2.1"), types.StringValue("192.0.2.0"), types.BoolValue(true), types.BoolValue(true)},
+			expErr:   true,
+		},
+		"interface_name_expand": {
+			function: new(functionInterfaceNameExpand),
+			args:     []attr.Value{types.StringValue("ge-0/0/[0-1]")},
+			expected: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ge-0/0/0"), types.StringValue("ge-0/0/1")}),
+		},
+		"interface_name_expand_invalid": {
+			function: new(functionInterfaceNameExpand),
+			args:     []attr.Value{types.StringValue("Ethernet4-1")},
+			expErr:   true,
+		},
+		"speed_normalize": {
+			function: new(functionSpeedNormalize),
+			args:     []attr.Value{types.StringValue("100 Gbps")},
+			expected: types.StringValue("100G"),
+		},
+		"speed_normalize_invalid": {
+			function: new(functionSpeedNormalize),
+			args:     []attr.Value{types.StringValue("2.5G")},
+			expErr:   true,
+		},
+		"vlan_range": {
+			function: new(functionVlanRange),
+			args:     []attr.Value{types.StringValue("10-12,20")},
+			expected: types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(10), types.Int64Value(11), types.Int64Value(12), types.Int64Value(20)}),
+		},
+		"vlan_range_invalid": {
+			function: new(functionVlanRange),
+			args:     []attr.Value{types.StringValue("4090-4095")},
+			expErr:   true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			var defResp function.DefinitionResponse
+			tCase.function.Definition(ctx, function.DefinitionRequest{}, &defResp)
+			require.False(t, defResp.Diagnostics.HasError(), defResp.Diagnostics)
+
+			result, funcErr := defResp.Definition.Return.NewResultData(ctx)
+			require.Nil(t, funcErr)
+
+			req := function.RunRequest{Arguments: function.NewArgumentsData(tCase.args)}
+			resp := function.RunResponse{Result: result}
+			tCase.function.Run(ctx, req, &resp)
+			if tCase.expErr {
+				require.NotNil(t, resp.Error)
+				return
+			}
+
+			require.Nil(t, resp.Error)
+			require.Equal(t, tCase.expected, resp.Result.Value())
+		})
+	}
+}
@@ -2,14 +2,16 @@ package iba
 
 import (
 	"context"
+
 	"github.com/Juniper/apstra-go-sdk/apstra"
-	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -30,7 +32,7 @@ type Dashboard struct {
 func (o Dashboard) ResourceAttributes() map[string]resourceSchema.Attribute {
 	return map[string]resourceSchema.Attribute{
 		"blueprint_id": resourceSchema.StringAttribute{
-			MarkdownDescription: "Apstra Blueprint ID. Used to identify the Blueprint that the IBA Widget belongs to.",
+			MarkdownDescription: "Apstra Blueprint ID. Used to identify the Blueprint that the IBA Dashboard belongs to.",
 			Required:            true,
 			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
@@ -55,25 +57,36 @@ func (o Dashboard) ResourceAttributes() map[string]resourceSchema.Attribute {
 			},
 		},
 		"default": resourceSchema.BoolAttribute{
-			MarkdownDescription: "True if Default IBA Dashboard",
-			Optional:            true,
+			MarkdownDescription: "When `true`, the IBA Dashboard is displayed on the Blueprint's Analytics " +
+				"landing page. Default: `false`",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
 		},
 		"predefined_dashboard": resourceSchema.StringAttribute{
 			MarkdownDescription: "Id of predefined IBA Dashboard if any",
 			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 		},
 		"updated_by": resourceSchema.StringAttribute{
 			MarkdownDescription: "The user who updated the IBA Dashboard last",
 			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 		},
 		"widget_grid": resourceSchema.ListAttribute{
-			MarkdownDescription: "Grid of Widgets to be displayed in the IBA Dashboard",
-			Required:            true,
+			MarkdownDescription: "Grid of Widgets to be displayed in the IBA Dashboard. Each inner list is a " +
+				"column of the grid, and contains the IDs of the Widgets in that column, from top to bottom. " +
+				"Use the `id` attribute of `apstra_blueprint_iba_widget` resources or data sources.",
+			Required: true,
 			ElementType: types.ListType{
 				ElemType: types.StringType,
 			},
 			Validators: []validator.List{
 				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueListsAre(
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				),
 			},
 		},
 	}
@@ -82,12 +95,12 @@ func (o Dashboard) ResourceAttributes() map[string]resourceSchema.Attribute {
 func (o Dashboard) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
 	return map[string]dataSourceSchema.Attribute{
 		"blueprint_id": dataSourceSchema.StringAttribute{
-			MarkdownDescription: "Apstra Blueprint ID. Used to identify the Blueprint that the IBA Widget belongs to.",
+			MarkdownDescription: "Apstra Blueprint ID. Used to identify the Blueprint that the IBA Dashboard belongs to.",
 			Required:            true,
 			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 		},
 		"id": dataSourceSchema.StringAttribute{
-			MarkdownDescription: "Populate this field to look up a IBA Widget by ID. Required when `name` is omitted.",
+			MarkdownDescription: "Populate this field to look up an IBA Dashboard by ID. Required when `name` is omitted.",
 			Optional:            true,
 			Computed:            true,
 			Validators: []validator.String{
@@ -99,7 +112,7 @@ func (o Dashboard) DataSourceAttributes() map[string]dataSourceSchema.Attribute
 			},
 		},
 		"name": dataSourceSchema.StringAttribute{
-			MarkdownDescription: "Populate this field to look up a IBA Widget by name. Required when `id` is omitted.",
+			MarkdownDescription: "Populate this field to look up an IBA Dashboard by name. Required when `id` is omitted.",
 			Optional:            true,
 			Computed:            true,
 			Validators: []validator.String{
@@ -107,7 +120,7 @@ func (o Dashboard) DataSourceAttributes() map[string]dataSourceSchema.Attribute
 			},
 		},
 		"description": dataSourceSchema.StringAttribute{
-			MarkdownDescription: "Description of the IBA Widget",
+			MarkdownDescription: "Description of the IBA Dashboard",
 			Computed:            true,
 		},
 		"default": dataSourceSchema.BoolAttribute{
@@ -123,14 +136,12 @@ func (o Dashboard) DataSourceAttributes() map[string]dataSourceSchema.Attribute
 			Computed:            true,
 		},
 		"widget_grid": dataSourceSchema.ListAttribute{
-			MarkdownDescription: "Grid of Widgets to be displayed in the dashboard",
-			Computed:            true,
+			MarkdownDescription: "Grid of Widgets to be displayed in the dashboard. Each inner list is a column " +
+				"of the grid, and contains the IDs of the Widgets in that column, from top to bottom.",
+			Computed: true,
 			ElementType: types.ListType{
 				ElemType: types.StringType,
 			},
-			Validators: []validator.List{
-				listvalidator.SizeAtLeast(1),
-			},
 		},
 	}
 }
@@ -138,11 +149,11 @@ func (o Dashboard) DataSourceAttributes() map[string]dataSourceSchema.Attribute
 func (o *Dashboard) LoadApiData(ctx context.Context, in *apstra.IbaDashboard, diag *diag.Diagnostics) {
 	o.Id = types.StringValue(in.Id.String())
 	o.Name = types.StringValue(in.Data.Label)
-	o.Description = utils.StringValueOrNull(ctx, in.Data.Description, diag)
+	o.Description = value.StringOrNull(ctx, in.Data.Description, diag)
 	o.Default = types.BoolValue(in.Data.Default)
-	o.PredefinedDashboard = types.StringValue(in.Data.PredefinedDashboard)
-	o.UpdatedBy = types.StringValue(in.Data.UpdatedBy)
-	o.WidgetGrid = utils.ListValueOrNull(ctx, types.ListType{ElemType: types.StringType}, in.Data.IbaWidgetGrid, diag)
+	o.PredefinedDashboard = value.StringOrNull(ctx, in.Data.PredefinedDashboard, diag)
+	o.UpdatedBy = value.StringOrNull(ctx, in.Data.UpdatedBy, diag)
+	o.WidgetGrid = value.ListOrNull(ctx, types.ListType{ElemType: types.StringType}, in.Data.IbaWidgetGrid, diag)
 }
 
 func (o *Dashboard) Request(ctx context.Context, d *diag.Diagnostics) *apstra.IbaDashboardData {
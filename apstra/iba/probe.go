@@ -2,10 +2,17 @@ package iba
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
 	"github.com/Juniper/terraform-provider-apstra/internal/value"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 type Probe struct {
@@ -25,6 +33,8 @@ type Probe struct {
 	ProbeConfig       jsontypes.Normalized `tfsdk:"probe_config"`
 	Stages            types.Set            `tfsdk:"stages"`
 	ProbeJson         jsontypes.Normalized `tfsdk:"probe_json"`
+	Processors        types.List           `tfsdk:"processors"`
+	StageSettings     types.Map            `tfsdk:"stage_settings"`
 }
 
 func (o Probe) ResourceAttributes() map[string]resourceSchema.Attribute {
@@ -38,14 +48,32 @@ func (o Probe) ResourceAttributes() map[string]resourceSchema.Attribute {
 		"id": resourceSchema.StringAttribute{
 			MarkdownDescription: "IBA Probe ID.",
 			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 		},
 		"name": resourceSchema.StringAttribute{
-			MarkdownDescription: "IBA Probe Name.",
+			MarkdownDescription: "IBA Probe Name. Required when `processors` is set, otherwise determined by Apstra.",
+			Optional:            true,
 			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("predefined_probe_id"),
+					path.MatchRelative().AtParent().AtName("probe_json"),
+				),
+			},
 		},
 		"description": resourceSchema.StringAttribute{
-			MarkdownDescription: "Description of the IBA Probe",
-			Computed:            true,
+			MarkdownDescription: "Description of the IBA Probe. May only be set along with `processors`, " +
+				"otherwise determined by Apstra.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("predefined_probe_id"),
+					path.MatchRelative().AtParent().AtName("probe_json"),
+				),
+			},
 		},
 		"stages": resourceSchema.SetAttribute{
 			MarkdownDescription: "Set of names of stages in the IBA Probe",
@@ -53,24 +81,50 @@ func (o Probe) ResourceAttributes() map[string]resourceSchema.Attribute {
 			ElementType:         types.StringType,
 		},
 		"predefined_probe_id": resourceSchema.StringAttribute{
-			MarkdownDescription: "Id of predefined IBA Probe",
+			MarkdownDescription: "Id of predefined IBA Probe. Changing this value replaces the IBA Probe.",
 			Optional:            true,
 			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("probe_config")), stringvalidator.LengthAtLeast(1)},
 		},
 		"probe_config": resourceSchema.StringAttribute{
-			MarkdownDescription: "Configuration elements for the IBA Probe",
+			MarkdownDescription: "Configuration elements for the IBA Probe. Changes are applied in place.",
 			CustomType:          jsontypes.NormalizedType{},
 			Optional:            true,
-			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("predefined_probe_id")), stringvalidator.LengthAtLeast(1)},
 		},
 		"probe_json": resourceSchema.StringAttribute{
-			MarkdownDescription: "Define the probe as json. If this is present, there can be no predefined probe.",
+			MarkdownDescription: "Define the probe as json. If this is present, there can be no predefined probe. Changes are applied in place.",
 			CustomType:          jsontypes.NormalizedType{},
 			Optional:            true,
-			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
-			Validators:          []validator.String{stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("predefined_probe_id"))},
+			Validators: []validator.String{stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("predefined_probe_id"),
+				path.MatchRelative().AtParent().AtName("processors"),
+			)},
+		},
+		"processors": resourceSchema.ListNestedAttribute{
+			MarkdownDescription: "Processors which make up the IBA Probe pipeline. Collector processors " +
+				"(`" + strings.Join(probeCollectorTypes, "`, `") + "`) gather data into stages, which are " +
+				"consumed by the `inputs` of subsequent processors. Stage references are validated at plan " +
+				"time. Changes are applied in place.",
+			Optional: true,
+			NestedObject: resourceSchema.NestedAttributeObject{
+				Attributes: probeProcessor{}.ResourceAttributes(),
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("name")),
+			},
+		},
+		"stage_settings": resourceSchema.MapNestedAttribute{
+			MarkdownDescription: "Optional settings for the stages produced by `processors`, keyed by stage name.",
+			Optional:            true,
+			NestedObject: resourceSchema.NestedAttributeObject{
+				Attributes: probeStage{}.ResourceAttributes(),
+			},
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+				mapvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("processors")),
+			},
 		},
 	}
 }
@@ -85,3 +139,185 @@ func (o *Probe) LoadApiData(ctx context.Context, in *apstra.IbaProbe, diag *diag
 	}
 	o.Stages = value.SetOrNull(ctx, types.StringType, s, diag)
 }
+
+// processors returns the processors of the IBA Probe along with their index in
+// the `processors` list. Unknown processors are omitted.
+func (o Probe) processors(ctx context.Context, diags *diag.Diagnostics) map[int]probeProcessor {
+	if o.Processors.IsNull() || o.Processors.IsUnknown() {
+		return nil
+	}
+
+	result := make(map[int]probeProcessor, len(o.Processors.Elements()))
+	for i, e := range o.Processors.Elements() {
+		obj, ok := e.(types.Object)
+		if !ok || obj.IsUnknown() {
+			continue
+		}
+
+		var p probeProcessor
+		diags.Append(obj.As(ctx, &p, basetypes.ObjectAsOptions{})...)
+		result[i] = p
+	}
+
+	return result
+}
+
+// ValidateConfig ensures that the processors form a valid pipeline: processor
+// and stage names must be unique, every input must consume a stage produced
+// by another processor, the pipeline must not contain loops, and every key in
+// stage_settings must name a stage.
+func (o Probe) ValidateConfig(ctx context.Context, diags *diag.Diagnostics) {
+	processors := o.processors(ctx, diags)
+	if diags.HasError() || processors == nil {
+		return
+	}
+
+	indexes := slices.Sorted(maps.Keys(processors))
+
+	processorNames := make(map[string]int) // processor name -> index
+	stageProducers := make(map[string]int) // stage name -> index of producing processor
+	for _, i := range indexes {
+		p := processors[i]
+		p.validateConfig(path.Root("processors").AtListIndex(i), diags)
+
+		if !p.Name.IsUnknown() {
+			if j, ok := processorNames[p.Name.ValueString()]; ok {
+				diags.AddAttributeError(path.Root("processors").AtListIndex(i).AtName("name"), "Duplicate processor name",
+					fmt.Sprintf("Processor name %s is also used by processor at index %d", p.Name, j))
+			} else {
+				processorNames[p.Name.ValueString()] = i
+			}
+		}
+
+		for k, stage := range p.outputStages(ctx, diags) {
+			if j, ok := stageProducers[stage]; ok {
+				diags.AddAttributeError(path.Root("processors").AtListIndex(i).AtName("outputs").AtMapKey(k), "Duplicate stage name",
+					fmt.Sprintf("Stage name %q is also produced by processor at index %d", stage, j))
+			} else {
+				stageProducers[stage] = i
+			}
+		}
+	}
+
+	// any unknown output means that we can't be sure which stages exist
+	for _, p := range processors {
+		if p.Outputs.IsUnknown() {
+			return
+		}
+		for _, v := range p.Outputs.Elements() {
+			if v.IsUnknown() {
+				return
+			}
+		}
+	}
+	if len(processors) != len(o.Processors.Elements()) {
+		return
+	}
+
+	// validate inputs, noting the processors feeding each processor
+	upstream := make(map[int][]int)
+	for _, i := range indexes {
+		for k, stage := range processors[i].inputStages(ctx, diags) {
+			j, ok := stageProducers[stage]
+			switch {
+			case !ok:
+				diags.AddAttributeError(path.Root("processors").AtListIndex(i).AtName("inputs").AtMapKey(k).AtName("stage"),
+					"Stage not found", fmt.Sprintf("No processor produces stage %q. Known stages: %q", stage, slices.Sorted(maps.Keys(stageProducers))))
+			case j == i:
+				diags.AddAttributeError(path.Root("processors").AtListIndex(i).AtName("inputs").AtMapKey(k).AtName("stage"),
+					"Invalid stage", fmt.Sprintf("Processor %s cannot consume its own stage %q", processors[i].Name, stage))
+			default:
+				upstream[i] = append(upstream[i], j)
+			}
+		}
+	}
+	if diags.HasError() {
+		return
+	}
+
+	// detect loops in the pipeline with a depth-first walk toward the collectors
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[int]int)
+	var visit func(int) bool
+	visit = func(i int) bool {
+		switch state[i] {
+		case visiting:
+			return false
+		case visited:
+			return true
+		}
+		state[i] = visiting
+		for _, j := range upstream[i] {
+			if !visit(j) {
+				return false
+			}
+		}
+		state[i] = visited
+		return true
+	}
+	for _, i := range indexes {
+		if !visit(i) {
+			diags.AddAttributeError(path.Root("processors").AtListIndex(i).AtName("inputs"), "Pipeline loop detected",
+				fmt.Sprintf("The inputs of processor %s lead to a loop in the pipeline", processors[i].Name))
+			return
+		}
+	}
+
+	// validate stage_settings keys
+	if o.StageSettings.IsUnknown() {
+		return
+	}
+	for k := range o.StageSettings.Elements() {
+		if _, ok := stageProducers[k]; !ok {
+			diags.AddAttributeError(path.Root("stage_settings").AtMapKey(k), "Stage not found",
+				fmt.Sprintf("No processor produces stage %q. Known stages: %q", k, slices.Sorted(maps.Keys(stageProducers))))
+		}
+	}
+}
+
+// JsonRequest returns the probe definition to be sent to the API. When the
+// IBA Probe is defined by processors, the JSON is rendered from them.
+// Otherwise, probe_json is returned as-is.
+func (o Probe) JsonRequest(ctx context.Context, diags *diag.Diagnostics) []byte {
+	if o.Processors.IsNull() {
+		return []byte(o.ProbeJson.ValueString())
+	}
+
+	var processors []probeProcessor
+	diags.Append(o.Processors.ElementsAs(ctx, &processors, false)...)
+	var stageSettings map[string]probeStage
+	diags.Append(o.StageSettings.ElementsAs(ctx, &stageSettings, true)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	data := probeData{
+		Label:       o.Name.ValueString(),
+		Description: o.Description.ValueString(),
+		Processors:  make([]probeProcessorData, len(processors)),
+		Stages:      make([]probeStageData, 0, len(stageSettings)),
+	}
+
+	for i, p := range processors {
+		data.Processors[i] = p.request(ctx, diags)
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(stageSettings)) {
+		data.Stages = append(data.Stages, stageSettings[k].request(ctx, k, diags))
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+
+	result, err := json.Marshal(data)
+	if err != nil {
+		diags.AddError("failed to render IBA Probe JSON", err.Error())
+		return nil
+	}
+
+	return result
+}
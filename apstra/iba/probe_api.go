@@ -0,0 +1,82 @@
+package iba
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+)
+
+// The SDK cannot update IBA probes in place, so the functions in this file call
+// the probe endpoints directly. They are used only by apstra_blueprint_iba_probe,
+// which is limited to Apstra 4.x (see compatibility.BpIbaProbeOk), and the
+// payloads follow the Apstra 4.2.x API.
+const (
+	apiUrlIbaProbeById           = "/api/blueprints/%s/probes/%s"
+	apiUrlIbaPredefinedProbeById = "/api/blueprints/%s/iba/predefined-probes/%s/%s"
+)
+
+// probeData is the JSON representation of an IBA probe as rendered from the
+// processors of an apstra_blueprint_iba_probe resource.
+type probeData struct {
+	Label       string               `json:"label"`
+	Description string               `json:"description,omitempty"`
+	Processors  []probeProcessorData `json:"processors"`
+	Stages      []probeStageData     `json:"stages"`
+}
+
+type probeProcessorData struct {
+	Name       string                             `json:"name"`
+	Type       string                             `json:"type"`
+	Inputs     map[string]probeProcessorInputData `json:"inputs"`
+	Outputs    map[string]string                  `json:"outputs"`
+	Properties map[string]any                     `json:"properties"`
+}
+
+type probeProcessorInputData struct {
+	Stage  string `json:"stage"`
+	Column string `json:"column,omitempty"`
+}
+
+type probeStageData struct {
+	Name                string            `json:"name"`
+	Description         string            `json:"description,omitempty"`
+	RetentionDuration   *int64            `json:"retention_duration,omitempty"`
+	EnableMetricLogging *bool             `json:"enable_metric_logging,omitempty"`
+	Units               map[string]string `json:"units,omitempty"`
+}
+
+// UpdateProbeFromJson replaces the specified probe with the supplied probe
+// definition. The probe keeps its ID and its accumulated time-series data.
+func UpdateProbeFromJson(ctx context.Context, client *apstra.Client, bpId, probeId string, probeJson []byte) error {
+	u, err := url.Parse(fmt.Sprintf(apiUrlIbaProbeById, url.PathEscape(bpId), url.PathEscape(probeId)))
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{
+		Method:  http.MethodPut,
+		Url:     u,
+		Payload: json.RawMessage(probeJson),
+	}, nil)
+}
+
+// UpdatePredefinedProbe re-renders the specified instance of a predefined
+// probe using the supplied configuration. The probe keeps its ID and its
+// accumulated time-series data.
+func UpdatePredefinedProbe(ctx context.Context, client *apstra.Client, bpId, predefinedProbeId, probeId string, probeConfig []byte) error {
+	u, err := url.Parse(fmt.Sprintf(apiUrlIbaPredefinedProbeById,
+		url.PathEscape(bpId), url.PathEscape(predefinedProbeId), url.PathEscape(probeId)))
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{
+		Method:  http.MethodPut,
+		Url:     u,
+		Payload: json.RawMessage(probeConfig),
+	}, nil)
+}
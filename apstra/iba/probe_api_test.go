@@ -0,0 +1,65 @@
+package iba
+
+import (
+	"context"
+	"testing"
+
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateProbeFromJson(t *testing.T) {
+	ctx := context.Background()
+
+	srv := testutils.MockApstra(t)
+	client := testutils.GetMockClient(t, ctx, srv)
+
+	bpId := srv.AddBlueprint("test")
+	probeId := srv.AddIbaProbe(bpId, map[string]any{"label": "before", "processors": []any{}, "stages": []any{}})
+	staged, _, _ := srv.BlueprintVersions(bpId)
+
+	probeJson := []byte(`{"label":"after","processors":[{"name":"p1","type":"extensible_data_collector"}],"stages":[{"name":"p1"}]}`)
+	require.NoError(t, UpdateProbeFromJson(ctx, client, bpId, probeId, probeJson))
+
+	probe, ok := srv.Object("/api/blueprints/" + bpId + "/probes/" + probeId)
+	require.True(t, ok)
+	require.Equal(t, probeId, probe["id"])
+	require.Equal(t, "after", probe["label"])
+	require.Len(t, probe["processors"], 1)
+	require.Len(t, probe["stages"], 1)
+
+	// the probe is not part of the staging blueprint
+	newStaged, _, _ := srv.BlueprintVersions(bpId)
+	require.Equal(t, staged, newStaged)
+
+	// a probe which doesn't exist cannot be updated
+	require.Error(t, UpdateProbeFromJson(ctx, client, bpId, "bogus", probeJson))
+}
+
+func TestUpdatePredefinedProbe(t *testing.T) {
+	ctx := context.Background()
+
+	srv := testutils.MockApstra(t)
+	client := testutils.GetMockClient(t, ctx, srv)
+
+	bpId := srv.AddBlueprint("test")
+	probeId := srv.AddIbaProbe(bpId, map[string]any{"label": "before", "predefined_probe": "bgp_session", "threshold": 1})
+	staged, _, _ := srv.BlueprintVersions(bpId)
+
+	probeConfig := []byte(`{"label":"after","threshold":2}`)
+	require.NoError(t, UpdatePredefinedProbe(ctx, client, bpId, "bgp_session", probeId, probeConfig))
+
+	probe, ok := srv.Object("/api/blueprints/" + bpId + "/probes/" + probeId)
+	require.True(t, ok)
+	require.Equal(t, probeId, probe["id"])
+	require.Equal(t, "bgp_session", probe["predefined_probe"])
+	require.Equal(t, "after", probe["label"])
+	require.EqualValues(t, 2, probe["threshold"])
+
+	// the probe is not part of the staging blueprint
+	newStaged, _, _ := srv.BlueprintVersions(bpId)
+	require.Equal(t, staged, newStaged)
+
+	// a probe which doesn't exist cannot be updated
+	require.Error(t, UpdatePredefinedProbe(ctx, client, bpId, "bgp_session", "bogus", probeConfig))
+}
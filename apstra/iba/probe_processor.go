@@ -0,0 +1,357 @@
+package iba
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ProbeProcessorTypeGraphCollector      = "generic_graph_collector"
+	ProbeProcessorTypeExtensibleCollector = "extensible_data_collector"
+	ProbeProcessorTypeRangeCheck          = "range_check"
+	ProbeProcessorTypeMatchCount          = "match_count"
+	ProbeProcessorTypeSum                 = "sum"
+	ProbeProcessorTypeAverage             = "periodic_average"
+	ProbeProcessorTypeAccumulate          = "accumulate"
+)
+
+var (
+	// ProbeProcessorTypes are the IBA processor types which may be used in
+	// the processors of an IBA Probe.
+	ProbeProcessorTypes = []string{
+		ProbeProcessorTypeGraphCollector,
+		ProbeProcessorTypeExtensibleCollector,
+		ProbeProcessorTypeRangeCheck,
+		ProbeProcessorTypeMatchCount,
+		ProbeProcessorTypeSum,
+		ProbeProcessorTypeAverage,
+		ProbeProcessorTypeAccumulate,
+	}
+
+	// probeCollectorTypes are the processor types which collect data from the
+	// graph or from devices, rather than from the stages of other processors.
+	probeCollectorTypes = []string{ProbeProcessorTypeGraphCollector, ProbeProcessorTypeExtensibleCollector}
+
+	// probeConsumerTypes are the processor types which consume the stages of
+	// other processors.
+	probeConsumerTypes = []string{
+		ProbeProcessorTypeRangeCheck,
+		ProbeProcessorTypeMatchCount,
+		ProbeProcessorTypeSum,
+		ProbeProcessorTypeAverage,
+		ProbeProcessorTypeAccumulate,
+	}
+
+	probeAnomalyTypes = []string{ProbeProcessorTypeRangeCheck, ProbeProcessorTypeMatchCount}
+	probeGroupByTypes = []string{ProbeProcessorTypeMatchCount, ProbeProcessorTypeSum, ProbeProcessorTypeAverage}
+)
+
+// forbiddenUnlessProcessorType returns validators which reject the attribute
+// when the sibling "type" attribute holds a value other than those permitted.
+func forbiddenUnlessProcessorType[T any](permitted ...string) []T {
+	var result []T
+	for _, t := range ProbeProcessorTypes {
+		if !slices.Contains(permitted, t) {
+			v := apstravalidator.ForbiddenWhenValueIs(path.MatchRelative().AtParent().AtName("type"), types.StringValue(t))
+			result = append(result, any(v).(T))
+		}
+	}
+	return result
+}
+
+// requiredWhenProcessorType returns validators which require the attribute
+// when the sibling "type" attribute holds any of the specified values.
+func requiredWhenProcessorType[T any](required ...string) []T {
+	result := make([]T, len(required))
+	for i, t := range required {
+		v := apstravalidator.RequiredWhenValueIs(path.MatchRelative().AtParent().AtName("type"), types.StringValue(t))
+		result[i] = any(v).(T)
+	}
+	return result
+}
+
+type probeProcessor struct {
+	Name         types.String         `tfsdk:"name"`
+	Type         types.String         `tfsdk:"type"`
+	Inputs       types.Map            `tfsdk:"inputs"`
+	Outputs      types.Map            `tfsdk:"outputs"`
+	GraphQuery   types.String         `tfsdk:"graph_query"`
+	ServiceName  types.String         `tfsdk:"service_name"`
+	RangeMin     types.Float64        `tfsdk:"range_min"`
+	RangeMax     types.Float64        `tfsdk:"range_max"`
+	RaiseAnomaly types.Bool           `tfsdk:"raise_anomaly"`
+	GroupBy      types.List           `tfsdk:"group_by"`
+	Properties   jsontypes.Normalized `tfsdk:"properties"`
+}
+
+func (o probeProcessor) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"name": resourceSchema.StringAttribute{
+			MarkdownDescription: "Processor name. Must be unique within the IBA Probe.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"type": resourceSchema.StringAttribute{
+			MarkdownDescription: "Processor type. Must be one of `" + strings.Join(ProbeProcessorTypes, "`, `") + "`.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.OneOf(ProbeProcessorTypes...)},
+		},
+		"inputs": resourceSchema.MapNestedAttribute{
+			MarkdownDescription: "Map of processor inputs keyed by input name (usually `in`). Each input " +
+				"consumes a stage produced by another processor of this IBA Probe. Required for all but the `" +
+				strings.Join(probeCollectorTypes, "` and `") + "` processors, which must not have inputs.",
+			Optional: true,
+			NestedObject: resourceSchema.NestedAttributeObject{
+				Attributes: probeProcessorInput{}.ResourceAttributes(),
+			},
+			Validators: append(append(
+				[]validator.Map{mapvalidator.SizeAtLeast(1)},
+				requiredWhenProcessorType[validator.Map](probeConsumerTypes...)...),
+				forbiddenUnlessProcessorType[validator.Map](probeConsumerTypes...)...),
+		},
+		"outputs": resourceSchema.MapAttribute{
+			MarkdownDescription: "Map of processor outputs keyed by output name (usually `out`). Each value " +
+				"is the name of the stage produced by the output. Stage names must be unique within the IBA Probe.",
+			Required:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+				mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"graph_query": resourceSchema.StringAttribute{
+			MarkdownDescription: "Graph query which selects the items for which data is collected. Required " +
+				"for, and only permitted with, the `" + strings.Join(probeCollectorTypes, "` and `") + "` processors.",
+			Optional: true,
+			Validators: append(append(
+				[]validator.String{apstravalidator.ParseGraphQuery()},
+				requiredWhenProcessorType[validator.String](probeCollectorTypes...)...),
+				forbiddenUnlessProcessorType[validator.String](probeCollectorTypes...)...),
+		},
+		"service_name": resourceSchema.StringAttribute{
+			MarkdownDescription: "Name of the telemetry service whose data is collected. Use the `name` " +
+				"attribute of an `apstra_telemetry_service_registry_entry` resource. Required for, and only " +
+				"permitted with, the `" + ProbeProcessorTypeExtensibleCollector + "` processor.",
+			Optional: true,
+			Validators: append(append(
+				[]validator.String{stringvalidator.LengthAtLeast(1)},
+				requiredWhenProcessorType[validator.String](ProbeProcessorTypeExtensibleCollector)...),
+				forbiddenUnlessProcessorType[validator.String](ProbeProcessorTypeExtensibleCollector)...),
+		},
+		"range_min": resourceSchema.Float64Attribute{
+			MarkdownDescription: "Values below this number fall outside of the range. Only permitted with the `" +
+				ProbeProcessorTypeRangeCheck + "` processor, which requires at least one of `range_min` and `range_max`.",
+			Optional:   true,
+			Validators: forbiddenUnlessProcessorType[validator.Float64](ProbeProcessorTypeRangeCheck),
+		},
+		"range_max": resourceSchema.Float64Attribute{
+			MarkdownDescription: "Values above this number fall outside of the range. Only permitted with the `" +
+				ProbeProcessorTypeRangeCheck + "` processor, which requires at least one of `range_min` and `range_max`.",
+			Optional:   true,
+			Validators: forbiddenUnlessProcessorType[validator.Float64](ProbeProcessorTypeRangeCheck),
+		},
+		"raise_anomaly": resourceSchema.BoolAttribute{
+			MarkdownDescription: "When `true`, items which fail the check raise anomalies. Only permitted with " +
+				"the `" + strings.Join(probeAnomalyTypes, "` and `") + "` processors.",
+			Optional:   true,
+			Validators: forbiddenUnlessProcessorType[validator.Bool](probeAnomalyTypes...),
+		},
+		"group_by": resourceSchema.ListAttribute{
+			MarkdownDescription: "Names of the input keys by which items are grouped. Only permitted with the `" +
+				strings.Join(probeGroupByTypes, "`, `") + "` processors.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: append(
+				[]validator.List{listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1))},
+				forbiddenUnlessProcessorType[validator.List](probeGroupByTypes...)...),
+		},
+		"properties": resourceSchema.StringAttribute{
+			MarkdownDescription: "Additional processor properties, expressed as a JSON object. Properties set " +
+				"by the other attributes of the processor take precedence over those found here.",
+			CustomType: jsontypes.NormalizedType{},
+			Optional:   true,
+		},
+	}
+}
+
+func (o probeProcessor) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":          types.StringType,
+		"type":          types.StringType,
+		"inputs":        types.MapType{ElemType: types.ObjectType{AttrTypes: probeProcessorInput{}.attrTypes()}},
+		"outputs":       types.MapType{ElemType: types.StringType},
+		"graph_query":   types.StringType,
+		"service_name":  types.StringType,
+		"range_min":     types.Float64Type,
+		"range_max":     types.Float64Type,
+		"raise_anomaly": types.BoolType,
+		"group_by":      types.ListType{ElemType: types.StringType},
+		"properties":    jsontypes.NormalizedType{},
+	}
+}
+
+// inputStages returns the known stage names consumed by the processor, keyed
+// by input name.
+func (o probeProcessor) inputStages(ctx context.Context, diags *diag.Diagnostics) map[string]string {
+	if o.Inputs.IsNull() || o.Inputs.IsUnknown() {
+		return nil
+	}
+
+	var inputs map[string]probeProcessorInput
+	diags.Append(o.Inputs.ElementsAs(ctx, &inputs, false)...)
+
+	result := make(map[string]string, len(inputs))
+	for k, v := range inputs {
+		if !v.Stage.IsUnknown() {
+			result[k] = v.Stage.ValueString()
+		}
+	}
+	return result
+}
+
+// outputStages returns the known stage names produced by the processor, keyed
+// by output name.
+func (o probeProcessor) outputStages(ctx context.Context, diags *diag.Diagnostics) map[string]string {
+	if o.Outputs.IsNull() || o.Outputs.IsUnknown() {
+		return nil
+	}
+
+	var outputs map[string]types.String
+	diags.Append(o.Outputs.ElementsAs(ctx, &outputs, false)...)
+
+	result := make(map[string]string, len(outputs))
+	for k, v := range outputs {
+		if !v.IsUnknown() {
+			result[k] = v.ValueString()
+		}
+	}
+	return result
+}
+
+// validateConfig performs checks which cannot be expressed with attribute
+// validators.
+func (o probeProcessor) validateConfig(p path.Path, diags *diag.Diagnostics) {
+	if o.Type.ValueString() == ProbeProcessorTypeRangeCheck && o.RangeMin.IsNull() && o.RangeMax.IsNull() {
+		diags.AddAttributeError(p, "Missing range",
+			fmt.Sprintf("Processor %s of type %q requires at least one of %q and %q",
+				o.Name, ProbeProcessorTypeRangeCheck, "range_min", "range_max"))
+	}
+
+	if !o.RangeMin.IsNull() && !o.RangeMin.IsUnknown() && !o.RangeMax.IsNull() && !o.RangeMax.IsUnknown() &&
+		o.RangeMin.ValueFloat64() > o.RangeMax.ValueFloat64() {
+		diags.AddAttributeError(p.AtName("range_min"), "Invalid range",
+			fmt.Sprintf("range_min (%s) must not exceed range_max (%s)", o.RangeMin, o.RangeMax))
+	}
+
+	if !o.Properties.IsNull() && !o.Properties.IsUnknown() {
+		var properties map[string]any
+		err := json.Unmarshal([]byte(o.Properties.ValueString()), &properties)
+		if err != nil {
+			diags.AddAttributeError(p.AtName("properties"), "Invalid properties",
+				"properties must be a JSON object: "+err.Error())
+		}
+	}
+}
+
+func (o probeProcessor) request(ctx context.Context, diags *diag.Diagnostics) probeProcessorData {
+	result := probeProcessorData{
+		Name:       o.Name.ValueString(),
+		Type:       o.Type.ValueString(),
+		Inputs:     make(map[string]probeProcessorInputData),
+		Properties: make(map[string]any),
+	}
+
+	var inputs map[string]probeProcessorInput
+	diags.Append(o.Inputs.ElementsAs(ctx, &inputs, true)...)
+	for k, v := range inputs {
+		result.Inputs[k] = v.request()
+	}
+
+	diags.Append(o.Outputs.ElementsAs(ctx, &result.Outputs, false)...)
+
+	if !o.Properties.IsNull() {
+		err := json.Unmarshal([]byte(o.Properties.ValueString()), &result.Properties)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("failed parsing properties of processor %s", o.Name), err.Error())
+			return result
+		}
+	}
+
+	if !o.GraphQuery.IsNull() {
+		result.Properties["graph_query"] = o.GraphQuery.ValueString()
+	}
+	if !o.ServiceName.IsNull() {
+		result.Properties["service_name"] = o.ServiceName.ValueString()
+	}
+	if !o.RangeMin.IsNull() || !o.RangeMax.IsNull() {
+		r := make(map[string]float64)
+		if !o.RangeMin.IsNull() {
+			r["min"] = o.RangeMin.ValueFloat64()
+		}
+		if !o.RangeMax.IsNull() {
+			r["max"] = o.RangeMax.ValueFloat64()
+		}
+		result.Properties["range"] = r
+	}
+	if !o.RaiseAnomaly.IsNull() {
+		result.Properties["raise_anomaly"] = o.RaiseAnomaly.ValueBool()
+	}
+	if !o.GroupBy.IsNull() {
+		var groupBy []string
+		diags.Append(o.GroupBy.ElementsAs(ctx, &groupBy, false)...)
+		result.Properties["group_by"] = groupBy
+	}
+
+	return result
+}
+
+type probeProcessorInput struct {
+	Stage  types.String `tfsdk:"stage"`
+	Column types.String `tfsdk:"column"`
+}
+
+func (o probeProcessorInput) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"stage": resourceSchema.StringAttribute{
+			MarkdownDescription: "Name of the stage consumed by the input. Must be the name of an output " +
+				"stage of another processor in this IBA Probe.",
+			Required:   true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"column": resourceSchema.StringAttribute{
+			MarkdownDescription: "Name of the column of the stage consumed by the input. When omitted, " +
+				"Apstra uses the stage's default value column.",
+			Optional:   true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	}
+}
+
+func (o probeProcessorInput) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"stage":  types.StringType,
+		"column": types.StringType,
+	}
+}
+
+func (o probeProcessorInput) request() probeProcessorInputData {
+	return probeProcessorInputData{
+		Stage:  o.Stage.ValueString(),
+		Column: o.Column.ValueString(),
+	}
+}
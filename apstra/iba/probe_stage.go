@@ -0,0 +1,68 @@
+package iba
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type probeStage struct {
+	Description         types.String `tfsdk:"description"`
+	RetentionDuration   types.Int64  `tfsdk:"retention_duration"`
+	EnableMetricLogging types.Bool   `tfsdk:"enable_metric_logging"`
+	Units               types.Map    `tfsdk:"units"`
+}
+
+func (o probeStage) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"description": resourceSchema.StringAttribute{
+			MarkdownDescription: "Stage description displayed in the Apstra web UI.",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"retention_duration": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Number of seconds for which the stage's historical data is retained.",
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"enable_metric_logging": resourceSchema.BoolAttribute{
+			MarkdownDescription: "When `true`, the stage's data is persisted in the Apstra metric database.",
+			Optional:            true,
+		},
+		"units": resourceSchema.MapAttribute{
+			MarkdownDescription: "Units of the stage's columns, keyed by column name (e.g. `\"tx_bps\" = \"bps\"`).",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators:          []validator.Map{mapvalidator.SizeAtLeast(1)},
+		},
+	}
+}
+
+func (o probeStage) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"description":           types.StringType,
+		"retention_duration":    types.Int64Type,
+		"enable_metric_logging": types.BoolType,
+		"units":                 types.MapType{ElemType: types.StringType},
+	}
+}
+
+func (o probeStage) request(ctx context.Context, name string, diags *diag.Diagnostics) probeStageData {
+	result := probeStageData{
+		Name:                name,
+		Description:         o.Description.ValueString(),
+		RetentionDuration:   o.RetentionDuration.ValueInt64Pointer(),
+		EnableMetricLogging: o.EnableMetricLogging.ValueBoolPointer(),
+	}
+
+	diags.Append(o.Units.ElementsAs(ctx, &result.Units, true)...)
+
+	return result
+}
@@ -0,0 +1,248 @@
+package iba
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testProbeProcessor(t testing.TB, name, pType string, inputs, outputs map[string]string) probeProcessor {
+	t.Helper()
+
+	inputValues := make(map[string]attr.Value, len(inputs))
+	for k, v := range inputs {
+		inputValues[k] = types.ObjectValueMust(probeProcessorInput{}.attrTypes(), map[string]attr.Value{
+			"stage":  types.StringValue(v),
+			"column": types.StringNull(),
+		})
+	}
+
+	result := probeProcessor{
+		Name:         types.StringValue(name),
+		Type:         types.StringValue(pType),
+		Inputs:       types.MapNull(types.ObjectType{AttrTypes: probeProcessorInput{}.attrTypes()}),
+		Outputs:      types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		GraphQuery:   types.StringNull(),
+		ServiceName:  types.StringNull(),
+		RangeMin:     types.Float64Null(),
+		RangeMax:     types.Float64Null(),
+		RaiseAnomaly: types.BoolNull(),
+		GroupBy:      types.ListNull(types.StringType),
+		Properties:   jsontypes.NewNormalizedNull(),
+	}
+	if len(inputs) > 0 {
+		result.Inputs = types.MapValueMust(types.ObjectType{AttrTypes: probeProcessorInput{}.attrTypes()}, inputValues)
+	}
+
+	outputValues := make(map[string]attr.Value, len(outputs))
+	for k, v := range outputs {
+		outputValues[k] = types.StringValue(v)
+	}
+	result.Outputs = types.MapValueMust(types.StringType, outputValues)
+
+	return result
+}
+
+func testProbe(t testing.TB, processors []probeProcessor, stageSettings map[string]probeStage) Probe {
+	t.Helper()
+	ctx := context.Background()
+
+	var d diag.Diagnostics
+	result := Probe{
+		Name:          types.StringValue("test probe"),
+		Description:   types.StringNull(),
+		ProbeJson:     jsontypes.NewNormalizedNull(),
+		StageSettings: types.MapNull(types.ObjectType{AttrTypes: probeStage{}.attrTypes()}),
+	}
+
+	var diags diag.Diagnostics
+	result.Processors, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: probeProcessor{}.attrTypes()}, processors)
+	d.Append(diags...)
+	if stageSettings != nil {
+		result.StageSettings, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: probeStage{}.attrTypes()}, stageSettings)
+		d.Append(diags...)
+	}
+	require.False(t, d.HasError(), d)
+
+	return result
+}
+
+func TestProbeValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	collector := func(name, stage string) probeProcessor {
+		p := testProbeProcessor(t, name, ProbeProcessorTypeGraphCollector, nil, map[string]string{"out": stage})
+		p.GraphQuery = types.StringValue("node('system', name='system')")
+		return p
+	}
+	rangeCheck := func(name, in, stage string) probeProcessor {
+		p := testProbeProcessor(t, name, ProbeProcessorTypeRangeCheck, map[string]string{"in": in}, map[string]string{"out": stage})
+		p.RangeMax = types.Float64Value(80)
+		return p
+	}
+
+	type testCase struct {
+		processors    []probeProcessor
+		stageSettings map[string]probeStage
+		expectErr     bool
+	}
+
+	testCases := map[string]testCase{
+		"valid_pipeline": {
+			processors: []probeProcessor{
+				collector("collect", "cpu"),
+				rangeCheck("check", "cpu", "cpu_high"),
+			},
+			stageSettings: map[string]probeStage{
+				"cpu": {
+					Description:         types.StringValue("cpu"),
+					RetentionDuration:   types.Int64Null(),
+					EnableMetricLogging: types.BoolNull(),
+					Units:               types.MapNull(types.StringType),
+				},
+			},
+		},
+		"duplicate_processor_name": {
+			processors: []probeProcessor{
+				collector("collect", "cpu"),
+				rangeCheck("collect", "cpu", "cpu_high"),
+			},
+			expectErr: true,
+		},
+		"duplicate_stage_name": {
+			processors: []probeProcessor{
+				collector("collect", "cpu"),
+				rangeCheck("check", "cpu", "cpu"),
+			},
+			expectErr: true,
+		},
+		"missing_input_stage": {
+			processors: []probeProcessor{
+				collector("collect", "cpu"),
+				rangeCheck("check", "memory", "cpu_high"),
+			},
+			expectErr: true,
+		},
+		"self_reference": {
+			processors: []probeProcessor{
+				collector("collect", "cpu"),
+				rangeCheck("check", "cpu_high", "cpu_high"),
+			},
+			expectErr: true,
+		},
+		"loop": {
+			processors: []probeProcessor{
+				collector("collect", "cpu"),
+				rangeCheck("a", "b_out", "a_out"),
+				rangeCheck("b", "a_out", "b_out"),
+			},
+			expectErr: true,
+		},
+		"range_check_without_range": {
+			processors: []probeProcessor{
+				collector("collect", "cpu"),
+				testProbeProcessor(t, "check", ProbeProcessorTypeRangeCheck, map[string]string{"in": "cpu"}, map[string]string{"out": "cpu_high"}),
+			},
+			expectErr: true,
+		},
+		"unknown_stage_setting": {
+			processors: []probeProcessor{
+				collector("collect", "cpu"),
+			},
+			stageSettings: map[string]probeStage{
+				"memory": {
+					Description:         types.StringValue("memory"),
+					RetentionDuration:   types.Int64Null(),
+					EnableMetricLogging: types.BoolNull(),
+					Units:               types.MapNull(types.StringType),
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			testProbe(t, tCase.processors, tCase.stageSettings).ValidateConfig(ctx, &diags)
+			if tCase.expectErr {
+				require.True(t, diags.HasError())
+			} else {
+				require.False(t, diags.HasError(), diags)
+			}
+		})
+	}
+}
+
+func TestProbeJsonRequest(t *testing.T) {
+	ctx := context.Background()
+
+	collector := testProbeProcessor(t, "collect", ProbeProcessorTypeExtensibleCollector, nil, map[string]string{"out": "cpu"})
+	collector.GraphQuery = types.StringValue("node('system', name='system', role='leaf')")
+	collector.ServiceName = types.StringValue("cpu_util")
+	collector.Properties = jsontypes.NewNormalizedValue(`{"system_id": "system.system_id", "service_name": "ignored"}`)
+
+	check := testProbeProcessor(t, "check", ProbeProcessorTypeRangeCheck, map[string]string{"in": "cpu"}, map[string]string{"out": "cpu_high"})
+	check.RangeMax = types.Float64Value(80)
+	check.RaiseAnomaly = types.BoolValue(true)
+
+	probe := testProbe(t, []probeProcessor{collector, check}, map[string]probeStage{
+		"cpu": {
+			Description:         types.StringNull(),
+			RetentionDuration:   types.Int64Value(3600),
+			EnableMetricLogging: types.BoolNull(),
+			Units:               types.MapValueMust(types.StringType, map[string]attr.Value{"value": types.StringValue("%")}),
+		},
+	})
+
+	var diags diag.Diagnostics
+	result := probe.JsonRequest(ctx, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	expected := `{
+		"label": "test probe",
+		"processors": [
+			{
+				"name": "collect",
+				"type": "extensible_data_collector",
+				"inputs": {},
+				"outputs": {"out": "cpu"},
+				"properties": {
+					"graph_query": "node('system', name='system', role='leaf')",
+					"service_name": "cpu_util",
+					"system_id": "system.system_id"
+				}
+			},
+			{
+				"name": "check",
+				"type": "range_check",
+				"inputs": {"in": {"stage": "cpu"}},
+				"outputs": {"out": "cpu_high"},
+				"properties": {
+					"range": {"max": 80},
+					"raise_anomaly": true
+				}
+			}
+		],
+		"stages": [
+			{
+				"name": "cpu",
+				"retention_duration": 3600,
+				"units": {"value": "%"}
+			}
+		]
+	}`
+
+	var expectedData, resultData any
+	require.NoError(t, json.Unmarshal([]byte(expected), &expectedData))
+	require.NoError(t, json.Unmarshal(result, &resultData))
+	require.Equal(t, expectedData, resultData)
+}
@@ -2,10 +2,13 @@ package iba
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
 	"github.com/Juniper/apstra-go-sdk/enum"
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -34,7 +37,7 @@ func (o Widget) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
 			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 		},
 		"id": dataSourceSchema.StringAttribute{
-			MarkdownDescription: "Populate this field to look up a IBA Widget by ID. Required when `name` is omitted.",
+			MarkdownDescription: "Populate this field to look up an IBA Widget by ID. Required when `name` is omitted.",
 			Optional:            true,
 			Computed:            true,
 			Validators: []validator.String{
@@ -46,7 +49,7 @@ func (o Widget) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
 			},
 		},
 		"name": dataSourceSchema.StringAttribute{
-			MarkdownDescription: "Populate this field to look up a IBA Widget by name. Required when `id` is omitted.",
+			MarkdownDescription: "Populate this field to look up an IBA Widget by name. Required when `id` is omitted.",
 			Optional:            true,
 			Computed:            true,
 			Validators: []validator.String{
@@ -95,16 +98,19 @@ func (o Widget) ResourceAttributes() map[string]resourceSchema.Attribute {
 				stringvalidator.LengthAtLeast(1),
 			},
 		},
-		"probe_id": dataSourceSchema.StringAttribute{
-			MarkdownDescription: "Id of IBA Probe used by this widget",
-			Required:            true,
+		"probe_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Id of IBA Probe used by this widget. Use the `id` attribute of an " +
+				"`apstra_blueprint_iba_probe` resource.",
+			Required: true,
 			Validators: []validator.String{
 				stringvalidator.LengthAtLeast(1),
 			},
 		},
 		"stage": resourceSchema.StringAttribute{
-			MarkdownDescription: "Stage of IBA Probe used by this widget",
-			Required:            true,
+			MarkdownDescription: "Stage of IBA Probe used by this widget. Must be one of the `stages` of the " +
+				"`apstra_blueprint_iba_probe` identified by `probe_id`. This is checked when the widget is " +
+				"created or updated, after any change to the IBA Probe in the same apply.",
+			Required: true,
 			Validators: []validator.String{
 				stringvalidator.LengthAtLeast(1),
 			},
@@ -115,7 +121,7 @@ func (o Widget) ResourceAttributes() map[string]resourceSchema.Attribute {
 func (o *Widget) LoadApiData(ctx context.Context, in *apstra.IbaWidget, d *diag.Diagnostics) {
 	o.Id = types.StringValue(in.Id.String())
 	o.Name = types.StringValue(in.Data.Label)
-	o.Description = utils.StringValueOrNull(ctx, in.Data.Description, d)
+	o.Description = value.StringOrNull(ctx, in.Data.Description, d)
 	o.Stage = types.StringValue(in.Data.StageName)
 	o.ProbeId = types.StringValue(in.Data.ProbeId.String())
 }
@@ -129,3 +135,30 @@ func (o *Widget) Request(_ context.Context, _ *diag.Diagnostics) *apstra.IbaWidg
 		Type:        enum.IbaWidgetTypeStage,
 	}
 }
+
+// ValidateStage ensures that the IBA Probe used by the widget exists and has a
+// stage with the configured name.
+func (o *Widget) ValidateStage(ctx context.Context, bp *apstra.TwoStageL3ClosClient, d *diag.Diagnostics) {
+	probe, err := bp.GetIbaProbe(ctx, apstra.ObjectId(o.ProbeId.ValueString()))
+	if err != nil {
+		if utils.IsApstra404(err) {
+			d.AddAttributeError(path.Root("probe_id"), "IBA Probe not found",
+				fmt.Sprintf("IBA Probe %s not found in Blueprint %s", o.ProbeId, o.BlueprintId))
+			return
+		}
+		d.AddError(fmt.Sprintf("failed reading IBA Probe %s", o.ProbeId), err.Error())
+		return
+	}
+
+	stages := make([]string, 0, len(probe.Stages))
+	for _, stage := range probe.Stages {
+		if name, ok := stage["name"].(string); ok {
+			stages = append(stages, name)
+		}
+	}
+
+	if !slices.Contains(stages, o.Stage.ValueString()) {
+		d.AddAttributeError(path.Root("stage"), "IBA Probe stage not found",
+			fmt.Sprintf("IBA Probe %s has no stage named %s. Valid stages are: %q", o.ProbeId, o.Stage, stages))
+	}
+}
@@ -0,0 +1,120 @@
+package tfapstra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importIdSeparator delimits the fields of a composite import ID, as in
+// "<blueprint_id>:<id>".
+const importIdSeparator = ":"
+
+// parseImportId splits a user-supplied import ID into values for each of the
+// named fields. Two formats are accepted:
+//
+//   - colon-delimited: `<blueprint_id>:<id>`
+//   - a JSON object keyed by field name: `{"blueprint_id":"...","id":"..."}`
+//
+// The JSON format is the one originally supported by this provider. In the
+// colon-delimited format, the final field absorbs any excess separators, so
+// the last value may itself contain a colon. All fields are required. The
+// returned slice has one element per field, in the order the fields were
+// specified.
+func parseImportId(in string, fields []string, diags *diag.Diagnostics) []string {
+	return parseImportIdFields(in, fields, len(fields), diags)
+}
+
+// parseImportIdWithOptional works like parseImportId, but the trailing fields
+// named in optional may be omitted from the import ID. Omitted optional fields
+// are returned as empty strings.
+func parseImportIdWithOptional(in string, required, optional []string, diags *diag.Diagnostics) []string {
+	return parseImportIdFields(in, append(slices.Clone(required), optional...), len(required), diags)
+}
+
+// parseImportIdFields implements parseImportId and parseImportIdWithOptional.
+// The first requiredCount fields are mandatory; the rest are optional.
+func parseImportIdFields(in string, fields []string, requiredCount int, diags *diag.Diagnostics) []string {
+	if requiredCount == 0 {
+		diags.AddError(errProviderBug, "parseImportId invoked with no required fields")
+		return nil
+	}
+
+	example := "<" + strings.Join(fields[:requiredCount], ">"+importIdSeparator+"<") + ">"
+	for _, field := range fields[requiredCount:] {
+		example += "[" + importIdSeparator + "<" + field + ">]"
+	}
+
+	// given is the number of leading fields which must not be empty. Optional
+	// fields which appear in the colon-delimited form must not be empty either.
+	given := requiredCount
+	result := make([]string, len(fields))
+	if strings.HasPrefix(strings.TrimSpace(in), "{") {
+		var m map[string]string
+		err := json.Unmarshal([]byte(in), &m)
+		if err != nil {
+			diags.AddError("failed parsing import id JSON string", err.Error())
+			return nil
+		}
+
+		for i, field := range fields {
+			result[i] = m[field]
+		}
+	} else {
+		parts := strings.SplitN(in, importIdSeparator, len(fields))
+		if len(parts) < requiredCount {
+			diags.AddError(errImportIdInvalid, fmt.Sprintf("expected import ID in the form %q, got %q", example, in))
+			return nil
+		}
+
+		copy(result, parts)
+		given = len(parts)
+	}
+
+	for i, field := range fields[:given] {
+		if result[i] == "" {
+			diags.AddError(errImportIdInvalid, fmt.Sprintf("%q element of import ID %q cannot be empty; expected the form %q", field, in, example))
+		}
+	}
+	if diags.HasError() {
+		return nil
+	}
+
+	return result
+}
+
+// importStateById parses the user-supplied import ID using parseImportId and
+// copies the resulting values into the root-level string attributes named by
+// attrNames. Terraform invokes Read() after ImportState() completes, so resources
+// whose Read() method is able to work from these identifiers alone can use this
+// function as their entire ImportState() implementation.
+func importStateById(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attrNames ...string) []string {
+	ids := parseImportId(req.ID, attrNames, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
+	for i, attrName := range attrNames {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attrName), ids[i])...)
+	}
+
+	return ids
+}
+
+// importStateSingleton handles import of resources which represent a single
+// server-wide setting. Such resources have a fixed ID, which is also the only
+// acceptable import ID.
+func importStateSingleton(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, id string) {
+	if req.ID != id {
+		resp.Diagnostics.AddError(errImportIdInvalid, fmt.Sprintf("expected import ID %q, got %q", id, req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,155 @@
+package tfapstra
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImportId(t *testing.T) {
+	type testCase struct {
+		in       string
+		fields   []string
+		expected []string
+		expErr   bool
+	}
+
+	testCases := map[string]testCase{
+		"colon_two_fields": {
+			in:       "bp1:node1",
+			fields:   []string{"blueprint_id", "id"},
+			expected: []string{"bp1", "node1"},
+		},
+		"colon_single_field": {
+			in:       "bp1",
+			fields:   []string{"id"},
+			expected: []string{"bp1"},
+		},
+		"colon_last_field_absorbs_separator": {
+			in:       "bp1:a:b",
+			fields:   []string{"blueprint_id", "id"},
+			expected: []string{"bp1", "a:b"},
+		},
+		"colon_three_fields": {
+			in:       "bp1:leaf_asns:rz1",
+			fields:   []string{"blueprint_id", "role", "routing_zone_id"},
+			expected: []string{"bp1", "leaf_asns", "rz1"},
+		},
+		"json": {
+			in:       `{"blueprint_id":"bp1","id":"node1"}`,
+			fields:   []string{"blueprint_id", "id"},
+			expected: []string{"bp1", "node1"},
+		},
+		"json_leading_whitespace": {
+			in:       ` {"id":"node1","blueprint_id":"bp1"}`,
+			fields:   []string{"blueprint_id", "id"},
+			expected: []string{"bp1", "node1"},
+		},
+		"colon_too_few_fields": {
+			in:     "bp1",
+			fields: []string{"blueprint_id", "id"},
+			expErr: true,
+		},
+		"colon_empty_field": {
+			in:     "bp1:",
+			fields: []string{"blueprint_id", "id"},
+			expErr: true,
+		},
+		"json_missing_field": {
+			in:     `{"blueprint_id":"bp1"}`,
+			fields: []string{"blueprint_id", "id"},
+			expErr: true,
+		},
+		"json_malformed": {
+			in:     `{"blueprint_id":"bp1"`,
+			fields: []string{"blueprint_id", "id"},
+			expErr: true,
+		},
+		"empty": {
+			in:     "",
+			fields: []string{"id"},
+			expErr: true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			result := parseImportId(tCase.in, tCase.fields, &diags)
+			if tCase.expErr {
+				require.True(t, diags.HasError())
+				require.Nil(t, result)
+				return
+			}
+
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
+
+func TestParseImportIdWithOptional(t *testing.T) {
+	required := []string{"blueprint_id", "role"}
+	optional := []string{"routing_zone_id"}
+
+	type testCase struct {
+		in       string
+		expected []string
+		expErr   bool
+	}
+
+	testCases := map[string]testCase{
+		"colon_without_optional": {
+			in:       "bp1:leaf_asns",
+			expected: []string{"bp1", "leaf_asns", ""},
+		},
+		"colon_with_optional": {
+			in:       "bp1:leaf_loopback_ips:rz1",
+			expected: []string{"bp1", "leaf_loopback_ips", "rz1"},
+		},
+		"json_without_optional": {
+			in:       `{"blueprint_id":"bp1","role":"leaf_asns"}`,
+			expected: []string{"bp1", "leaf_asns", ""},
+		},
+		"json_with_optional": {
+			in:       `{"blueprint_id":"bp1","role":"leaf_loopback_ips","routing_zone_id":"rz1"}`,
+			expected: []string{"bp1", "leaf_loopback_ips", "rz1"},
+		},
+		"colon_too_few_fields": {
+			in:     "bp1",
+			expErr: true,
+		},
+		"colon_empty_optional": {
+			in:     "bp1:leaf_loopback_ips:",
+			expErr: true,
+		},
+		"colon_empty_required": {
+			in:     ":leaf_asns",
+			expErr: true,
+		},
+		"json_missing_required": {
+			in:     `{"blueprint_id":"bp1","routing_zone_id":"rz1"}`,
+			expErr: true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			result := parseImportIdWithOptional(tCase.in, required, optional, &diags)
+			if tCase.expErr {
+				require.True(t, diags.HasError())
+				require.Nil(t, result)
+				return
+			}
+
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package tfapstra_test
+
+import (
+	"fmt"
+
+	tfapstra "github.com/Juniper/terraform-provider-apstra/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/test_utils/mockapstra"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// mockProviderConfigHCL configures the provider for use with the in-process
+// mock Apstra server. Connection details are supplied by testutils.MockApstra()
+// through the environment.
+const mockProviderConfigHCL = `
+provider "apstra" {
+  tls_validation_disabled = true
+  blueprint_mutex_enabled = false
+}
+`
+
+// testMockProtoV6ProviderFactories is used by tests which run against the
+// in-process mock Apstra server. Unlike testAccProtoV6ProviderFactories, it
+// is available without the integration build tag.
+var testMockProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"apstra": providerserver.NewProtocol6WithError(tfapstra.NewProvider()),
+}
+
+// mockServerObject follows the object managed by a Terraform resource on the
+// mock server. The object ID is taken from the resource's state by each
+// Check, and remembered for CheckDestroy.
+type mockServerObject struct {
+	srv          *mockapstra.Server
+	resourceName string // e.g. "apstra_role.test"
+	collection   string // e.g. "/api/aaa/roles"
+	id           string
+}
+
+// Check returns a check which looks up the resource's object on the mock
+// server and hands it to f along with the object ID.
+func (o *mockServerObject) Check(f func(id string, obj map[string]any) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[o.resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", o.resourceName)
+		}
+		o.id = rs.Primary.ID
+
+		obj, ok := o.srv.Object(o.collection + "/" + o.id)
+		if !ok {
+			return fmt.Errorf("%s %q not found on mock server", o.resourceName, o.id)
+		}
+
+		return f(o.id, obj)
+	}
+}
+
+// CheckDestroy ensures that the object most recently seen by Check has been
+// removed from the mock server.
+func (o *mockServerObject) CheckDestroy(_ *terraform.State) error {
+	if _, ok := o.srv.Object(o.collection + "/" + o.id); ok {
+		return fmt.Errorf("%s %q still exists on mock server", o.resourceName, o.id)
+	}
+	return nil
+}
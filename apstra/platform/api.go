@@ -0,0 +1,165 @@
+package platform
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+)
+
+// The platform settings endpoints are not covered by the SDK, so they are
+// called directly. They were introduced in Apstra 6.1.0 (see
+// compatibility.PlatformSettingsOK). Each accepts GET and PUT only: PUT
+// replaces the whole setting, except for the SMTP password (see SmtpData)
+// and feature flags (see SetFeatureFlags).
+const (
+	apiUrlPlatformSyslog          = "/api/platform/syslog"
+	apiUrlPlatformNtp             = "/api/platform/ntp"
+	apiUrlPlatformDns             = "/api/platform/dns"
+	apiUrlPlatformSmtp            = "/api/platform/smtp"
+	apiUrlPlatformSessionTimeouts = "/api/platform/session-timeouts"
+	apiUrlPlatformFeatureFlags    = "/api/platform/feature-flags"
+)
+
+// SyslogData is the JSON representation of the Apstra server's syslog
+// forwarding configuration.
+type SyslogData struct {
+	Facility  string               `json:"facility"`
+	Receivers []syslogReceiverData `json:"receivers"`
+}
+
+type syslogReceiverData struct {
+	Address  string `json:"address"`
+	Port     int64  `json:"port"`
+	Protocol string `json:"protocol"`
+}
+
+// NtpData is the JSON representation of the Apstra server's NTP configuration.
+type NtpData struct {
+	Servers []string `json:"servers"`
+}
+
+// DnsData is the JSON representation of the Apstra server's DNS resolver
+// configuration.
+type DnsData struct {
+	Nameservers   []string `json:"nameservers"`
+	SearchDomains []string `json:"search_domains"`
+}
+
+// SmtpData is the JSON representation of the mail server used by Apstra to
+// send alert notifications. Password is never returned by the API. When it is
+// omitted from a PUT request, Apstra retains the existing password (as with
+// the secrets of external authentication providers).
+type SmtpData struct {
+	Host       string   `json:"host"`
+	Port       int64    `json:"port"`
+	Security   string   `json:"security"`
+	Username   string   `json:"username"`
+	Password   string   `json:"password,omitempty"`
+	Sender     string   `json:"sender"`
+	Recipients []string `json:"recipients"`
+}
+
+// SessionTimeoutsData is the JSON representation of the Apstra server's API
+// and web UI session timeouts, in minutes.
+type SessionTimeoutsData struct {
+	ApiTimeout int64 `json:"api_timeout"`
+	UiTimeout  int64 `json:"ui_timeout"`
+}
+
+// FeatureFlagsData is the JSON representation of the Apstra server's feature
+// flags. When writing, only the listed flags are changed.
+type FeatureFlagsData struct {
+	FeatureFlags []featureFlagData `json:"feature_flags"`
+}
+
+type featureFlagData struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+func get[T any](ctx context.Context, client *apstra.Client, rawUrl string) (*T, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var result T
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodGet, Url: u}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func put(ctx context.Context, client *apstra.Client, rawUrl string, in any) error {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPut, Url: u, Payload: in}, nil)
+}
+
+// GetSyslog fetches the syslog forwarding configuration.
+func GetSyslog(ctx context.Context, client *apstra.Client) (*SyslogData, error) {
+	return get[SyslogData](ctx, client, apiUrlPlatformSyslog)
+}
+
+// SetSyslog replaces the syslog forwarding configuration.
+func SetSyslog(ctx context.Context, client *apstra.Client, in *SyslogData) error {
+	return put(ctx, client, apiUrlPlatformSyslog, in)
+}
+
+// GetNtp fetches the NTP configuration.
+func GetNtp(ctx context.Context, client *apstra.Client) (*NtpData, error) {
+	return get[NtpData](ctx, client, apiUrlPlatformNtp)
+}
+
+// SetNtp replaces the NTP configuration.
+func SetNtp(ctx context.Context, client *apstra.Client, in *NtpData) error {
+	return put(ctx, client, apiUrlPlatformNtp, in)
+}
+
+// GetDns fetches the DNS resolver configuration.
+func GetDns(ctx context.Context, client *apstra.Client) (*DnsData, error) {
+	return get[DnsData](ctx, client, apiUrlPlatformDns)
+}
+
+// SetDns replaces the DNS resolver configuration.
+func SetDns(ctx context.Context, client *apstra.Client, in *DnsData) error {
+	return put(ctx, client, apiUrlPlatformDns, in)
+}
+
+// GetSmtp fetches the alert notification mail server configuration.
+func GetSmtp(ctx context.Context, client *apstra.Client) (*SmtpData, error) {
+	return get[SmtpData](ctx, client, apiUrlPlatformSmtp)
+}
+
+// SetSmtp replaces the alert notification mail server configuration.
+func SetSmtp(ctx context.Context, client *apstra.Client, in *SmtpData) error {
+	return put(ctx, client, apiUrlPlatformSmtp, in)
+}
+
+// GetSessionTimeouts fetches the API and web UI session timeouts.
+func GetSessionTimeouts(ctx context.Context, client *apstra.Client) (*SessionTimeoutsData, error) {
+	return get[SessionTimeoutsData](ctx, client, apiUrlPlatformSessionTimeouts)
+}
+
+// SetSessionTimeouts replaces the API and web UI session timeouts.
+func SetSessionTimeouts(ctx context.Context, client *apstra.Client, in *SessionTimeoutsData) error {
+	return put(ctx, client, apiUrlPlatformSessionTimeouts, in)
+}
+
+// GetFeatureFlags fetches all feature flags.
+func GetFeatureFlags(ctx context.Context, client *apstra.Client) (*FeatureFlagsData, error) {
+	return get[FeatureFlagsData](ctx, client, apiUrlPlatformFeatureFlags)
+}
+
+// SetFeatureFlags changes the listed feature flags. Flags not included in the
+// request are not changed.
+func SetFeatureFlags(ctx context.Context, client *apstra.Client, in *FeatureFlagsData) error {
+	return put(ctx, client, apiUrlPlatformFeatureFlags, in)
+}
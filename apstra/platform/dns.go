@@ -0,0 +1,65 @@
+package platform
+
+import (
+	"context"
+
+	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Dns struct {
+	Id            types.String `tfsdk:"id"`
+	Nameservers   types.List   `tfsdk:"nameservers"`
+	SearchDomains types.List   `tfsdk:"search_domains"`
+}
+
+func (o Dns) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": singletonIdAttribute(DnsId),
+		"nameservers": resourceSchema.ListAttribute{
+			MarkdownDescription: "IP addresses of the DNS servers used by the Apstra server, in order of preference.",
+			Required:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(apstravalidator.ParseIp(false, false)),
+			},
+		},
+		"search_domains": resourceSchema.ListAttribute{
+			MarkdownDescription: "Domains searched when resolving unqualified hostnames, in order.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+	}
+}
+
+func (o *Dns) Request(ctx context.Context, _ string, diags *diag.Diagnostics) *DnsData {
+	result := DnsData{SearchDomains: []string{}}
+	diags.Append(o.Nameservers.ElementsAs(ctx, &result.Nameservers, false)...)
+	if !o.SearchDomains.IsNull() {
+		diags.Append(o.SearchDomains.ElementsAs(ctx, &result.SearchDomains, false)...)
+	}
+	if diags.HasError() {
+		return nil
+	}
+
+	return &result
+}
+
+func (o *Dns) LoadApiData(ctx context.Context, in *DnsData, diags *diag.Diagnostics) {
+	o.Id = types.StringValue(DnsId)
+	o.Nameservers = value.ListOrNull(ctx, types.StringType, in.Nameservers, diags)
+	o.SearchDomains = value.ListOrNull(ctx, types.StringType, in.SearchDomains, diags)
+}
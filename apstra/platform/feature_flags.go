@@ -0,0 +1,75 @@
+package platform
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type FeatureFlags struct {
+	Id    types.String `tfsdk:"id"`
+	Flags types.Map    `tfsdk:"flags"`
+}
+
+func (o FeatureFlags) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": singletonIdAttribute(FeatureFlagsId),
+		"flags": resourceSchema.MapAttribute{
+			MarkdownDescription: "Map of feature flag names to their desired state (`true` for enabled). Only " +
+				"the flags named here are managed; other flags are left unchanged. When imported, all flags " +
+				"reported by the Apstra server are loaded.",
+			Required:    true,
+			ElementType: types.BoolType,
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+				mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+	}
+}
+
+func (o *FeatureFlags) Request(ctx context.Context, _ string, diags *diag.Diagnostics) *FeatureFlagsData {
+	var flags map[string]bool
+	diags.Append(o.Flags.ElementsAs(ctx, &flags, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	// sort flag names so that the request is deterministic
+	names := make([]string, 0, len(flags))
+	for name := range flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := FeatureFlagsData{FeatureFlags: make([]featureFlagData, len(names))}
+	for i, name := range names {
+		result.FeatureFlags[i] = featureFlagData{Name: name, Enabled: flags[name]}
+	}
+
+	return &result
+}
+
+// LoadApiData loads the flags found in o.Flags from the API response. If
+// o.Flags is null (as it is during import) every flag is loaded.
+func (o *FeatureFlags) LoadApiData(ctx context.Context, in *FeatureFlagsData, diags *diag.Diagnostics) {
+	loadAll := o.Flags.IsNull() || o.Flags.IsUnknown()
+	managed := o.Flags.Elements()
+
+	flags := make(map[string]bool, len(in.FeatureFlags))
+	for _, flag := range in.FeatureFlags {
+		if _, ok := managed[flag.Name]; ok || loadAll {
+			flags[flag.Name] = flag.Enabled
+		}
+	}
+
+	o.Id = types.StringValue(FeatureFlagsId)
+	o.Flags = value.MapOrNull(ctx, types.BoolType, flags, diags)
+}
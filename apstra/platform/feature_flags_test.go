@@ -0,0 +1,88 @@
+package platform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureFlagsLoadApiData(t *testing.T) {
+	apiData := FeatureFlagsData{
+		FeatureFlags: []featureFlagData{
+			{Name: "a", Enabled: true},
+			{Name: "b", Enabled: false},
+			{Name: "c", Enabled: true},
+		},
+	}
+
+	type testCase struct {
+		state    types.Map
+		expected types.Map
+	}
+
+	testCases := map[string]testCase{
+		"import": {
+			state: types.MapNull(types.BoolType),
+			expected: types.MapValueMust(types.BoolType, map[string]attr.Value{
+				"a": types.BoolValue(true),
+				"b": types.BoolValue(false),
+				"c": types.BoolValue(true),
+			}),
+		},
+		"managed_subset": {
+			state: types.MapValueMust(types.BoolType, map[string]attr.Value{
+				"b": types.BoolValue(true),
+			}),
+			expected: types.MapValueMust(types.BoolType, map[string]attr.Value{
+				"b": types.BoolValue(false),
+			}),
+		},
+		"managed_flag_missing": {
+			state: types.MapValueMust(types.BoolType, map[string]attr.Value{
+				"a": types.BoolValue(true),
+				"z": types.BoolValue(true),
+			}),
+			expected: types.MapValueMust(types.BoolType, map[string]attr.Value{
+				"a": types.BoolValue(true),
+			}),
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			featureFlags := FeatureFlags{Flags: tCase.state}
+			featureFlags.LoadApiData(context.Background(), &apiData, &diags)
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, types.StringValue(FeatureFlagsId), featureFlags.Id)
+			require.Equal(t, tCase.expected, featureFlags.Flags)
+		})
+	}
+}
+
+func TestFeatureFlagsRequest(t *testing.T) {
+	featureFlags := FeatureFlags{
+		Flags: types.MapValueMust(types.BoolType, map[string]attr.Value{
+			"c": types.BoolValue(true),
+			"a": types.BoolValue(false),
+			"b": types.BoolValue(true),
+		}),
+	}
+
+	var diags diag.Diagnostics
+	request := featureFlags.Request(context.Background(), &diags)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, &FeatureFlagsData{
+		FeatureFlags: []featureFlagData{
+			{Name: "a", Enabled: false},
+			{Name: "b", Enabled: true},
+			{Name: "c", Enabled: true},
+		},
+	}, request)
+}
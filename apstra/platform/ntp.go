@@ -0,0 +1,50 @@
+package platform
+
+import (
+	"context"
+
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Ntp struct {
+	Id      types.String `tfsdk:"id"`
+	Servers types.List   `tfsdk:"servers"`
+}
+
+func (o Ntp) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": singletonIdAttribute(NtpId),
+		"servers": resourceSchema.ListAttribute{
+			MarkdownDescription: "Hostnames or IP addresses of the NTP servers used by the Apstra server, in " +
+				"order of preference.",
+			Required:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+	}
+}
+
+func (o *Ntp) Request(ctx context.Context, _ string, diags *diag.Diagnostics) *NtpData {
+	var result NtpData
+	diags.Append(o.Servers.ElementsAs(ctx, &result.Servers, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &result
+}
+
+func (o *Ntp) LoadApiData(ctx context.Context, in *NtpData, diags *diag.Diagnostics) {
+	o.Id = types.StringValue(NtpId)
+	o.Servers = value.ListOrNull(ctx, types.StringType, in.Servers, diags)
+}
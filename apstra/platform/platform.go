@@ -0,0 +1,45 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+)
+
+// IDs of the singleton platform settings resources. Each setting exists
+// exactly once on the Apstra server, so its ID is fixed.
+const (
+	SyslogId          = "syslog"
+	NtpId             = "ntp"
+	DnsId             = "dns"
+	SmtpId            = "smtp"
+	SessionTimeoutsId = "session_timeouts"
+	FeatureFlagsId    = "feature_flags"
+)
+
+// singletonIdAttribute returns the schema for the fixed "id" attribute of a
+// singleton platform settings resource.
+func singletonIdAttribute(id string) resourceSchema.Attribute {
+	return resourceSchema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Always `%s`. There is only one instance of this setting per Apstra "+
+			"server; this value is also the import ID.", id),
+		Computed: true,
+		Default:  stringdefault.StaticString(id),
+	}
+}
+
+// Setting is implemented by the models of the singleton platform settings
+// resources. D is the API representation of the setting.
+type Setting[D any] interface {
+	ResourceAttributes() map[string]resourceSchema.Attribute
+
+	// Request returns the API representation of the setting. secret is the
+	// value of the setting's write-only attribute, if it has one. Settings
+	// without a write-only attribute ignore it.
+	Request(ctx context.Context, secret string, diags *diag.Diagnostics) *D
+
+	LoadApiData(ctx context.Context, in *D, diags *diag.Diagnostics)
+}
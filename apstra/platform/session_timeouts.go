@@ -0,0 +1,46 @@
+package platform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SessionTimeouts struct {
+	Id                types.String `tfsdk:"id"`
+	ApiTimeoutMinutes types.Int64  `tfsdk:"api_timeout_minutes"`
+	UiTimeoutMinutes  types.Int64  `tfsdk:"ui_timeout_minutes"`
+}
+
+func (o SessionTimeouts) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": singletonIdAttribute(SessionTimeoutsId),
+		"api_timeout_minutes": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Number of minutes after which an API token expires.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"ui_timeout_minutes": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Number of minutes of inactivity after which a web UI session is logged out.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+	}
+}
+
+func (o *SessionTimeouts) Request(_ context.Context, _ string, _ *diag.Diagnostics) *SessionTimeoutsData {
+	return &SessionTimeoutsData{
+		ApiTimeout: o.ApiTimeoutMinutes.ValueInt64(),
+		UiTimeout:  o.UiTimeoutMinutes.ValueInt64(),
+	}
+}
+
+func (o *SessionTimeouts) LoadApiData(_ context.Context, in *SessionTimeoutsData, _ *diag.Diagnostics) {
+	o.Id = types.StringValue(SessionTimeoutsId)
+	o.ApiTimeoutMinutes = types.Int64Value(in.ApiTimeout)
+	o.UiTimeoutMinutes = types.Int64Value(in.UiTimeout)
+}
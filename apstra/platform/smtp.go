@@ -0,0 +1,137 @@
+package platform
+
+import (
+	"context"
+
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	smtpDefaultPort      = 587
+	smtpSecurityNone     = "none"
+	smtpSecurityStartTls = "starttls"
+	smtpSecurityTls      = "tls"
+)
+
+type Smtp struct {
+	Id                types.String `tfsdk:"id"`
+	Host              types.String `tfsdk:"host"`
+	Port              types.Int64  `tfsdk:"port"`
+	Security          types.String `tfsdk:"security"`
+	Username          types.String `tfsdk:"username"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+	Sender            types.String `tfsdk:"sender"`
+	Recipients        types.Set    `tfsdk:"recipients"`
+}
+
+func (o Smtp) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": singletonIdAttribute(SmtpId),
+		"host": resourceSchema.StringAttribute{
+			MarkdownDescription: "Hostname or IP address of the mail server.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"port": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Port number of the mail server. Default: `587`",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(smtpDefaultPort),
+			Validators:          []validator.Int64{int64validator.Between(1, 65535)},
+		},
+		"security": resourceSchema.StringAttribute{
+			MarkdownDescription: "Connection security used with the mail server. Must be one of `none`, " +
+				"`starttls` or `tls`. Default: `" + smtpSecurityStartTls + "`",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(smtpSecurityStartTls),
+			Validators: []validator.String{
+				stringvalidator.OneOf(smtpSecurityNone, smtpSecurityStartTls, smtpSecurityTls),
+			},
+		},
+		"username": resourceSchema.StringAttribute{
+			MarkdownDescription: "Username used to authenticate to the mail server. Omit when the server " +
+				"does not require authentication.",
+			Optional:   true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"password_wo": resourceSchema.StringAttribute{
+			MarkdownDescription: "Password used with `username` to authenticate to the mail server. This " +
+				"attribute is write-only: its value is sent to Apstra when the resource is created and whenever " +
+				"`password_wo_version` changes, but is never saved in the Terraform plan or state. Apstra retains " +
+				"the existing password when other attributes are updated. The value may be supplied by an " +
+				"ephemeral resource. Requires Terraform 1.11 or later.",
+			Optional:  true,
+			WriteOnly: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.AlsoRequires(path.MatchRoot("username")),
+			},
+		},
+		"password_wo_version": resourceSchema.Int64Attribute{
+			MarkdownDescription: utils.WriteOnlyVersionDescription("password_wo"),
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"sender": resourceSchema.StringAttribute{
+			MarkdownDescription: "Email address from which alert notifications are sent.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"recipients": resourceSchema.SetAttribute{
+			MarkdownDescription: "Email addresses to which alert notifications are sent.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+	}
+}
+
+// Request returns the API representation of the mail server configuration.
+// The password must be supplied by the caller because it is not available in
+// the plan. An empty password is omitted from the request, in which case
+// Apstra retains the existing password.
+func (o *Smtp) Request(ctx context.Context, password string, diags *diag.Diagnostics) *SmtpData {
+	result := SmtpData{
+		Host:       o.Host.ValueString(),
+		Port:       o.Port.ValueInt64(),
+		Security:   o.Security.ValueString(),
+		Username:   o.Username.ValueString(),
+		Password:   password,
+		Sender:     o.Sender.ValueString(),
+		Recipients: []string{},
+	}
+	if !o.Recipients.IsNull() {
+		diags.Append(o.Recipients.ElementsAs(ctx, &result.Recipients, false)...)
+		if diags.HasError() {
+			return nil
+		}
+	}
+
+	return &result
+}
+
+func (o *Smtp) LoadApiData(ctx context.Context, in *SmtpData, diags *diag.Diagnostics) {
+	o.Id = types.StringValue(SmtpId)
+	o.Host = types.StringValue(in.Host)
+	o.Port = types.Int64Value(in.Port)
+	o.Security = types.StringValue(in.Security)
+	o.Username = value.StringOrNull(ctx, in.Username, diags)
+	o.Sender = types.StringValue(in.Sender)
+	o.Recipients = value.SetOrNull(ctx, types.StringType, in.Recipients, diags)
+}
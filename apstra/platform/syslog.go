@@ -0,0 +1,128 @@
+package platform
+
+import (
+	"context"
+
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const syslogDefaultFacility = "local0"
+
+var syslogFacilities = []string{
+	"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news", "uucp", "cron", "authpriv", "ftp",
+	"local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+}
+
+type Syslog struct {
+	Id        types.String `tfsdk:"id"`
+	Facility  types.String `tfsdk:"facility"`
+	Receivers types.Set    `tfsdk:"receivers"`
+}
+
+func (o Syslog) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": singletonIdAttribute(SyslogId),
+		"facility": resourceSchema.StringAttribute{
+			MarkdownDescription: "Syslog facility of messages forwarded by the Apstra server. Default: `" +
+				syslogDefaultFacility + "`",
+			Optional:   true,
+			Computed:   true,
+			Default:    stringdefault.StaticString(syslogDefaultFacility),
+			Validators: []validator.String{stringvalidator.OneOf(syslogFacilities...)},
+		},
+		"receivers": resourceSchema.SetNestedAttribute{
+			MarkdownDescription: "Set of syslog servers to which the Apstra server forwards its logs.",
+			Required:            true,
+			NestedObject: resourceSchema.NestedAttributeObject{
+				Attributes: syslogReceiver{}.ResourceAttributes(),
+			},
+			Validators: []validator.Set{setvalidator.SizeAtLeast(1)},
+		},
+	}
+}
+
+func (o *Syslog) Request(ctx context.Context, _ string, diags *diag.Diagnostics) *SyslogData {
+	var receivers []syslogReceiver
+	diags.Append(o.Receivers.ElementsAs(ctx, &receivers, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	result := SyslogData{
+		Facility:  o.Facility.ValueString(),
+		Receivers: make([]syslogReceiverData, len(receivers)),
+	}
+	for i, receiver := range receivers {
+		result.Receivers[i] = receiver.request()
+	}
+
+	return &result
+}
+
+func (o *Syslog) LoadApiData(ctx context.Context, in *SyslogData, diags *diag.Diagnostics) {
+	receivers := make([]syslogReceiver, len(in.Receivers))
+	for i, receiver := range in.Receivers {
+		receivers[i].loadApiData(ctx, receiver, diags)
+	}
+
+	o.Id = types.StringValue(SyslogId)
+	o.Facility = types.StringValue(in.Facility)
+	o.Receivers = value.SetOrNull(ctx, types.ObjectType{AttrTypes: syslogReceiver{}.attrTypes()}, receivers, diags)
+}
+
+type syslogReceiver struct {
+	Address  types.String `tfsdk:"address"`
+	Port     types.Int64  `tfsdk:"port"`
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+func (o syslogReceiver) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"address":  types.StringType,
+		"port":     types.Int64Type,
+		"protocol": types.StringType,
+	}
+}
+
+func (o syslogReceiver) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"address": resourceSchema.StringAttribute{
+			MarkdownDescription: "Hostname or IP address of the syslog server.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"port": resourceSchema.Int64Attribute{
+			MarkdownDescription: "Port number of the syslog server, usually `514`.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.Between(1, 65535)},
+		},
+		"protocol": resourceSchema.StringAttribute{
+			MarkdownDescription: "Transport protocol used to reach the syslog server. Must be `udp` or `tcp`.",
+			Required:            true,
+			Validators:          []validator.String{stringvalidator.OneOf("udp", "tcp")},
+		},
+	}
+}
+
+func (o syslogReceiver) request() syslogReceiverData {
+	return syslogReceiverData{
+		Address:  o.Address.ValueString(),
+		Port:     o.Port.ValueInt64(),
+		Protocol: o.Protocol.ValueString(),
+	}
+}
+
+func (o *syslogReceiver) loadApiData(_ context.Context, in syslogReceiverData, _ *diag.Diagnostics) {
+	o.Address = types.StringValue(in.Address)
+	o.Port = types.Int64Value(in.Port)
+	o.Protocol = types.StringValue(in.Protocol)
+}
@@ -0,0 +1,37 @@
+package private
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type EphemeralBlueprintMutex struct {
+	BlueprintId string `json:"blueprint_id"`
+	TagId       string `json:"tag_id"`
+}
+
+func (o *EphemeralBlueprintMutex) LoadPrivateState(ctx context.Context, ps State, diags *diag.Diagnostics) {
+	b, d := ps.GetKey(ctx, "EphemeralBlueprintMutex")
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	err := json.Unmarshal(b, &o)
+	if err != nil {
+		diags.AddError("failed to unmarshal private state", err.Error())
+		return
+	}
+}
+
+func (o *EphemeralBlueprintMutex) SetPrivateState(ctx context.Context, ps State, diags *diag.Diagnostics) {
+	b, err := json.Marshal(o)
+	if err != nil {
+		diags.AddError("failed to marshal private state", err.Error())
+		return
+	}
+
+	diags.Append(ps.SetKey(ctx, "EphemeralBlueprintMutex", b)...)
+}
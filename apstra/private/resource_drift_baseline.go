@@ -0,0 +1,46 @@
+package private
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ResourceDriftBaseline is stored in private state by resources which check
+// their blueprint graph node for drift. It records a fingerprint of the node
+// in the staging blueprint as it was last written by Terraform, so that Read()
+// can distinguish changes made by Terraform (which are expected to be
+// undeployed until the next commit) from changes made by others.
+type ResourceDriftBaseline struct {
+	Fingerprint string `json:"fingerprint"`
+}
+
+func (o *ResourceDriftBaseline) LoadPrivateState(ctx context.Context, ps State, diags *diag.Diagnostics) {
+	b, d := ps.GetKey(ctx, fmt.Sprintf("%T", *o))
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(b) == 0 {
+		return
+	}
+
+	err := json.Unmarshal(b, &o)
+	if err != nil {
+		diags.AddError("failed to unmarshal private state", err.Error())
+		return
+	}
+}
+
+func (o ResourceDriftBaseline) SetPrivateState(ctx context.Context, ps State, diags *diag.Diagnostics) {
+	b, err := json.Marshal(o)
+	if err != nil {
+		diags.AddError("failed to marshal private state", err.Error())
+		return
+	}
+
+	diags.Append(ps.SetKey(ctx, fmt.Sprintf("%T", o), b)...)
+}
@@ -0,0 +1,51 @@
+package private
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ResourceImported is stored in private state by the ImportState() method of
+// resources which ordinarily use their prior state to decide which optional
+// attributes to populate in Read(). It signals to the first Read() following
+// an import that every attribute should be read from the API. Read() should
+// Clear() the record once it has been consumed.
+type ResourceImported struct {
+	Imported bool `json:"imported"`
+}
+
+func (o *ResourceImported) LoadPrivateState(ctx context.Context, ps State, diags *diag.Diagnostics) {
+	b, d := ps.GetKey(ctx, fmt.Sprintf("%T", *o))
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(b) == 0 {
+		return
+	}
+
+	err := json.Unmarshal(b, &o)
+	if err != nil {
+		diags.AddError("failed to unmarshal private state", err.Error())
+		return
+	}
+}
+
+func (o ResourceImported) SetPrivateState(ctx context.Context, ps State, diags *diag.Diagnostics) {
+	b, err := json.Marshal(o)
+	if err != nil {
+		diags.AddError("failed to marshal private state", err.Error())
+		return
+	}
+
+	diags.Append(ps.SetKey(ctx, fmt.Sprintf("%T", o), b)...)
+}
+
+// Clear removes the record from private state.
+func (o ResourceImported) Clear(ctx context.Context, ps State, diags *diag.Diagnostics) {
+	diags.Append(ps.SetKey(ctx, fmt.Sprintf("%T", o), nil)...)
+}
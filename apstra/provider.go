@@ -22,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -39,7 +41,10 @@ const (
 	winCertErrStringMatch = "x509: certificate signed by unknown authority"
 	linCertErrStringMatch = "x509: cannot validate certificate for"
 
-	defaultApiTimeout = 10
+	defaultApiTimeout      = 10
+	defaultApiRetryMax     = 3
+	defaultApiRetryWaitMin = 1
+	defaultApiRetryWaitMax = 30
 
 	disableTlsValidationMsg = `!!! BAD IDEA WARNING !!!
 
@@ -57,6 +62,7 @@ var gitCommit, gitTag string // populated by goreleaser
 var (
 	_ provider.Provider                       = (*Provider)(nil)
 	_ provider.ProviderWithEphemeralResources = (*Provider)(nil)
+	_ provider.ProviderWithFunctions          = (*Provider)(nil)
 )
 
 // NewProvider instantiates the provider in main
@@ -82,6 +88,10 @@ func NewProvider() provider.Provider {
 // map of mutexes keyed by blueprint ID
 var blueprintMutexes map[string]apstra.Mutex
 
+// map of the messages of mutex tags created by this provider, keyed by
+// blueprint ID. The message identifies the tag which represents the mutex.
+var blueprintMutexMessages = make(map[string]string)
+
 // mutex which we use to control access to blueprintMutexes
 var blueprintMutexesMutex sync.Mutex
 
@@ -107,8 +117,11 @@ type providerData struct {
 	client                  *apstra.Client
 	providerVersion         string
 	terraformVersion        string
-	bpLockFunc              func(context.Context, string) error
+	bpLockFunc              blueprintLockFunc
+	bpDriftCheckFunc        blueprintDriftCheckFunc
+	bpDriftBaselineFunc     blueprintDriftBaselineFunc
 	bpUnlockFunc            func(context.Context, string) error
+	bpMutexLocker           *blueprintMutexLocker
 	getTwoStageL3ClosClient func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
 	getFreeformClient       func(context.Context, string) (*apstra.FreeformClient, error)
 	experimental            bool
@@ -163,6 +176,42 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 				Optional:   true,
 				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
+			"blueprint_mutex_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time, in seconds, to wait for a Blueprint mutex held by some other " +
+					"client before giving up with an error. Omit, or set to 0, to wait indefinitely. Only relevant " +
+					"when `blueprint_mutex_enabled` is `true`.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"blueprint_drift_warnings": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, resources which manage a single Blueprint graph node compare " +
+					"that node in the staging Blueprint with its counterpart in the active (deployed) Blueprint " +
+					"whenever they are read. These are `apstra_datacenter_configlet`, " +
+					"`apstra_datacenter_connectivity_template`, `apstra_datacenter_external_gateway`, " +
+					"`apstra_datacenter_generic_system`, `apstra_datacenter_interconnect_domain`, " +
+					"`apstra_datacenter_interconnect_domain_gateway`, `apstra_datacenter_rack`, " +
+					"`apstra_datacenter_routing_policy`, `apstra_datacenter_routing_zone`, " +
+					"`apstra_datacenter_security_policy` and `apstra_datacenter_virtual_network`. A node which " +
+					"differs, and which has changed since it was last written by Terraform, produces a warning in " +
+					"`terraform plan` output naming the resource attributes which differ (and any graph node " +
+					"attributes not managed by the resource), revealing changes staged outside of Terraform which " +
+					"would be deployed by the next commit. Terraform's own undeployed changes do not produce " +
+					"warnings, except on nodes last written by an earlier release of the provider, by a different " +
+					"resource, or by an import. Other resources are not checked. The comparison is made once per " +
+					"Blueprint per Terraform run. See also the `apstra_blueprint_drift` data source. Requires Apstra " +
+					compatibility.BlueprintDriftOK.String() + ".",
+				Optional: true,
+			},
+			"blueprint_mutex_ttl": schema.Int64Attribute{
+				MarkdownDescription: "Lease duration, in seconds, embedded in Blueprint mutexes created by this " +
+					"provider. Any client (including other instances of Terraform) waiting on a mutex with an expired " +
+					"lease will break the mutex, with a warning identifying the previous holder, and take the lock for " +
+					"itself. The value should comfortably exceed the longest expected `terraform apply` run. Omit, or " +
+					"set to 0, to create mutexes which never expire. Stale mutexes with expired leases are broken " +
+					"regardless of this setting. Only relevant when `blueprint_mutex_enabled` is `true`.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
 			"experimental": schema.BoolAttribute{
 				MarkdownDescription: "Enable *experimental* features. In this release that means:\n" +
 					"  - Set the `experimental` flag in the underlying Apstra SDK client object. Doing so permits " +
@@ -177,6 +226,33 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 				Optional:   true,
 				Validators: []validator.Int64{int64validator.AtLeast(0)},
 			},
+			"api_retry_max": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of times an API request is retried when it fails with "+
+					"a transient error. Transient errors are `429 Too Many Requests` and `503 Service Unavailable` "+
+					"responses, `502 Bad Gateway` and `504 Gateway Timeout` responses to idempotent requests, and "+
+					"conflicts caused by a Blueprint which is being built. Omit for default value of %d. Value of "+
+					"0 disables retries.",
+					defaultApiRetryMax),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"api_retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Delay in seconds before the first retry of a failed API "+
+					"request. The delay doubles with each subsequent retry, up to `api_retry_wait_max`, and is "+
+					"randomly reduced by up to half to avoid synchronized retries. Omit for default value of %d "+
+					"seconds.",
+					defaultApiRetryWaitMin),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"api_retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum delay in seconds between retries of a failed API "+
+					"request. Also caps any delay requested by the server with a `Retry-After` header. Omit for "+
+					"default value of %d seconds.",
+					defaultApiRetryWaitMax),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
 			"env_var_prefix": schema.StringAttribute{
 				MarkdownDescription: fmt.Sprintf("This attribute defines a prefix which redefines all of the " +
 					"`APSTRA_*` environment variables. For example, setting `env_var_prefix = \"FOO_\"` will cause " +
@@ -193,13 +269,19 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 
 // Provider configuration struct. Matches GetSchema() output.
 type providerConfig struct {
-	Url          types.String `tfsdk:"url"`
-	TlsNoVerify  types.Bool   `tfsdk:"tls_validation_disabled"`
-	MutexEnable  types.Bool   `tfsdk:"blueprint_mutex_enabled"`
-	MutexMessage types.String `tfsdk:"blueprint_mutex_message"`
-	Experimental types.Bool   `tfsdk:"experimental"`
-	ApiTimeout   types.Int64  `tfsdk:"api_timeout"`
-	EnvVarPrefix types.String `tfsdk:"env_var_prefix"`
+	Url             types.String `tfsdk:"url"`
+	TlsNoVerify     types.Bool   `tfsdk:"tls_validation_disabled"`
+	MutexEnable     types.Bool   `tfsdk:"blueprint_mutex_enabled"`
+	MutexMessage    types.String `tfsdk:"blueprint_mutex_message"`
+	MutexTimeout    types.Int64  `tfsdk:"blueprint_mutex_timeout"`
+	MutexTtl        types.Int64  `tfsdk:"blueprint_mutex_ttl"`
+	DriftWarnings   types.Bool   `tfsdk:"blueprint_drift_warnings"`
+	Experimental    types.Bool   `tfsdk:"experimental"`
+	ApiTimeout      types.Int64  `tfsdk:"api_timeout"`
+	ApiRetryMax     types.Int64  `tfsdk:"api_retry_max"`
+	ApiRetryWaitMin types.Int64  `tfsdk:"api_retry_wait_min"`
+	ApiRetryWaitMax types.Int64  `tfsdk:"api_retry_wait_max"`
+	EnvVarPrefix    types.String `tfsdk:"env_var_prefix"`
 }
 
 func (o *providerConfig) fromEnv(_ context.Context, diags *diag.Diagnostics) {
@@ -229,6 +311,38 @@ func (o *providerConfig) fromEnv(_ context.Context, diags *diag.Diagnostics) {
 		o.MutexMessage = types.StringValue(s)
 	}
 
+	if s, ok := os.LookupEnv(envVarPrefix + constants.EnvBlueprintMutexTimeout); ok && o.MutexTimeout.IsNull() {
+		v, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("error parsing environment variable %q", envVarPrefix+constants.EnvBlueprintMutexTimeout), err.Error())
+		}
+		if v < 0 {
+			diags.AddError(fmt.Sprintf("invalid value in environment variable %q", envVarPrefix+constants.EnvBlueprintMutexTimeout),
+				fmt.Sprintf("minimum permitted value is 0, got %d", v))
+		}
+		o.MutexTimeout = types.Int64Value(v)
+	}
+
+	if s, ok := os.LookupEnv(envVarPrefix + constants.EnvBlueprintMutexTtl); ok && o.MutexTtl.IsNull() {
+		v, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("error parsing environment variable %q", envVarPrefix+constants.EnvBlueprintMutexTtl), err.Error())
+		}
+		if v < 0 {
+			diags.AddError(fmt.Sprintf("invalid value in environment variable %q", envVarPrefix+constants.EnvBlueprintMutexTtl),
+				fmt.Sprintf("minimum permitted value is 0, got %d", v))
+		}
+		o.MutexTtl = types.Int64Value(v)
+	}
+
+	if s, ok := os.LookupEnv(envVarPrefix + constants.EnvBlueprintDriftWarnings); ok && o.DriftWarnings.IsNull() {
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("error parsing environment variable %q", envVarPrefix+constants.EnvBlueprintDriftWarnings), err.Error())
+		}
+		o.DriftWarnings = types.BoolValue(v)
+	}
+
 	if s, ok := os.LookupEnv(envVarPrefix + constants.EnvExperimental); ok && o.Experimental.IsNull() {
 		v, err := strconv.ParseBool(s)
 		if err != nil {
@@ -248,6 +362,71 @@ func (o *providerConfig) fromEnv(_ context.Context, diags *diag.Diagnostics) {
 		}
 		o.ApiTimeout = types.Int64Value(v)
 	}
+
+	if s, ok := os.LookupEnv(envVarPrefix + constants.EnvApiRetryMax); ok && o.ApiRetryMax.IsNull() {
+		v, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("error parsing environment variable %q", envVarPrefix+constants.EnvApiRetryMax), err.Error())
+		}
+		if v < 0 {
+			diags.AddError(fmt.Sprintf("invalid value in environment variable %q", envVarPrefix+constants.EnvApiRetryMax),
+				fmt.Sprintf("minimum permitted value is 0, got %d", v))
+		}
+		o.ApiRetryMax = types.Int64Value(v)
+	}
+
+	if s, ok := os.LookupEnv(envVarPrefix + constants.EnvApiRetryWaitMin); ok && o.ApiRetryWaitMin.IsNull() {
+		v, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("error parsing environment variable %q", envVarPrefix+constants.EnvApiRetryWaitMin), err.Error())
+		}
+		if v < 0 {
+			diags.AddError(fmt.Sprintf("invalid value in environment variable %q", envVarPrefix+constants.EnvApiRetryWaitMin),
+				fmt.Sprintf("minimum permitted value is 0, got %d", v))
+		}
+		o.ApiRetryWaitMin = types.Int64Value(v)
+	}
+
+	if s, ok := os.LookupEnv(envVarPrefix + constants.EnvApiRetryWaitMax); ok && o.ApiRetryWaitMax.IsNull() {
+		v, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("error parsing environment variable %q", envVarPrefix+constants.EnvApiRetryWaitMax), err.Error())
+		}
+		if v < 0 {
+			diags.AddError(fmt.Sprintf("invalid value in environment variable %q", envVarPrefix+constants.EnvApiRetryWaitMax),
+				fmt.Sprintf("minimum permitted value is 0, got %d", v))
+		}
+		o.ApiRetryWaitMax = types.Int64Value(v)
+	}
+}
+
+// retryPolicy returns the API retry policy described by the configuration,
+// filling in defaults for omitted values.
+func (o *providerConfig) retryPolicy(diags *diag.Diagnostics) utils.RetryPolicy {
+	if o.ApiRetryMax.IsNull() {
+		o.ApiRetryMax = types.Int64Value(defaultApiRetryMax)
+	}
+	if o.ApiRetryWaitMin.IsNull() {
+		o.ApiRetryWaitMin = types.Int64Value(defaultApiRetryWaitMin)
+	}
+	if o.ApiRetryWaitMax.IsNull() {
+		o.ApiRetryWaitMax = types.Int64Value(max(defaultApiRetryWaitMax, o.ApiRetryWaitMin.ValueInt64()))
+	}
+
+	if o.ApiRetryWaitMin.ValueInt64() > o.ApiRetryWaitMax.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("api_retry_wait_min"),
+			"invalid retry configuration",
+			fmt.Sprintf("api_retry_wait_min (%d) must not exceed api_retry_wait_max (%d)",
+				o.ApiRetryWaitMin.ValueInt64(), o.ApiRetryWaitMax.ValueInt64()),
+		)
+	}
+
+	return utils.RetryPolicy{
+		MaxRetries: int(o.ApiRetryMax.ValueInt64()),
+		WaitMin:    time.Duration(o.ApiRetryWaitMin.ValueInt64()) * time.Second,
+		WaitMax:    time.Duration(o.ApiRetryWaitMax.ValueInt64()) * time.Second,
+	}
 }
 
 func (o providerConfig) handleMutexFlag(_ context.Context, diags *diag.Diagnostics) {
@@ -264,7 +443,12 @@ func (o providerConfig) handleMutexFlag(_ context.Context, diags *diag.Diagnosti
 	}
 
 	if !o.MutexEnable.IsNull() && o.MutexEnable.ValueBool() {
-		blueprintMutexes = make(map[string]apstra.Mutex, 0) // non-nil slice signals intent use blueprint mutexes
+		// Configure may run more than once in a single process (terraform
+		// configures the provider for each graph walk), so keep any mutexes
+		// already recorded as held.
+		if blueprintMutexes == nil {
+			blueprintMutexes = make(map[string]apstra.Mutex, 0) // non-nil slice signals intent use blueprint mutexes
+		}
 	}
 }
 
@@ -311,6 +495,14 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		clientCfg.HttpClient.Transport = transport
 	}
 
+	// Retry requests which fail with transient errors
+	retryPolicy := config.retryPolicy(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	retryPolicy.Logger = clientCfg.Logger
+	clientCfg.HttpClient.Transport = utils.NewRetryTransport(clientCfg.HttpClient.Transport, retryPolicy)
+
 	// Set the API timeout
 	if config.ApiTimeout.IsNull() {
 		config.ApiTimeout = types.Int64Value(defaultApiTimeout)
@@ -367,6 +559,14 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		return
 	}
 
+	// drift warnings query the API directly (see blueprint.GetDrift)
+	if config.DriftWarnings.ValueBool() {
+		compatibility.RequireApiVersion(client.ApiVersion(), compatibility.BlueprintDriftOK, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Login after client creation so that future parallel
 	// workflows don't trigger TOO MANY REQUESTS threshold.
 	err = client.Login(ctx)
@@ -380,7 +580,14 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		return
 	}
 
-	bpLockFunc := func(ctx context.Context, id string) error {
+	mutexLocker := blueprintMutexLocker{
+		client:  client,
+		message: config.MutexMessage.ValueString(),
+		timeout: time.Duration(config.MutexTimeout.ValueInt64()) * time.Second,
+		ttl:     time.Duration(config.MutexTtl.ValueInt64()) * time.Second,
+	}
+
+	bpLockFunc := func(ctx context.Context, id string, diags *diag.Diagnostics) error {
 		if blueprintMutexes == nil {
 			// A nil map indicates we're not configured to lock the mutex.
 			return nil
@@ -399,27 +606,16 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 			return fmt.Errorf("error creating blueprint client while attempting to lock blueprint mutex - %w", err)
 		}
 
-		// Shove the date into the environment so it's available to ExpandEnv.
-		// This should probably be in a text/template configuration.
-		err = os.Setenv("DATE", time.Now().UTC().String())
-		if err != nil {
-			return fmt.Errorf("error setting the 'DATE' environment variable - %w", err)
-		}
-
-		// Set the mutex message.
-		err = bpClient.Mutex.SetMessage(os.ExpandEnv(config.MutexMessage.ValueString()))
-		if err != nil {
-			return fmt.Errorf("error setting mutex message - %w", err)
-		}
-
-		// This is a blocking call. We get the lock, we hit an error, or we wait.
-		err = bpClient.Mutex.Lock(ctx)
+		// This is a blocking call. We get the lock, we hit an error, or we wait
+		// (possibly until the configured timeout).
+		message, err := mutexLocker.lock(ctx, id, bpClient.Mutex, diags)
 		if err != nil {
-			return fmt.Errorf("error locking blueprint mutex - %w", err)
+			return err
 		}
 
 		// Drop the Mutex into the map so that it can be unlocked after deployment.
 		blueprintMutexes[id] = bpClient.Mutex
+		blueprintMutexMessages[id] = message
 
 		return nil
 	}
@@ -429,6 +625,7 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		defer blueprintMutexesMutex.Unlock()
 		if m, ok := blueprintMutexes[id]; ok {
 			delete(blueprintMutexes, id)
+			delete(blueprintMutexMessages, id)
 			return m.Unlock(ctx)
 		}
 		return nil
@@ -492,13 +689,21 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		return freeformClient, nil
 	}
 
+	driftChecker := blueprintDriftChecker{
+		client:  client,
+		enabled: config.DriftWarnings.ValueBool(),
+	}
+
 	// data passed to Resource, DataSource, and Ephemeral Configure() methods
 	pd := providerData{
 		client:                  client,
 		providerVersion:         p.Version + "-" + p.Commit,
 		terraformVersion:        req.TerraformVersion,
 		bpLockFunc:              bpLockFunc,
+		bpDriftCheckFunc:        driftChecker.check,
+		bpDriftBaselineFunc:     driftChecker.baseline,
 		bpUnlockFunc:            bpUnlockFunc,
+		bpMutexLocker:           &mutexLocker,
 		getTwoStageL3ClosClient: getTwoStageL3ClosClient,
 		getFreeformClient:       getFreeformClient,
 		experimental:            config.Experimental.ValueBool(),
@@ -519,12 +724,17 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		func() datasource.DataSource { return &dataSourceAsnPools{} },
 		func() datasource.DataSource { return &dataSourceBlueprintAnomalies{} },
 		func() datasource.DataSource { return &dataSourceBlueprintDeploy{} },
-		func() datasource.DataSource { return &dataSourceBlueprintIbaPredefinedProbe{} },
-		// func() datasource.DataSource { return &dataSourceBlueprintIbaWidget{} },
-		// func() datasource.DataSource { return &dataSourceBlueprintIbaWidgets{} },
-		// func() datasource.DataSource { return &dataSourceBlueprintIbaDashboard{} },
+		func() datasource.DataSource { return &dataSourceBlueprintDeployPreview{} },
+		func() datasource.DataSource { return &dataSourceBlueprintDrift{} },
+		func() datasource.DataSource { return &dataSourceBlueprintIbaDashboard{} },
 		func() datasource.DataSource { return &dataSourceBlueprintIbaDashboards{} },
+		func() datasource.DataSource { return &dataSourceBlueprintIbaPredefinedProbe{} },
+		func() datasource.DataSource { return &dataSourceBlueprintIbaWidget{} },
+		func() datasource.DataSource { return &dataSourceBlueprintIbaWidgets{} },
+		func() datasource.DataSource { return &dataSourceBlueprintImportConfig{} },
+		func() datasource.DataSource { return &dataSourceBlueprintMutex{} },
 		func() datasource.DataSource { return &dataSourceBlueprintNodeConfig{} },
+		func() datasource.DataSource { return &dataSourceBlueprintRevisions{} },
 		func() datasource.DataSource { return &dataSourceBlueprints{} },
 		func() datasource.DataSource { return &dataSourceConfiglet{} },
 		func() datasource.DataSource { return &dataSourceConfiglets{} },
@@ -569,6 +779,8 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		func() datasource.DataSource { return &dataSourceDatacenterVirtualNetwork{} },
 		func() datasource.DataSource { return &dataSourceDatacenterVirtualNetworks{} },
 		func() datasource.DataSource { return &dataSourceDeviceConfig{} },
+		func() datasource.DataSource { return &dataSourceDeviceProfile{} },
+		func() datasource.DataSource { return &dataSourceDeviceProfiles{} },
 		func() datasource.DataSource { return &dataSourceFreeformAggregateLink{} },
 		func() datasource.DataSource { return &dataSourceFreeformAllocGroup{} },
 		func() datasource.DataSource { return &dataSourceFreeformBlueprint{} },
@@ -585,6 +797,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		func() datasource.DataSource { return &dataSourceInterfacesBySystem{} },
 		func() datasource.DataSource { return &dataSourceIntegerPools{} },
 		func() datasource.DataSource { return &dataSourceInterfaceMap{} },
+		func() datasource.DataSource { return &dataSourceInterfaceMapGenerator{} },
 		func() datasource.DataSource { return &dataSourceInterfaceMaps{} },
 		func() datasource.DataSource { return &dataSourceIpv4Pool{} },
 		func() datasource.DataSource { return &dataSourceIpv4Pools{} },
@@ -613,6 +826,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 // EphemeralResources defines provider ephemeral resources
 func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
+		func() ephemeral.EphemeralResource { return &ephemeralBlueprintMutex{} },
 		func() ephemeral.EphemeralResource { return &ephemeralToken{} },
 	}
 }
@@ -620,12 +834,19 @@ func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.Ephe
 // Resources defines provider resources
 func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		newResourceAaaLdapProvider,
+		func() resource.Resource { return &resourceAaaProviderRoleMapping{} },
+		newResourceAaaRadiusProvider,
+		newResourceAaaTacacsProvider,
 		func() resource.Resource { return &resourceAgentProfile{} },
 		func() resource.Resource { return &resourceAsnPool{} },
 		func() resource.Resource { return &resourceBlueprintDeploy{} },
-		// func() resource.Resource { return &resourceBlueprintIbaDashboard{} },
+		func() resource.Resource { return &resourceBlueprintIbaDashboard{} },
 		func() resource.Resource { return &resourceBlueprintIbaProbe{} },
-		// func() resource.Resource { return &resourceBlueprintIbaWidget{} },
+		func() resource.Resource { return &resourceBlueprintIbaWidget{} },
+		func() resource.Resource { return &resourceBlueprintMutex{} },
+		func() resource.Resource { return &resourceBlueprintPermission{} },
+		func() resource.Resource { return &resourceBlueprintRollback{} },
 		func() resource.Resource { return &resourceConfiglet{} },
 		func() resource.Resource { return &resourceDatacenterBlueprint{} },
 		func() resource.Resource { return &resourceDatacenterConfiglet{} },
@@ -653,6 +874,8 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		func() resource.Resource { return &resourceDatacenterTag{} },
 		func() resource.Resource { return &resourceDatacenterVirtualNetwork{} },
 		func() resource.Resource { return &resourceDeviceAllocation{} },
+		func() resource.Resource { return &resourceDeviceOsUpgrade{} },
+		func() resource.Resource { return &resourceDeviceProfile{} },
 		func() resource.Resource { return &resourceFreeformAggregateLink{} },
 		func() resource.Resource { return &resourceFreeformAllocGroup{} },
 		func() resource.Resource { return &resourceFreeformBlueprint{} },
@@ -673,19 +896,38 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		func() resource.Resource { return &resourceManagedDevice{} },
 		func() resource.Resource { return &resourceManagedDeviceAck{} },
 		func() resource.Resource { return &resourceModularDeviceProfile{} },
+		func() resource.Resource { return &resourceOsImage{} },
+		newResourcePlatformDns,
+		newResourcePlatformFeatureFlags,
+		newResourcePlatformNtp,
+		newResourcePlatformSessionTimeouts,
+		newResourcePlatformSmtp,
+		newResourcePlatformSyslog,
 		func() resource.Resource { return &resourceRawJSON{} },
 		func() resource.Resource { return &resourceResourcePoolAllocation{} },
 		func() resource.Resource { return &resourcePropertySet{} },
 		func() resource.Resource { return &resourceRackType{} },
+		func() resource.Resource { return &resourceRole{} },
 		func() resource.Resource { return &resourceTag{} },
 		func() resource.Resource { return &resourceTelemetryServiceRegistryEntry{} },
 		func() resource.Resource { return &resourceTemplateCollapsed{} },
 		func() resource.Resource { return &resourceTemplatePodBased{} },
 		func() resource.Resource { return &resourceTemplateRackBased{} },
+		func() resource.Resource { return &resourceUser{} },
 		func() resource.Resource { return &resourceVniPool{} },
 	}
 }
 
+func (p *Provider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		func() function.Function { return &functionCidrWithin{} },
+		func() function.Function { return &functionInterfaceNameExpand{} },
+		func() function.Function { return &functionParseRouteTarget{} },
+		func() function.Function { return &functionSpeedNormalize{} },
+		func() function.Function { return &functionVlanRange{} },
+	}
+}
+
 func terraformVersionWarnings(_ context.Context, version string, diags *diag.Diagnostics) {
 	const tf150warning = "" +
 		"You're using Terraform %s. Terraform 1.5.0 has a known issue calculating " +
@@ -0,0 +1,228 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/authentication"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &resourceAaaProvider{}
+	_ resource.ResourceWithValidateConfig = &resourceAaaProvider{}
+	_ resource.ResourceWithImportState    = &resourceAaaProvider{}
+	_ resourceWithSetClient               = &resourceAaaProvider{}
+)
+
+// resourceAaaProvider implements the apstra_aaa_ldap_provider,
+// apstra_aaa_radius_provider and apstra_aaa_tacacs_provider resources, which
+// differ only in their model and the name of their write-only secret.
+type resourceAaaProvider struct {
+	client *apstra.Client
+
+	typeName    string                         // resource type name without the provider prefix
+	label       string                         // provider type in messages, e.g. "LDAP"
+	secretName  string                         // write-only secret attribute name without the "_wo" suffix
+	description string                         // resource-specific part of the schema description
+	newModel    func() authentication.Provider // returns a pointer to an empty model
+}
+
+func newResourceAaaLdapProvider() resource.Resource {
+	return &resourceAaaProvider{
+		typeName:    "_aaa_ldap_provider",
+		label:       "LDAP",
+		secretName:  "bind_password",
+		description: "The bind password is accepted only through the write-only `bind_password_wo` attribute",
+		newModel:    func() authentication.Provider { return new(authentication.LdapProvider) },
+	}
+}
+
+func newResourceAaaRadiusProvider() resource.Resource {
+	return &resourceAaaProvider{
+		typeName:    "_aaa_radius_provider",
+		label:       "RADIUS",
+		secretName:  "shared_secret",
+		description: "The shared secret is accepted only through the write-only `shared_secret_wo` attribute",
+		newModel:    func() authentication.Provider { return new(authentication.RadiusProvider) },
+	}
+}
+
+func newResourceAaaTacacsProvider() resource.Resource {
+	return &resourceAaaProvider{
+		typeName:    "_aaa_tacacs_provider",
+		label:       "TACACS+",
+		secretName:  "shared_secret",
+		description: "The shared secret is accepted only through the write-only `shared_secret_wo` attribute",
+		newModel:    func() authentication.Provider { return new(authentication.TacacsProvider) },
+	}
+}
+
+func (o *resourceAaaProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + o.typeName
+}
+
+func (o *resourceAaaProvider) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	configureResource(ctx, o, req, resp)
+}
+
+func (o *resourceAaaProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	groups := "LDAP groups"
+	if o.label != "LDAP" {
+		groups = fmt.Sprintf("groups reported by the %s servers", o.label)
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryPlatform + fmt.Sprintf("This resource configures an external %s "+
+			"authentication provider. %s, so it never appears in the Terraform plan or state. Use the "+
+			"`apstra_aaa_provider_role_mapping` resource to grant Apstra Roles to %s. Requires Apstra %s.",
+			o.label, o.description, groups, compatibility.AaaProvidersOK.String()),
+		Attributes: o.newModel().ResourceAttributes(),
+	}
+}
+
+func (o *resourceAaaProvider) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// cannot proceed if the resource has not been configured
+	if o.client == nil {
+		return
+	}
+
+	compatibility.RequireApiVersion(o.client.ApiVersion(), compatibility.AaaProvidersOK, &resp.Diagnostics)
+}
+
+func (o *resourceAaaProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (o *resourceAaaProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	plan := o.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are available only in the configuration
+	var secret types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(o.secretName+"_wo"), &secret)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.Request(ctx, secret.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := authentication.CreateProvider(ctx, o.client, request)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error creating %s provider", o.label), err.Error())
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (o *resourceAaaProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	state := o.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiData, err := authentication.GetProvider(ctx, o.client, id.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			// resource deleted outside of terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("error reading %s provider", o.label), err.Error())
+		return
+	}
+
+	state.LoadApiData(ctx, apiData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (o *resourceAaaProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	plan := o.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id, secret types.String
+	var planVersion, stateVersion types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(o.secretName+"_wo_version"), &planVersion)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(o.secretName+"_wo_version"), &stateVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// send the secret only when the user has signaled a change. Apstra retains
+	// the previous value when the secret is omitted.
+	if !planVersion.Equal(stateVersion) {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(o.secretName+"_wo"), &secret)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	request := plan.Request(ctx, secret.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := authentication.UpdateProvider(ctx, o.client, id.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error updating %s provider", o.label), err.Error())
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (o *resourceAaaProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := authentication.DeleteProvider(ctx, o.client, id.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			return // 404 is okay
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("error deleting %s provider", o.label), err.Error())
+		return
+	}
+}
+
+func (o *resourceAaaProvider) setClient(client *apstra.Client) {
+	o.client = client
+}
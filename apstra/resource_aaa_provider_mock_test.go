@@ -0,0 +1,123 @@
+package tfapstra_test
+
+import (
+	"fmt"
+	"testing"
+
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const (
+	resourceAaaLdapProviderMockHCL = `
+resource "apstra_aaa_ldap_provider" "test" {
+  name                     = "test"
+  hosts                    = [%q]
+  bind_dn                  = "cn=apstra,dc=example,dc=com"
+  user_search_base         = "ou=people,dc=example,dc=com"
+  group_search_base        = "ou=groups,dc=example,dc=com"
+  bind_password_wo         = %q
+  bind_password_wo_version = %d
+}
+`
+	resourceAaaRadiusProviderMockHCL = `
+resource "apstra_aaa_radius_provider" "test" {
+  name                     = "test"
+  hosts                    = [%q]
+  shared_secret_wo         = %q
+  shared_secret_wo_version = %d
+}
+`
+	resourceAaaTacacsProviderMockHCL = `
+resource "apstra_aaa_tacacs_provider" "test" {
+  name                     = "test"
+  hosts                    = [%q]
+  auth_mode                = "chap"
+  shared_secret_wo         = %q
+  shared_secret_wo_version = %d
+}
+`
+)
+
+// TestResourceAaaProviderMock checks that the write-only secret of each
+// external authentication provider type is sent only when its version
+// attribute changes.
+func TestResourceAaaProviderMock(t *testing.T) {
+	testCases := map[string]struct {
+		resourceType string
+		secretName   string
+		hcl          string
+	}{
+		"ldap":   {resourceType: "apstra_aaa_ldap_provider", secretName: "bind_password", hcl: resourceAaaLdapProviderMockHCL},
+		"radius": {resourceType: "apstra_aaa_radius_provider", secretName: "shared_secret", hcl: resourceAaaRadiusProviderMockHCL},
+		"tacacs": {resourceType: "apstra_aaa_tacacs_provider", secretName: "shared_secret", hcl: resourceAaaTacacsProviderMockHCL},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			srv := testutils.MockApstra(t)
+			resourceName := tCase.resourceType + ".test"
+
+			provider := &mockServerObject{srv: srv, resourceName: resourceName, collection: "/api/aaa/providers"}
+
+			checkServerState := func(host, secret string) resource.TestCheckFunc {
+				return provider.Check(func(id string, obj map[string]any) error {
+					config, _ := obj["config"].(map[string]any)
+					hosts, _ := config["hostname"].([]any)
+					if len(hosts) != 1 || hosts[0] != host {
+						return fmt.Errorf("expected provider hosts [%s], got %v", host, config["hostname"])
+					}
+
+					got, ok := srv.ProviderSecret(id)
+					if !ok {
+						return fmt.Errorf("provider %q has no secret on mock server", id)
+					}
+					if got != secret {
+						return fmt.Errorf("expected provider secret %q, got %q", secret, got)
+					}
+					return nil
+				})
+			}
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+				TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_11_0)},
+				Steps: []resource.TestStep{
+					{
+						Config: mockProviderConfigHCL + fmt.Sprintf(tCase.hcl, "a.example.com", "secret1", 1),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttrSet(resourceName, "id"),
+							resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+							resource.TestCheckNoResourceAttr(resourceName, tCase.secretName+"_wo"),
+							checkServerState("a.example.com", "secret1"),
+						),
+					},
+					{
+						// a new secret without a new version is not sent
+						Config: mockProviderConfigHCL + fmt.Sprintf(tCase.hcl, "b.example.com", "secret2", 1),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, "hosts.0", "b.example.com"),
+							checkServerState("b.example.com", "secret1"),
+						),
+					},
+					{
+						// bumping the version sends the secret
+						Config: mockProviderConfigHCL + fmt.Sprintf(tCase.hcl, "b.example.com", "secret2", 2),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, tCase.secretName+"_wo_version", "2"),
+							checkServerState("b.example.com", "secret2"),
+						),
+					},
+					{
+						ResourceName:            resourceName,
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: []string{tCase.secretName + "_wo_version"},
+					},
+				},
+				CheckDestroy: provider.CheckDestroy,
+			})
+		})
+	}
+}
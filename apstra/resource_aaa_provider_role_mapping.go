@@ -0,0 +1,158 @@
+package tfapstra
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/authentication"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &resourceAaaProviderRoleMapping{}
+	_ resource.ResourceWithValidateConfig = &resourceAaaProviderRoleMapping{}
+	_ resource.ResourceWithImportState    = &resourceAaaProviderRoleMapping{}
+	_ resourceWithSetClient               = &resourceAaaProviderRoleMapping{}
+)
+
+type resourceAaaProviderRoleMapping struct {
+	client *apstra.Client
+}
+
+func (o *resourceAaaProviderRoleMapping) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aaa_provider_role_mapping"
+}
+
+func (o *resourceAaaProviderRoleMapping) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	configureResource(ctx, o, req, resp)
+}
+
+func (o *resourceAaaProviderRoleMapping) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryPlatform + "This resource maps the groups reported by an external " +
+			"authentication provider to Apstra Roles. It manages the provider's complete role mapping: " +
+			"mappings not found in the configuration are removed. Requires Apstra " +
+			compatibility.AaaProvidersOK.String() + ".",
+		Attributes: authentication.ProviderRoleMapping{}.ResourceAttributes(),
+	}
+}
+
+func (o *resourceAaaProviderRoleMapping) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// cannot proceed if the resource has not been configured
+	if o.client == nil {
+		return
+	}
+
+	compatibility.RequireApiVersion(o.client.ApiVersion(), compatibility.AaaProvidersOK, &resp.Diagnostics)
+}
+
+func (o *resourceAaaProviderRoleMapping) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("provider_id"), req, resp)
+}
+
+func (o *resourceAaaProviderRoleMapping) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan authentication.ProviderRoleMapping
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.Request(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := authentication.SetProviderRoleMapping(ctx, o.client, plan.ProviderId.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError("error setting provider role mapping", err.Error())
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceAaaProviderRoleMapping) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state authentication.ProviderRoleMapping
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiData, err := authentication.GetProviderRoleMapping(ctx, o.client, state.ProviderId.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			// provider deleted outside of terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error reading provider role mapping", err.Error())
+		return
+	}
+
+	state.LoadApiData(ctx, apiData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an empty mapping means the mapping was removed outside of terraform
+	if state.Mappings.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (o *resourceAaaProviderRoleMapping) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var plan authentication.ProviderRoleMapping
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.Request(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := authentication.SetProviderRoleMapping(ctx, o.client, plan.ProviderId.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError("error updating provider role mapping", err.Error())
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceAaaProviderRoleMapping) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state authentication.ProviderRoleMapping
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the role mapping cannot be deleted, so clear it instead
+	request := authentication.ProviderRoleMappingData{Mappings: []authentication.ProviderRoleMappingEntryData{}}
+	err := authentication.SetProviderRoleMapping(ctx, o.client, state.ProviderId.ValueString(), &request)
+	if err != nil {
+		if utils.IsApstra404(err) {
+			return // 404 is okay
+		}
+		resp.Diagnostics.AddError("error clearing provider role mapping", err.Error())
+		return
+	}
+}
+
+func (o *resourceAaaProviderRoleMapping) setClient(client *apstra.Client) {
+	o.client = client
+}
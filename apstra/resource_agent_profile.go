@@ -7,6 +7,7 @@ import (
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -31,26 +32,36 @@ func (o *resourceAgentProfile) Configure(ctx context.Context, req resource.Confi
 
 func (o *resourceAgentProfile) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: docCategoryDevices + "This resource creates an Agent Profile. Note that credentials (username/password) " +
-			"cannot be set using this resource because (a) Apstra doesn't allow them to be retrieved, so it's " +
-			"impossible for terraform to detect drift and because (b) leaving credentials in the configuration/state " +
-			"isn't a safe practice.",
-		Attributes: agentProfile{}.resourceAttributes(),
+		MarkdownDescription: docCategoryDevices + "This resource creates an Agent Profile. Credentials are " +
+			"accepted only through the write-only `username_wo` and `password_wo` attributes, so they never " +
+			"appear in the Terraform plan or state. Because Apstra doesn't allow credentials to be retrieved, " +
+			"Terraform cannot detect drift: change `credentials_wo_version` to rotate them.",
+		Attributes: agentProfileWithCredentials{}.resourceAttributes(),
 	}
 }
 
 func (o *resourceAgentProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan agentProfile
+	var plan agentProfileWithCredentials
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Write-only values are available only in the configuration
+	var username, password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("username_wo"), &username)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request := plan.request(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	request.Username = username.ValueStringPointer()
+	request.Password = password.ValueStringPointer()
 
 	id, err := o.client.CreateAgentProfile(ctx, request)
 	if err != nil {
@@ -59,8 +70,8 @@ func (o *resourceAgentProfile) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	plan.Id = types.StringValue(string(id))
-	plan.HasUsername = types.BoolValue(false) // safe to assume false at creation time
-	plan.HasPassword = types.BoolValue(false) // safe to assume false at creation time
+	plan.HasUsername = types.BoolValue(!username.IsNull())
+	plan.HasPassword = types.BoolValue(!password.IsNull())
 
 	// set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -68,7 +79,7 @@ func (o *resourceAgentProfile) Create(ctx context.Context, req resource.CreateRe
 
 func (o *resourceAgentProfile) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state agentProfile
+	var state agentProfileWithCredentials
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -90,7 +101,7 @@ func (o *resourceAgentProfile) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Create new state object
-	var newState agentProfile
+	newState := agentProfileWithCredentials{CredentialsWoVersion: state.CredentialsWoVersion}
 	newState.loadApiData(ctx, ap, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -101,9 +112,10 @@ func (o *resourceAgentProfile) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (o *resourceAgentProfile) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var plan agentProfile
+	// Get plan and state values
+	var plan, state agentProfileWithCredentials
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -113,6 +125,18 @@ func (o *resourceAgentProfile) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// send the credentials only when the user has signaled a change
+	if !plan.CredentialsWoVersion.Equal(state.CredentialsWoVersion) {
+		var username, password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("username_wo"), &username)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		request.Username = username.ValueStringPointer()
+		request.Password = password.ValueStringPointer()
+	}
 	err := o.client.UpdateAgentProfile(ctx, apstra.ObjectId(plan.Id.ValueString()), request)
 	if err != nil {
 		var ace apstra.ClientErr
@@ -133,7 +157,7 @@ func (o *resourceAgentProfile) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Create new state object
-	var newState agentProfile
+	newState := agentProfileWithCredentials{CredentialsWoVersion: plan.CredentialsWoVersion}
 	newState.loadApiData(ctx, ap, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -144,7 +168,7 @@ func (o *resourceAgentProfile) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (o *resourceAgentProfile) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state agentProfile
+	var state agentProfileWithCredentials
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
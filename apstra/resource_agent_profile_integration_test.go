@@ -11,6 +11,7 @@ import (
 	"github.com/Juniper/apstra-go-sdk/apstra"
 	tfapstra "github.com/Juniper/terraform-provider-apstra/apstra"
 	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/Juniper/terraform-provider-apstra/internal/pointer"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/require"
@@ -22,6 +23,10 @@ const (
   open_options = %s
   packages     = %s
   platform     = %s
+
+  username_wo            = %s
+  password_wo            = %s
+  credentials_wo_version = %s
 }
 `
 )
@@ -31,6 +36,10 @@ type testAgentProfile struct {
 	openOptions map[string]string
 	packages    map[string]string
 	platform    string
+
+	usernameWo           string
+	passwordWo           string
+	credentialsWoVersion *int
 }
 
 func (o testAgentProfile) render(rType, rName string) string {
@@ -40,6 +49,9 @@ func (o testAgentProfile) render(rType, rName string) string {
 		stringMapOrNull(o.openOptions, 1),
 		stringMapOrNull(o.packages, 1),
 		stringOrNull(o.platform),
+		stringOrNull(o.usernameWo),
+		stringOrNull(o.passwordWo),
+		intPtrOrNull(o.credentialsWoVersion),
 	)
 }
 
@@ -64,6 +76,14 @@ func (o testAgentProfile) testChecks(t testing.TB, rType, rName string) testChec
 		}
 	}
 
+	if o.credentialsWoVersion != nil {
+		result.append(t, "TestCheckResourceAttr", "credentials_wo_version", strconv.Itoa(*o.credentialsWoVersion))
+	}
+
+	// write-only attributes must never be saved in state
+	result.append(t, "TestCheckNoResourceAttr", "username_wo")
+	result.append(t, "TestCheckNoResourceAttr", "password_wo")
+
 	if o.packages != nil {
 		result.append(t, "TestCheckResourceAttr", "packages.%", strconv.Itoa(len(o.packages)))
 
@@ -164,6 +184,42 @@ func TestResourceAgentProfile(t *testing.T) {
 				},
 			},
 		},
+		"write_only_credentials": {
+			steps: []step{
+				{
+					config: testAgentProfile{
+						name:       acctest.RandString(6),
+						usernameWo: acctest.RandString(6),
+						passwordWo: acctest.RandString(6),
+					},
+					extraChecks: []extraCheck{
+						{testFuncName: "TestCheckResourceAttr", testFuncArgs: []string{"has_username", "true"}},
+						{testFuncName: "TestCheckResourceAttr", testFuncArgs: []string{"has_password", "true"}},
+					},
+					extractions: []extraction{
+						{
+							attribute: "id",
+							target:    tNameToApId,
+						},
+					},
+				},
+				{
+					preConfig: func(t testing.TB) {
+						setCredentials(t, "", "")
+					},
+					config: testAgentProfile{
+						name:                 acctest.RandString(6),
+						usernameWo:           acctest.RandString(6),
+						passwordWo:           acctest.RandString(6),
+						credentialsWoVersion: pointer.To(1),
+					},
+					extraChecks: []extraCheck{
+						{testFuncName: "TestCheckResourceAttr", testFuncArgs: []string{"has_username", "true"}},
+						{testFuncName: "TestCheckResourceAttr", testFuncArgs: []string{"has_password", "true"}},
+					},
+				},
+			},
+		},
 	}
 
 	resourceType := tfapstra.ResourceName(ctx, &tfapstra.ResourceAgentProfile)
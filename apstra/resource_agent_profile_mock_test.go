@@ -0,0 +1,37 @@
+package tfapstra_test
+
+import (
+	"regexp"
+	"testing"
+
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const resourceAgentProfileVersionWithoutCredentialsMockHCL = `
+resource "apstra_agent_profile" "test" {
+  name                   = "test"
+  username_wo            = "admin"
+  credentials_wo_version = 2
+}
+`
+
+// TestResourceAgentProfileCredentialsVersionMock checks that a
+// credentials_wo_version which would not be accompanied by both credentials
+// is rejected rather than silently ignored.
+func TestResourceAgentProfileCredentialsVersionMock(t *testing.T) {
+	testutils.MockApstra(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_11_0)},
+		Steps: []resource.TestStep{
+			{
+				Config:      mockProviderConfigHCL + resourceAgentProfileVersionWithoutCredentialsMockHCL,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
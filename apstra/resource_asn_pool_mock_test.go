@@ -0,0 +1,63 @@
+package tfapstra_test
+
+import (
+	"fmt"
+	"testing"
+
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const resourceAsnPoolMockHCL = `
+resource "apstra_asn_pool" "test" {
+  name   = %q
+  ranges = [
+    { first = 100, last = 199 },
+    { first = %d, last = %d },
+  ]
+}
+`
+
+// TestResourceAsnPoolMock exercises the apstra_asn_pool resource against the
+// in-process mock Apstra server, so it requires TF_ACC but not an Apstra
+// controller.
+func TestResourceAsnPoolMock(t *testing.T) {
+	srv := testutils.MockApstra(t)
+
+	pool := &mockServerObject{srv: srv, resourceName: "apstra_asn_pool.test", collection: "/api/resources/asn-pools"}
+
+	checkServerState := func(name string, ranges int) resource.TestCheckFunc {
+		return pool.Check(func(_ string, obj map[string]any) error {
+			if obj["display_name"] != name {
+				return fmt.Errorf("expected pool name %q, got %q", name, obj["display_name"])
+			}
+			if l := len(obj["ranges"].([]any)); l != ranges {
+				return fmt.Errorf("expected %d ranges, got %d", ranges, l)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourceAsnPoolMockHCL, "a", 300, 399),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apstra_asn_pool.test", "id"),
+					resource.TestCheckResourceAttr("apstra_asn_pool.test", "name", "a"),
+					resource.TestCheckResourceAttr("apstra_asn_pool.test", "ranges.#", "2"),
+					checkServerState("a", 2),
+				),
+			},
+			{
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourceAsnPoolMockHCL, "b", 500, 599),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apstra_asn_pool.test", "name", "b"),
+					checkServerState("b", 2),
+				),
+			},
+		},
+		CheckDestroy: pool.CheckDestroy,
+	})
+}
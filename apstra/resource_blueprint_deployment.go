@@ -8,9 +8,11 @@ import (
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.ResourceWithConfigure = &resourceBlueprintDeploy{}
+var _ resource.ResourceWithModifyPlan = &resourceBlueprintDeploy{}
 var _ resourceWithSetClient = &resourceBlueprintDeploy{}
 var _ resourceWithSetBpLockFunc = &resourceBlueprintDeploy{}
 var _ resourceWithSetBpUnlockFunc = &resourceBlueprintDeploy{}
@@ -19,7 +21,7 @@ var _ resourceWithSetTerraformVersion = &resourceBlueprintDeploy{}
 
 type resourceBlueprintDeploy struct {
 	client           *apstra.Client
-	lockFunc         func(context.Context, string) error
+	lockFunc         blueprintLockFunc
 	unlockFunc       func(context.Context, string) error
 	providerVersion  string
 	terraformVersion string
@@ -35,21 +37,75 @@ func (o *resourceBlueprintDeploy) Configure(ctx context.Context, req resource.Co
 
 func (o *resourceBlueprintDeploy) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: docCategoryRefDesignAny + "This resource commits a staging Blueprint after checking for build errors.",
-		Attributes:          blueprint.Deploy{}.ResourceAttributes(),
+		MarkdownDescription: docCategoryRefDesignAny + "This resource commits a staging Blueprint after checking for build errors. " +
+			"Optional policies can prevent deployment when the Blueprint has build warnings or specific types " +
+			"of anomalies, and can wait for the configuration to be deployed to every system. When the staging " +
+			"Blueprint already has uncommitted changes, the plan previews the changes which will be deployed, " +
+			"and deployment is refused if the changes staged at apply time no longer match the preview.",
+		Attributes: blueprint.DeployWithPolicy{}.ResourceAttributes(),
 	}
 }
 
+func (o *resourceBlueprintDeploy) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// No plan means we're doing Delete(). A plan which matches the state
+	// means no commit will happen, so there's nothing to preview.
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var plan blueprint.DeployWithPolicy
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The blueprint may be created by this apply.
+	if plan.BlueprintId.IsUnknown() {
+		return
+	}
+
+	bpId := apstra.ObjectId(plan.BlueprintId.ValueString())
+	if !utils.BlueprintExists(ctx, o.client, bpId, &resp.Diagnostics) {
+		return // Create() will complain
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// When nothing is staged yet, the changes to be deployed will be made by
+	// other resources in this apply. Leave the preview unknown so that it is
+	// collected by Create() or Update() just before commit.
+	status, err := o.client.GetBlueprintStatus(ctx, bpId)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to get Blueprint %s status", bpId), err.Error())
+		return
+	}
+	if !status.HasUncommittedChanges {
+		if !plan.PreviewConfig.ValueBool() {
+			plan.PendingConfig = types.MapNull(types.StringType)
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		}
+		return
+	}
+
+	plan.Preview(ctx, o.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (o *resourceBlueprintDeploy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan blueprint.Deploy
+	var plan blueprint.DeployWithPolicy
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Lock the blueprint mutex.
-	err := o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err := o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -73,22 +129,28 @@ func (o *resourceBlueprintDeploy) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Record the changes about to be deployed, and make sure that any preview
+	// shown in the plan still describes them.
+	plan.ConfirmPreview(ctx, o.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	template := blueprint.CommentTemplate{
 		ProviderVersion:  o.providerVersion,
 		TerraformVersion: o.terraformVersion,
 	}
 
-	plan.Deploy(ctx, &template, o.client, &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
-		return
+	// Save the new revision even if the post-commit checks failed. Otherwise
+	// the next apply would commit again.
+	if plan.Commit(ctx, &template, o.client, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (o *resourceBlueprintDeploy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Retrieve values from state
-	var state blueprint.Deploy
+	var state blueprint.DeployWithPolicy
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -122,7 +184,7 @@ func (o *resourceBlueprintDeploy) Read(ctx context.Context, req resource.ReadReq
 
 func (o *resourceBlueprintDeploy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan blueprint.Deploy
+	var plan blueprint.DeployWithPolicy
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -137,7 +199,7 @@ func (o *resourceBlueprintDeploy) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Lock the blueprint mutex.
-	err := o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err := o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -154,22 +216,28 @@ func (o *resourceBlueprintDeploy) Update(ctx context.Context, req resource.Updat
 		}
 	}()
 
+	// Record the changes about to be deployed, and make sure that any preview
+	// shown in the plan still describes them.
+	plan.ConfirmPreview(ctx, o.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	template := blueprint.CommentTemplate{
 		ProviderVersion:  o.providerVersion,
 		TerraformVersion: o.terraformVersion,
 	}
 
-	plan.Deploy(ctx, &template, o.client, &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
-		return
+	// Save the new revision even if the post-commit checks failed. Otherwise
+	// the next apply would commit again.
+	if plan.Commit(ctx, &template, o.client, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (o *resourceBlueprintDeploy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state blueprint.Deploy
+	var state blueprint.DeployWithPolicy
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -196,7 +264,7 @@ func (o *resourceBlueprintDeploy) setClient(client *apstra.Client) {
 	o.client = client
 }
 
-func (o *resourceBlueprintDeploy) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceBlueprintDeploy) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
 
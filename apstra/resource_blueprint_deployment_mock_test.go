@@ -0,0 +1,74 @@
+package tfapstra_test
+
+import (
+	"fmt"
+	"testing"
+
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+const resourceBlueprintDeploymentMockHCL = `
+resource "apstra_blueprint_deployment" "test" {
+  blueprint_id   = %q
+  preview_config = true
+}
+`
+
+// TestResourceBlueprintDeploymentPreviewMock checks that changes staged before
+// the plan is made are previewed in the plan, and that the previewed values
+// are kept once the blueprint has been deployed.
+func TestResourceBlueprintDeploymentPreviewMock(t *testing.T) {
+	srv := testutils.MockApstra(t)
+
+	// a new blueprint has never been deployed, so each of its nodes (the
+	// default routing zone, the metadata node and this system) is pending
+	bpId := srv.AddBlueprint("test")
+	srv.AddNode(bpId, map[string]any{"type": "system", "label": "leaf1", "role": "leaf"})
+
+	config := mockProviderConfigHCL + fmt.Sprintf(resourceBlueprintDeploymentMockHCL, bpId)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("apstra_blueprint_deployment.test", tfjsonpath.New("pending_nodes_added"), knownvalue.Int64Exact(3)),
+						plancheck.ExpectKnownValue("apstra_blueprint_deployment.test", tfjsonpath.New("pending_nodes_removed"), knownvalue.Int64Exact(0)),
+						plancheck.ExpectKnownValue("apstra_blueprint_deployment.test", tfjsonpath.New("pending_nodes_changed"), knownvalue.Int64Exact(0)),
+						// the system has no device assigned, so it has no pending configuration
+						plancheck.ExpectKnownValue("apstra_blueprint_deployment.test", tfjsonpath.New("pending_config"), knownvalue.MapSizeExact(0)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apstra_blueprint_deployment.test", "has_uncommitted_changes", "false"),
+					resource.TestCheckResourceAttr("apstra_blueprint_deployment.test", "pending_nodes_added", "3"),
+					resource.TestCheckResourceAttr("apstra_blueprint_deployment.test", "pending_config.%", "0"),
+				),
+			},
+			{
+				// staging a change outside of terraform causes the next plan
+				// to deploy it and preview exactly that change
+				PreConfig: func() {
+					srv.AddNode(bpId, map[string]any{"type": "system", "label": "leaf2", "role": "leaf"})
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("apstra_blueprint_deployment.test", plancheck.ResourceActionCreate),
+						plancheck.ExpectKnownValue("apstra_blueprint_deployment.test", tfjsonpath.New("pending_nodes_added"), knownvalue.Int64Exact(1)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apstra_blueprint_deployment.test", "has_uncommitted_changes", "false"),
+					resource.TestCheckResourceAttr("apstra_blueprint_deployment.test", "pending_nodes_added", "1"),
+				),
+			},
+		},
+	})
+}
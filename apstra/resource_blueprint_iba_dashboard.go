@@ -35,7 +35,8 @@ func (o *resourceBlueprintIbaDashboard) Configure(ctx context.Context, req resou
 
 func (o *resourceBlueprintIbaDashboard) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: docCategoryRefDesignAny + "This resource creates a IBA Dashboard.\n\n" +
+		MarkdownDescription: docCategoryRefDesignAny + "This resource creates an IBA Dashboard which arranges " +
+			"IBA Widgets in a grid.\n\n" +
 			"*Note: Compatible only with Apstra " + compatibility.BpIbaDashboardOk.String() + "*",
 		Attributes: iba.Dashboard{}.ResourceAttributes(),
 	}
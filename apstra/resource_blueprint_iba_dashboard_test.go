@@ -16,7 +16,7 @@ const (
 
 resource "apstra_blueprint_iba_dashboard" "a" {
   blueprint_id = "%s"
-  default = false
+  default = %t
   %s
   name = "Test Dashboard"
   widget_grid = tolist([
@@ -59,22 +59,24 @@ func TestAccResourceDashboard(t *testing.T) {
 			// Create and Read testing. Empty Description Test
 			{
 				Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaDashboardTemplateHCL,
-					bpClient.Id(), "", fmt.Sprintf(onePane, widgetIdA)),
+					bpClient.Id(), false, "", fmt.Sprintf(onePane, widgetIdA)),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					// Verify ID has any133 value set
+					// Verify ID has any value set
 					resource.TestCheckResourceAttrSet("apstra_blueprint_iba_dashboard.a", "id"),
 					resource.TestCheckResourceAttr("apstra_blueprint_iba_dashboard.a", "widget_grid.0.0", widgetIdA.String()),
+					resource.TestCheckResourceAttr("apstra_blueprint_iba_dashboard.a", "default", "false"),
 				),
 			},
 			// Update and Read testing
 			{
 				Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaDashboardTemplateHCL,
-					bpClient.Id(), descString, fmt.Sprintf(twoPanes, widgetIdA, widgetIdB)),
+					bpClient.Id(), true, descString, fmt.Sprintf(twoPanes, widgetIdA, widgetIdB)),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify ID has any value set
 					resource.TestCheckResourceAttrSet("apstra_blueprint_iba_dashboard.a", "id"),
 					resource.TestCheckResourceAttr("apstra_blueprint_iba_dashboard.a", "widget_grid.0.0", widgetIdA.String()),
 					resource.TestCheckResourceAttr("apstra_blueprint_iba_dashboard.a", "widget_grid.1.0", widgetIdB.String()),
+					resource.TestCheckResourceAttr("apstra_blueprint_iba_dashboard.a", "default", "true"),
 				),
 			},
 		},
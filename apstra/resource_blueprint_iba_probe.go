@@ -40,7 +40,17 @@ func (o *resourceBlueprintIbaProbe) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
-func (o *resourceBlueprintIbaProbe) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+func (o *resourceBlueprintIbaProbe) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Retrieve values from config
+	var config iba.Probe
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// validate the processor pipeline
+	config.ValidateConfig(ctx, &resp.Diagnostics)
+
 	// cannot proceed to api version validation if the provider has not been configured
 	if o.client == nil {
 		return
@@ -76,8 +86,13 @@ func (o *resourceBlueprintIbaProbe) Create(ctx context.Context, req resource.Cre
 	var id apstra.ObjectId
 	// Convert the plan into an API Request
 	if plan.PredefinedProbeId.IsUnknown() || plan.PredefinedProbeId.IsNull() {
-		// Create Probe from Json
-		id, err = bpClient.CreateIbaProbeFromJson(ctx, []byte(plan.ProbeJson.ValueString()))
+		// Create Probe from Json, either supplied directly or rendered from processors
+		probeJson := plan.JsonRequest(ctx, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id, err = bpClient.CreateIbaProbeFromJson(ctx, probeJson)
 		if err != nil {
 			resp.Diagnostics.AddError("failed to create Iba Probe", err.Error())
 			return
@@ -155,9 +170,57 @@ func (o *resourceBlueprintIbaProbe) Read(ctx context.Context, req resource.ReadR
 }
 
 // Update resource
-func (o *resourceBlueprintIbaProbe) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+func (o *resourceBlueprintIbaProbe) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	resp.Diagnostics.AddError("not implemented", "Probe update not implemented via terraform. Please file a bug.")
+	var plan iba.Probe
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// get a client for the datacenter reference design
+	bpClient, err := o.getBpClientFunc(ctx, plan.BlueprintId.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("blueprint %s not found", plan.BlueprintId), err.Error())
+			return
+		}
+		resp.Diagnostics.AddError("failed to create blueprint client", err.Error())
+		return
+	}
+
+	// Update the probe in place so that its time-series history is retained
+	if plan.PredefinedProbeId.IsNull() {
+		probeJson := plan.JsonRequest(ctx, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err = iba.UpdateProbeFromJson(ctx, o.client, plan.BlueprintId.ValueString(), plan.Id.ValueString(), probeJson)
+	} else {
+		err = iba.UpdatePredefinedProbe(ctx, o.client, plan.BlueprintId.ValueString(),
+			plan.PredefinedProbeId.ValueString(), plan.Id.ValueString(), []byte(plan.ProbeConfig.ValueString()))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update Iba Probe", err.Error())
+		return
+	}
+
+	// Fetch the probe details
+	api, err := bpClient.GetIbaProbe(ctx, apstra.ObjectId(plan.Id.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Read IBA Probe", err.Error())
+		return
+	}
+
+	// Populate plan object with updated probe details
+	plan.LoadApiData(ctx, api, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Delete resource
@@ -193,7 +256,7 @@ func (o *resourceBlueprintIbaProbe) setBpClientFunc(f func(context.Context, stri
 	o.getBpClientFunc = f
 }
 
-// setClient is used for API version compatibility check only
+// setClient is used for API version compatibility check and for in-place probe updates
 func (o *resourceBlueprintIbaProbe) setClient(client *apstra.Client) {
 	o.client = client
 }
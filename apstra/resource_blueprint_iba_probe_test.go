@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
 	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 const (
@@ -19,7 +21,7 @@ resource "apstra_blueprint_iba_probe" "p_device_health" {
   predefined_probe_id = "device_health"
   probe_config = jsonencode(
     {
-      "max_cpu_utilization": 80,
+      "max_cpu_utilization": %d,
       "max_memory_utilization": 80,
       "max_disk_utilization": 80,
       "duration": 660,
@@ -165,6 +167,46 @@ resource "apstra_blueprint_iba_probe" "p_device_health" {
 		%s
 		EOT
 	}`
+	resourceBlueprintIbaProbeProcessorsHCL = `
+resource "apstra_blueprint_iba_probe" "p_leaf_degree" {
+  blueprint_id = "%s"
+  name         = "Leaf Interface Count"
+  processors = [
+    {
+      name        = "leaf interfaces"
+      type        = "generic_graph_collector"
+      graph_query = "node('system', name='system', role='leaf').out('hosted_interfaces').node('interface', name='iface', if_type='ethernet')"
+      outputs     = { out = "leaf_interfaces" }
+      properties = jsonencode({
+        system_id = "system.system_id"
+        interface = "iface.if_name"
+        value     = "1"
+        data_type = "number"
+      })
+    },
+    {
+      name     = "interfaces per leaf"
+      type     = "sum"
+      inputs   = { in = { stage = "%s" } }
+      outputs  = { out = "leaf_interface_count" }
+      group_by = ["system_id"]
+    },
+    {
+      name          = "too many interfaces"
+      type          = "range_check"
+      inputs        = { in = { stage = "leaf_interface_count" } }
+      outputs       = { out = "leaf_interface_count_high" }
+      range_max     = %d
+      raise_anomaly = false
+    },
+  ]
+  stage_settings = {
+    leaf_interface_count = {
+      description = "Ethernet interfaces per leaf"
+    }
+  }
+}
+`
 )
 
 func TestAccResourceProbe(t *testing.T) {
@@ -186,7 +228,19 @@ func TestAccResourceProbe(t *testing.T) {
 		"predefined": {
 			steps: []resource.TestStep{
 				{
-					Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaProbeHCL, bpClient.Id()),
+					Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaProbeHCL, bpClient.Id(), 80),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet("apstra_blueprint_iba_probe.p_device_health", "id"),
+						resource.TestCheckResourceAttr("apstra_blueprint_iba_probe.p_device_health", "name", "Device System Health"),
+					),
+				},
+				{
+					Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaProbeHCL, bpClient.Id(), 90),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("apstra_blueprint_iba_probe.p_device_health", plancheck.ResourceActionUpdate),
+						},
+					},
 					Check: resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttrSet("apstra_blueprint_iba_probe.p_device_health", "id"),
 						resource.TestCheckResourceAttr("apstra_blueprint_iba_probe.p_device_health", "name", "Device System Health"),
@@ -204,6 +258,52 @@ func TestAccResourceProbe(t *testing.T) {
 						resource.TestCheckResourceAttr("apstra_blueprint_iba_probe.p_device_traffic", "name", "Device Traffic"),
 					),
 				},
+				{
+					Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaProbeJsonHCL, bpClient.Id(),
+						strings.Replace(probeStr, `"label": "Device Traffic"`, `"label": "Device Traffic Renamed"`, 1)),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("apstra_blueprint_iba_probe.p_device_traffic", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet("apstra_blueprint_iba_probe.p_device_traffic", "id"),
+						resource.TestCheckResourceAttr("apstra_blueprint_iba_probe.p_device_traffic", "name", "Device Traffic Renamed"),
+					),
+				},
+			},
+		},
+		"from_processors": {
+			steps: []resource.TestStep{
+				{
+					Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaProbeProcessorsHCL, bpClient.Id(), "leaf_interfaces", 50),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet("apstra_blueprint_iba_probe.p_leaf_degree", "id"),
+						resource.TestCheckResourceAttr("apstra_blueprint_iba_probe.p_leaf_degree", "name", "Leaf Interface Count"),
+						resource.TestCheckResourceAttr("apstra_blueprint_iba_probe.p_leaf_degree", "stages.#", "3"),
+						resource.TestCheckTypeSetElemAttr("apstra_blueprint_iba_probe.p_leaf_degree", "stages.*", "leaf_interface_count_high"),
+					),
+				},
+				{
+					Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaProbeProcessorsHCL, bpClient.Id(), "leaf_interfaces", 60),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("apstra_blueprint_iba_probe.p_leaf_degree", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrSet("apstra_blueprint_iba_probe.p_leaf_degree", "id"),
+						resource.TestCheckResourceAttr("apstra_blueprint_iba_probe.p_leaf_degree", "processors.2.range_max", "60"),
+					),
+				},
+			},
+		},
+		"bad_stage_reference": {
+			steps: []resource.TestStep{
+				{
+					Config:      insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaProbeProcessorsHCL, bpClient.Id(), "no_such_stage", 50),
+					ExpectError: regexp.MustCompile("Stage not found"),
+				},
 			},
 		},
 		"error": {
@@ -36,9 +36,9 @@ func (o *resourceBlueprintIbaWidget) Configure(ctx context.Context, req resource
 
 func (o *resourceBlueprintIbaWidget) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: docCategoryRefDesignAny + "This resource creates an IBA Widget.\n\n" +
+		MarkdownDescription: docCategoryRefDesignAny + "This resource creates an IBA Widget which displays a " +
+			"stage of an IBA Probe.\n\n" +
 			"*Note: Compatible only with Apstra " + compatibility.BpIbaWidgetOk.String() + "*",
-
 		Attributes: iba.Widget{}.ResourceAttributes(),
 	}
 }
@@ -77,13 +77,19 @@ func (o *resourceBlueprintIbaWidget) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	// ensure the probe has the stage we're about to display
+	plan.ValidateStage(ctx, bp, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert the plan into an API Request
-	probeReq := plan.Request(ctx, &resp.Diagnostics)
+	widgetReq := plan.Request(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	id, err := bp.CreateIbaWidget(ctx, probeReq)
+	id, err := bp.CreateIbaWidget(ctx, widgetReq)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create IBA Widget", err.Error())
 		return
@@ -153,6 +159,12 @@ func (o *resourceBlueprintIbaWidget) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	// ensure the probe has the stage we're about to display
+	plan.ValidateStage(ctx, bp, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IBA Widget
 	err = bp.UpdateIbaWidget(ctx, apstra.ObjectId(plan.Id.ValueString()), widgetReq)
 	if err != nil {
@@ -182,13 +194,13 @@ func (o *resourceBlueprintIbaWidget) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	// Delete IBA Probe by calling API
+	// Delete IBA Widget by calling API
 	err = bp.DeleteIbaWidget(ctx, apstra.ObjectId(state.Id.ValueString()))
 	if err != nil {
 		if utils.IsApstra404(err) {
 			return // 404 is okay
 		}
-		resp.Diagnostics.AddError("error deleting IBA Probe", err.Error())
+		resp.Diagnostics.AddError("error deleting IBA Widget", err.Error())
 		return
 	}
 }
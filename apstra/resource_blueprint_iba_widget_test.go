@@ -3,6 +3,7 @@ package tfapstra
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
@@ -31,7 +32,7 @@ resource "apstra_blueprint_iba_widget" "w_device_health_high_cpu" {
   blueprint_id = "%s"
   name = "%s"
   probe_id = apstra_blueprint_iba_probe.p_device_health.id
-  stage = "Systems with high CPU utilization"
+  stage = "%s"
   description = "made from terraform"
 }
 `
@@ -50,12 +51,18 @@ func TestAccResourceWidget(t *testing.T) {
 
 	n1 := "Widget1"
 	n2 := "Widget2"
+	stage := "Systems with high CPU utilization"
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Stage which does not exist in the probe
+			{
+				Config:      insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaWidgetHCL, bpClient.Id(), bpClient.Id(), n1, "bogus"),
+				ExpectError: regexp.MustCompile("IBA Probe stage not found"),
+			},
 			// Create and Read testing
 			{
-				Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaWidgetHCL, bpClient.Id(), bpClient.Id(), n1),
+				Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaWidgetHCL, bpClient.Id(), bpClient.Id(), n1, stage),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify ID has any value set
 					resource.TestCheckResourceAttrSet("apstra_blueprint_iba_widget.w_device_health_high_cpu", "id"),
@@ -65,7 +72,7 @@ func TestAccResourceWidget(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaWidgetHCL, bpClient.Id(), bpClient.Id(), n2),
+				Config: insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaWidgetHCL, bpClient.Id(), bpClient.Id(), n2, stage),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify ID has any value set
 					resource.TestCheckResourceAttrSet("apstra_blueprint_iba_widget.w_device_health_high_cpu", "id"),
@@ -73,6 +80,11 @@ func TestAccResourceWidget(t *testing.T) {
 					resource.TestCheckResourceAttr("apstra_blueprint_iba_widget.w_device_health_high_cpu", "name", n2),
 				),
 			},
+			// Stage which does not exist in the probe is caught before the widget is updated
+			{
+				Config:      insecureProviderConfigHCL + fmt.Sprintf(resourceBlueprintIbaWidgetHCL, bpClient.Id(), bpClient.Id(), n2, "bogus"),
+				ExpectError: regexp.MustCompile("IBA Probe stage not found"),
+			},
 		},
 	})
 }
@@ -0,0 +1,150 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ resource.ResourceWithConfigure = &resourceBlueprintMutex{}
+	_ resourceWithSetClient          = &resourceBlueprintMutex{}
+	_ resourceWithSetBpMutexLocker   = &resourceBlueprintMutex{}
+)
+
+type resourceBlueprintMutex struct {
+	client *apstra.Client
+	locker *blueprintMutexLocker
+}
+
+func (o *resourceBlueprintMutex) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_mutex"
+}
+
+func (o *resourceBlueprintMutex) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	configureResource(ctx, o, req, resp)
+}
+
+func (o *resourceBlueprintMutex) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryRefDesignAny + "This resource locks a Blueprint mutex, and holds it until " +
+			"the resource is destroyed or the mutex is unlocked by some other means (e.g. deployment of the " +
+			"Blueprint by an `apstra_blueprint_deployment` resource). Creation of this resource waits for the " +
+			"mutex according to the `blueprint_mutex_timeout` and `blueprint_mutex_ttl` provider attributes, " +
+			"whether or not `blueprint_mutex_enabled` is set.\n\n" +
+			"When `blueprint_mutex_enabled` is `true`, other resources in the same run treat the mutex as their " +
+			"own rather than waiting for it. A mutex found to be unlocked when this resource is refreshed causes " +
+			"the resource to be re-created, locking the Blueprint again. [More info about Blueprint mutexes " +
+			"here](https://github.com/Juniper/terraform-provider-apstra/blob/main/kb/blueprint_mutex.md).",
+		Attributes: blueprint.Mutex{}.ResourceAttributes(),
+	}
+}
+
+func (o *resourceBlueprintMutex) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan blueprint.Mutex
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lock the mutex
+	tag, err := o.locker.lockAndHold(ctx, plan.BlueprintId.ValueString(), plan.Message.ValueString(), &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()), err.Error())
+		return
+	}
+
+	// Load the computed values
+	plan.LoadApiData(ctx, tag, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceBlueprintMutex) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state blueprint.Mutex
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag, err := o.client.GetTag(ctx, apstra.ObjectId(state.TagId.ValueString()))
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("error reading blueprint %q mutex", state.BlueprintId.ValueString()), err.Error())
+		return
+	}
+
+	// A tag which no longer describes the lease recorded in state, or which
+	// no longer names this blueprint, is not the mutex we locked.
+	acquiredAt := state.AcquiredAt
+	state.LoadApiData(ctx, tag, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if tag.Data == nil || !strings.Contains(tag.Data.Label, state.BlueprintId.ValueString()) || !state.AcquiredAt.Equal(acquiredAt) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The mutex was probably locked by an earlier run. Record it as held so
+	// that other resources in this run don't wait for it.
+	err = o.locker.adopt(ctx, state.BlueprintId.ValueString(), tag)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error adopting blueprint %q mutex", state.BlueprintId.ValueString()), err.Error())
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never invoked: every configurable attribute requires replacement.
+func (o *resourceBlueprintMutex) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan blueprint.Mutex
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceBlueprintMutex) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state blueprint.Mutex
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := o.locker.release(ctx, state.BlueprintId.ValueString(), state.TagId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error unlocking blueprint %q mutex", state.BlueprintId.ValueString()), err.Error())
+		return
+	}
+}
+
+func (o *resourceBlueprintMutex) setClient(client *apstra.Client) {
+	o.client = client
+}
+
+func (o *resourceBlueprintMutex) setBpMutexLocker(locker *blueprintMutexLocker) {
+	o.locker = locker
+}
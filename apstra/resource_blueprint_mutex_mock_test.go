@@ -0,0 +1,80 @@
+package tfapstra_test
+
+import (
+	"fmt"
+	"testing"
+
+	tfapstra "github.com/Juniper/terraform-provider-apstra/apstra"
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// resourceBlueprintMutexMockProviderHCL enables blueprint mutexes with a short
+// timeout, so that a provider waiting on its own mutex fails quickly rather
+// than hanging.
+const resourceBlueprintMutexMockProviderHCL = `
+provider "apstra" {
+  tls_validation_disabled = true
+  blueprint_mutex_enabled = true
+  blueprint_mutex_timeout = 5
+}
+`
+
+const resourceBlueprintMutexMockHCL = `
+resource "apstra_blueprint_mutex" "test" {
+  blueprint_id = %q
+}
+`
+
+const resourceBlueprintMutexWithDeploymentMockHCL = `
+resource "apstra_blueprint_mutex" "test" {
+  blueprint_id = %q
+}
+
+resource "apstra_blueprint_deployment" "test" {
+  blueprint_id = %q
+}
+`
+
+// TestResourceBlueprintMutexMock checks that a mutex locked by an earlier run
+// is treated as held by a later run, so that resources modifying the
+// blueprint don't wait for it.
+func TestResourceBlueprintMutexMock(t *testing.T) {
+	srv := testutils.MockApstra(t)
+	t.Cleanup(tfapstra.ForgetBlueprintMutexes)
+
+	bpId := srv.AddBlueprint("test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceBlueprintMutexMockProviderHCL + fmt.Sprintf(resourceBlueprintMutexMockHCL, bpId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apstra_blueprint_mutex.test", "blueprint_id", bpId),
+					resource.TestCheckResourceAttrSet("apstra_blueprint_mutex.test", "tag_id"),
+				),
+			},
+			{
+				// Start from a clean slate, as a new provider process would.
+				// The deployment must find the mutex held by refreshing the
+				// apstra_blueprint_mutex resource. Deployment unlocks the
+				// mutex, so the mutex resource plans to lock it again.
+				PreConfig: tfapstra.ForgetBlueprintMutexes,
+				Config:    resourceBlueprintMutexMockProviderHCL + fmt.Sprintf(resourceBlueprintMutexWithDeploymentMockHCL, bpId, bpId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apstra_blueprint_deployment.test", "has_uncommitted_changes", "false"),
+					func(_ *terraform.State) error {
+						staged, deployed, _ := srv.BlueprintVersions(bpId)
+						if staged != deployed {
+							return fmt.Errorf("expected blueprint to be deployed, staged version %d, deployed version %d", staged, deployed)
+						}
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
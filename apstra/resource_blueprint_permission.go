@@ -0,0 +1,148 @@
+package tfapstra
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/authentication"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &resourceBlueprintPermission{}
+	_ resource.ResourceWithValidateConfig = &resourceBlueprintPermission{}
+	_ resource.ResourceWithImportState    = &resourceBlueprintPermission{}
+	_ resourceWithSetClient               = &resourceBlueprintPermission{}
+)
+
+type resourceBlueprintPermission struct {
+	client *apstra.Client
+}
+
+func (o *resourceBlueprintPermission) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_permission"
+}
+
+func (o *resourceBlueprintPermission) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	configureResource(ctx, o, req, resp)
+}
+
+func (o *resourceBlueprintPermission) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryRefDesignAny + "This resource grants a Role access to a single Blueprint. " +
+			"Users holding the Role receive the specified access to the Blueprint. Requires Apstra " +
+			compatibility.AaaUsersAndRolesOK.String() + ".",
+		Attributes: authentication.BlueprintPermission{}.ResourceAttributes(),
+	}
+}
+
+func (o *resourceBlueprintPermission) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// cannot proceed if the resource has not been configured
+	if o.client == nil {
+		return
+	}
+
+	compatibility.RequireApiVersion(o.client.ApiVersion(), compatibility.AaaUsersAndRolesOK, &resp.Diagnostics)
+}
+
+func (o *resourceBlueprintPermission) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "role_id")
+}
+
+func (o *resourceBlueprintPermission) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan authentication.BlueprintPermission
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.Request(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := authentication.SetBlueprintPermission(ctx, o.client, plan.RoleId.ValueString(), plan.BlueprintId.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError("error setting Blueprint permission", err.Error())
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceBlueprintPermission) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state authentication.BlueprintPermission
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiData, err := authentication.GetBlueprintPermission(ctx, o.client, state.RoleId.ValueString(), state.BlueprintId.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			// resource deleted outside of terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error reading Blueprint permission", err.Error())
+		return
+	}
+
+	state.LoadApiData(ctx, apiData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (o *resourceBlueprintPermission) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var plan authentication.BlueprintPermission
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.Request(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := authentication.SetBlueprintPermission(ctx, o.client, plan.RoleId.ValueString(), plan.BlueprintId.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError("error updating Blueprint permission", err.Error())
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceBlueprintPermission) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state authentication.BlueprintPermission
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := authentication.DeleteBlueprintPermission(ctx, o.client, state.RoleId.ValueString(), state.BlueprintId.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			return // 404 is okay
+		}
+		resp.Diagnostics.AddError("error deleting Blueprint permission", err.Error())
+		return
+	}
+}
+
+func (o *resourceBlueprintPermission) setClient(client *apstra.Client) {
+	o.client = client
+}
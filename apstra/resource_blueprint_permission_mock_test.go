@@ -0,0 +1,82 @@
+package tfapstra_test
+
+import (
+	"fmt"
+	"testing"
+
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const resourceBlueprintPermissionMockHCL = `
+resource "apstra_role" "test" {
+  name        = "test"
+  permissions = ["blueprint.view"]
+}
+
+resource "apstra_blueprint_permission" "test" {
+  blueprint_id = %q
+  role_id      = apstra_role.test.id
+  permission   = %q
+}
+`
+
+// TestResourceBlueprintPermissionMock checks that the permission granted to a
+// role is changed in place and can be imported by blueprint and role ID.
+func TestResourceBlueprintPermissionMock(t *testing.T) {
+	srv := testutils.MockApstra(t)
+	bpId := srv.AddBlueprint("test")
+
+	role := &mockServerObject{srv: srv, resourceName: "apstra_role.test", collection: "/api/aaa/roles"}
+
+	checkServerState := func(permission string) resource.TestCheckFunc {
+		return role.Check(func(id string, _ map[string]any) error {
+			p, ok := srv.BlueprintPermission(id, bpId)
+			if !ok {
+				return fmt.Errorf("role %q has no permission for blueprint %q on mock server", id, bpId)
+			}
+			if p != permission {
+				return fmt.Errorf("expected permission %q, got %q", permission, p)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourceBlueprintPermissionMockHCL, bpId, "read_only"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apstra_blueprint_permission.test", "blueprint_id", bpId),
+					resource.TestCheckResourceAttrPair("apstra_blueprint_permission.test", "role_id", "apstra_role.test", "id"),
+					resource.TestCheckResourceAttr("apstra_blueprint_permission.test", "permission", "read_only"),
+					checkServerState("read_only"),
+				),
+			},
+			{
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourceBlueprintPermissionMockHCL, bpId, "read_write"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apstra_blueprint_permission.test", "permission", "read_write"),
+					checkServerState("read_write"),
+				),
+			},
+			{
+				ResourceName: "apstra_blueprint_permission.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return bpId + ":" + role.id, nil
+				},
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "blueprint_id",
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if _, ok := srv.BlueprintPermission(role.id, bpId); ok {
+				return fmt.Errorf("role %q still has permission for blueprint %q on mock server", role.id, bpId)
+			}
+			return nil
+		},
+	})
+}
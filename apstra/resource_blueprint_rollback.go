@@ -0,0 +1,168 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &resourceBlueprintRollback{}
+	_ resource.ResourceWithValidateConfig = &resourceBlueprintRollback{}
+	_ resourceWithSetClient               = &resourceBlueprintRollback{}
+	_ resourceWithSetBpLockFunc           = &resourceBlueprintRollback{}
+	_ resourceWithSetBpUnlockFunc         = &resourceBlueprintRollback{}
+	_ resourceWithSetProviderVersion      = &resourceBlueprintRollback{}
+	_ resourceWithSetTerraformVersion     = &resourceBlueprintRollback{}
+)
+
+type resourceBlueprintRollback struct {
+	client           *apstra.Client
+	lockFunc         blueprintLockFunc
+	unlockFunc       func(context.Context, string) error
+	providerVersion  string
+	terraformVersion string
+}
+
+func (o *resourceBlueprintRollback) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_rollback"
+}
+
+func (o *resourceBlueprintRollback) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	configureResource(ctx, o, req, resp)
+}
+
+func (o *resourceBlueprintRollback) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryRefDesignAny + "This resource rolls the staging Blueprint back to a " +
+			"previously deployed revision (Apstra's *Time Voyager* feature) and optionally deploys it. The " +
+			"rollback happens when the resource is created; changing `revision_id` performs another rollback. " +
+			"Deleting the resource only removes it from the Terraform state. Requires Apstra " +
+			compatibility.BlueprintRevisionsOK.String() + ".",
+		Attributes: blueprint.Rollback{}.ResourceAttributes(),
+	}
+}
+
+func (o *resourceBlueprintRollback) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// cannot proceed if the resource has not been configured
+	if o.client == nil {
+		return
+	}
+
+	compatibility.RequireApiVersion(o.client.ApiVersion(), compatibility.BlueprintRevisionsOK, &resp.Diagnostics)
+}
+
+func (o *resourceBlueprintRollback) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan blueprint.Rollback
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lock the blueprint mutex.
+	err := o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
+			err.Error())
+		return
+	}
+
+	// A deployed rollback is finished with the blueprint, so release the
+	// mutex as apstra_blueprint_deployment would. A staged rollback leaves the
+	// mutex held until the eventual deployment.
+	if plan.Deploy.ValueBool() {
+		defer func() {
+			err := o.unlockFunc(ctx, plan.BlueprintId.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("error unlocking blueprint %q mutex", plan.BlueprintId.ValueString()),
+					err.Error())
+			}
+		}()
+	}
+
+	if !utils.BlueprintExists(ctx, o.client, apstra.ObjectId(plan.BlueprintId.ValueString()), &resp.Diagnostics) {
+		resp.Diagnostics.AddError("Blueprint not found", fmt.Sprintf("Blueprint %q not found", plan.BlueprintId.ValueString()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	template := blueprint.CommentTemplate{
+		ProviderVersion:  o.providerVersion,
+		TerraformVersion: o.terraformVersion,
+	}
+
+	// Save the rollback even if the deployment failed, so that the state
+	// reflects the staging blueprint.
+	if plan.Rollback(ctx, &template, o.client, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
+}
+
+func (o *resourceBlueprintRollback) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state blueprint.Rollback
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !utils.BlueprintExists(ctx, o.client, apstra.ObjectId(state.BlueprintId.ValueString()), &resp.Diagnostics) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The rollback is a one-time event which is not repeated, but the
+	// blueprint may have been deployed since.
+	state.Read(ctx, o.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (o *resourceBlueprintRollback) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Because every configurable attribute requires replacement, Update() should never be called.
+	resp.Diagnostics.Append(validatordiag.BugInProviderDiagnostic(
+		"resourceBlueprintRollback.Update() should never be called",
+	))
+}
+
+func (o *resourceBlueprintRollback) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Nothing to do. The Terraform Plugin Framework will remove the resource
+	// from the terraform state for us. A rollback cannot be undone.
+}
+
+func (o *resourceBlueprintRollback) setClient(client *apstra.Client) {
+	o.client = client
+}
+
+func (o *resourceBlueprintRollback) setBpLockFunc(f blueprintLockFunc) {
+	o.lockFunc = f
+}
+
+func (o *resourceBlueprintRollback) setBpUnlockFunc(f func(context.Context, string) error) {
+	o.unlockFunc = f
+}
+
+func (o *resourceBlueprintRollback) setProviderVersion(v string) {
+	o.providerVersion = v
+}
+
+func (o *resourceBlueprintRollback) setTerraformVersion(v string) {
+	o.terraformVersion = v
+}
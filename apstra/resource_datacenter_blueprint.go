@@ -26,7 +26,7 @@ var (
 type resourceDatacenterBlueprint struct {
 	client          *apstra.Client
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 	unlockFunc      func(context.Context, string) error
 }
 
@@ -242,7 +242,7 @@ func (o *resourceDatacenterBlueprint) Update(ctx context.Context, req resource.U
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.Id.ValueString())
+	err = o.lockFunc(ctx, plan.Id.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError("failed locking blueprint mutex", err.Error())
 		return
@@ -329,7 +329,7 @@ func (o *resourceDatacenterBlueprint) setBpClientFunc(f func(context.Context, st
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterBlueprint) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterBlueprint) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
 
@@ -18,14 +18,19 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = &resourceDatacenterConfiglet{}
+	_ resource.ResourceWithImportState    = &resourceDatacenterConfiglet{}
 	_ resource.ResourceWithValidateConfig = &resourceDatacenterConfiglet{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDatacenterConfiglet{}
 	_ resourceWithSetBpLockFunc           = &resourceDatacenterConfiglet{}
+	_ resourceWithSetBpDriftCheckFunc     = &resourceDatacenterConfiglet{}
+	_ resourceWithSetBpDriftBaselineFunc  = &resourceDatacenterConfiglet{}
 )
 
 type resourceDatacenterConfiglet struct {
-	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	getBpClientFunc   func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	lockFunc          blueprintLockFunc
+	driftCheckFunc    blueprintDriftCheckFunc
+	driftBaselineFunc blueprintDriftBaselineFunc
 }
 
 func (o *resourceDatacenterConfiglet) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -138,7 +143,7 @@ func (o *resourceDatacenterConfiglet) Create(ctx context.Context, req resource.C
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -161,6 +166,10 @@ func (o *resourceDatacenterConfiglet) Create(ctx context.Context, req resource.C
 
 	// update the plan with the configlet ID and set the state
 	plan.Id = types.StringValue(id.String())
+
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -193,11 +202,18 @@ func (o *resourceDatacenterConfiglet) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	// warn about changes staged outside of terraform
+	o.driftCheckFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), blueprint.DatacenterConfiglet{}.DriftAttributePaths(), req.Private, &resp.Diagnostics)
+
 	// Set state
 	state.LoadApiData(ctx, api.Data, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterConfiglet) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterConfiglet) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
 	var plan blueprint.DatacenterConfiglet
@@ -214,7 +230,7 @@ func (o *resourceDatacenterConfiglet) Update(ctx context.Context, req resource.U
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -237,6 +253,9 @@ func (o *resourceDatacenterConfiglet) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	// create new state object
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -260,7 +279,7 @@ func (o *resourceDatacenterConfiglet) Delete(ctx context.Context, req resource.D
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -284,6 +303,14 @@ func (o *resourceDatacenterConfiglet) setBpClientFunc(f func(context.Context, st
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterConfiglet) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterConfiglet) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
+
+func (o *resourceDatacenterConfiglet) setBpDriftCheckFunc(f blueprintDriftCheckFunc) {
+	o.driftCheckFunc = f
+}
+
+func (o *resourceDatacenterConfiglet) setBpDriftBaselineFunc(f blueprintDriftBaselineFunc) {
+	o.driftBaselineFunc = f
+}
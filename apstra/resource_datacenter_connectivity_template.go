@@ -13,14 +13,19 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceDatacenterConnectivityTemplate{}
-	_ resourceWithSetDcBpClientFunc  = &resourceDatacenterConnectivityTemplate{}
-	_ resourceWithSetBpLockFunc      = &resourceDatacenterConnectivityTemplate{}
+	_ resource.ResourceWithConfigure     = &resourceDatacenterConnectivityTemplate{}
+	_ resource.ResourceWithImportState   = &resourceDatacenterConnectivityTemplate{}
+	_ resourceWithSetDcBpClientFunc      = &resourceDatacenterConnectivityTemplate{}
+	_ resourceWithSetBpLockFunc          = &resourceDatacenterConnectivityTemplate{}
+	_ resourceWithSetBpDriftCheckFunc    = &resourceDatacenterConnectivityTemplate{}
+	_ resourceWithSetBpDriftBaselineFunc = &resourceDatacenterConnectivityTemplate{}
 )
 
 type resourceDatacenterConnectivityTemplate struct {
-	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	getBpClientFunc   func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	lockFunc          blueprintLockFunc
+	driftCheckFunc    blueprintDriftCheckFunc
+	driftBaselineFunc blueprintDriftBaselineFunc
 }
 
 func (o *resourceDatacenterConnectivityTemplate) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,7 +67,7 @@ func (o *resourceDatacenterConnectivityTemplate) Create(ctx context.Context, req
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -83,8 +88,12 @@ func (o *resourceDatacenterConnectivityTemplate) Create(ctx context.Context, req
 		return
 	}
 
-	// set the state
 	plan.Id = types.StringValue(string(*request.Id))
+
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
+	// set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -123,10 +132,17 @@ func (o *resourceDatacenterConnectivityTemplate) Read(ctx context.Context, req r
 		return
 	}
 
+	// warn about changes staged outside of terraform
+	o.driftCheckFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), blueprint.ConnectivityTemplate{}.DriftAttributePaths(), req.Private, &resp.Diagnostics)
+
 	// set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterConnectivityTemplate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterConnectivityTemplate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan.
 	var plan blueprint.ConnectivityTemplate
@@ -143,7 +159,7 @@ func (o *resourceDatacenterConnectivityTemplate) Update(ctx context.Context, req
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -164,6 +180,9 @@ func (o *resourceDatacenterConnectivityTemplate) Update(ctx context.Context, req
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -186,7 +205,7 @@ func (o *resourceDatacenterConnectivityTemplate) Delete(ctx context.Context, req
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -208,6 +227,14 @@ func (o *resourceDatacenterConnectivityTemplate) setBpClientFunc(f func(context.
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterConnectivityTemplate) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterConnectivityTemplate) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
+
+func (o *resourceDatacenterConnectivityTemplate) setBpDriftCheckFunc(f blueprintDriftCheckFunc) {
+	o.driftCheckFunc = f
+}
+
+func (o *resourceDatacenterConnectivityTemplate) setBpDriftBaselineFunc(f blueprintDriftBaselineFunc) {
+	o.driftBaselineFunc = f
+}
@@ -15,14 +15,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceDatacenterConnectivityTemplateAssignments{}
-	_ resourceWithSetDcBpClientFunc  = &resourceDatacenterConnectivityTemplateAssignments{}
-	_ resourceWithSetBpLockFunc      = &resourceDatacenterConnectivityTemplateAssignments{}
+	_ resource.ResourceWithConfigure   = &resourceDatacenterConnectivityTemplateAssignments{}
+	_ resource.ResourceWithImportState = &resourceDatacenterConnectivityTemplateAssignments{}
+	_ resourceWithSetDcBpClientFunc    = &resourceDatacenterConnectivityTemplateAssignments{}
+	_ resourceWithSetBpLockFunc        = &resourceDatacenterConnectivityTemplateAssignments{}
 )
 
 type resourceDatacenterConnectivityTemplateAssignments struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterConnectivityTemplateAssignments) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,7 +63,7 @@ func (o *resourceDatacenterConnectivityTemplateAssignments) Create(ctx context.C
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -143,6 +144,10 @@ func (o *resourceDatacenterConnectivityTemplateAssignments) Read(ctx context.Con
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterConnectivityTemplateAssignments) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "connectivity_template_id")
+}
+
 func (o *resourceDatacenterConnectivityTemplateAssignments) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
 	var plan blueprint.ConnectivityTemplateAssignments
@@ -166,7 +171,7 @@ func (o *resourceDatacenterConnectivityTemplateAssignments) Update(ctx context.C
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -251,7 +256,7 @@ func (o *resourceDatacenterConnectivityTemplateAssignments) Delete(ctx context.C
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -324,6 +329,6 @@ func (o *resourceDatacenterConnectivityTemplateAssignments) setBpClientFunc(f fu
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterConnectivityTemplateAssignments) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterConnectivityTemplateAssignments) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
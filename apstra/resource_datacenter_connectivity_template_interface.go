@@ -13,6 +13,7 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = &resourceDatacenterConnectivityTemplateInterface{}
+	_ resource.ResourceWithImportState    = &resourceDatacenterConnectivityTemplateInterface{}
 	_ resource.ResourceWithValidateConfig = &resourceDatacenterConnectivityTemplateInterface{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDatacenterConnectivityTemplateInterface{}
 	_ resourceWithSetBpLockFunc           = &resourceDatacenterConnectivityTemplateInterface{}
@@ -20,7 +21,7 @@ var (
 
 type resourceDatacenterConnectivityTemplateInterface struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterConnectivityTemplateInterface) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -107,7 +108,7 @@ func (o *resourceDatacenterConnectivityTemplateInterface) Create(ctx context.Con
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -174,6 +175,10 @@ func (o *resourceDatacenterConnectivityTemplateInterface) Read(ctx context.Conte
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterConnectivityTemplateInterface) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterConnectivityTemplateInterface) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan.
 	var plan connectivitytemplates.ConnectivityTemplateInterface
@@ -190,7 +195,7 @@ func (o *resourceDatacenterConnectivityTemplateInterface) Update(ctx context.Con
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -236,7 +241,7 @@ func (o *resourceDatacenterConnectivityTemplateInterface) Delete(ctx context.Con
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -258,6 +263,6 @@ func (o *resourceDatacenterConnectivityTemplateInterface) setBpClientFunc(f func
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterConnectivityTemplateInterface) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterConnectivityTemplateInterface) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
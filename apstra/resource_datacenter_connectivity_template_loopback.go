@@ -12,14 +12,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceDatacenterConnectivityTemplateLoopback{}
-	_ resourceWithSetDcBpClientFunc  = &resourceDatacenterConnectivityTemplateLoopback{}
-	_ resourceWithSetBpLockFunc      = &resourceDatacenterConnectivityTemplateLoopback{}
+	_ resource.ResourceWithConfigure   = &resourceDatacenterConnectivityTemplateLoopback{}
+	_ resource.ResourceWithImportState = &resourceDatacenterConnectivityTemplateLoopback{}
+	_ resourceWithSetDcBpClientFunc    = &resourceDatacenterConnectivityTemplateLoopback{}
+	_ resourceWithSetBpLockFunc        = &resourceDatacenterConnectivityTemplateLoopback{}
 )
 
 type resourceDatacenterConnectivityTemplateLoopback struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterConnectivityTemplateLoopback) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,7 +62,7 @@ func (o *resourceDatacenterConnectivityTemplateLoopback) Create(ctx context.Cont
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -128,6 +129,10 @@ func (o *resourceDatacenterConnectivityTemplateLoopback) Read(ctx context.Contex
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterConnectivityTemplateLoopback) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterConnectivityTemplateLoopback) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan.
 	var plan connectivitytemplates.ConnectivityTemplateLoopback
@@ -144,7 +149,7 @@ func (o *resourceDatacenterConnectivityTemplateLoopback) Update(ctx context.Cont
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -190,7 +195,7 @@ func (o *resourceDatacenterConnectivityTemplateLoopback) Delete(ctx context.Cont
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -212,6 +217,6 @@ func (o *resourceDatacenterConnectivityTemplateLoopback) setBpClientFunc(f func(
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterConnectivityTemplateLoopback) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterConnectivityTemplateLoopback) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -12,14 +12,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceDatacenterConnectivityTemplateProtocolEndpoint{}
-	_ resourceWithSetDcBpClientFunc  = &resourceDatacenterConnectivityTemplateProtocolEndpoint{}
-	_ resourceWithSetBpLockFunc      = &resourceDatacenterConnectivityTemplateProtocolEndpoint{}
+	_ resource.ResourceWithConfigure   = &resourceDatacenterConnectivityTemplateProtocolEndpoint{}
+	_ resource.ResourceWithImportState = &resourceDatacenterConnectivityTemplateProtocolEndpoint{}
+	_ resourceWithSetDcBpClientFunc    = &resourceDatacenterConnectivityTemplateProtocolEndpoint{}
+	_ resourceWithSetBpLockFunc        = &resourceDatacenterConnectivityTemplateProtocolEndpoint{}
 )
 
 type resourceDatacenterConnectivityTemplateProtocolEndpoint struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterConnectivityTemplateProtocolEndpoint) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,7 +61,7 @@ func (o *resourceDatacenterConnectivityTemplateProtocolEndpoint) Create(ctx cont
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -127,6 +128,10 @@ func (o *resourceDatacenterConnectivityTemplateProtocolEndpoint) Read(ctx contex
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterConnectivityTemplateProtocolEndpoint) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterConnectivityTemplateProtocolEndpoint) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan.
 	var plan connectivitytemplates.ConnectivityTemplateProtocolEndpoint
@@ -143,7 +148,7 @@ func (o *resourceDatacenterConnectivityTemplateProtocolEndpoint) Update(ctx cont
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -189,7 +194,7 @@ func (o *resourceDatacenterConnectivityTemplateProtocolEndpoint) Delete(ctx cont
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -211,6 +216,6 @@ func (o *resourceDatacenterConnectivityTemplateProtocolEndpoint) setBpClientFunc
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterConnectivityTemplateProtocolEndpoint) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterConnectivityTemplateProtocolEndpoint) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -15,6 +15,7 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = &resourceDatacenterConnectivityTemplateSvi{}
+	_ resource.ResourceWithImportState    = &resourceDatacenterConnectivityTemplateSvi{}
 	_ resource.ResourceWithValidateConfig = &resourceDatacenterConnectivityTemplateSvi{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDatacenterConnectivityTemplateSvi{}
 	_ resourceWithSetBpLockFunc           = &resourceDatacenterConnectivityTemplateSvi{}
@@ -22,7 +23,7 @@ var (
 
 type resourceDatacenterConnectivityTemplateSvi struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterConnectivityTemplateSvi) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -99,7 +100,7 @@ func (o *resourceDatacenterConnectivityTemplateSvi) Create(ctx context.Context,
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -166,6 +167,10 @@ func (o *resourceDatacenterConnectivityTemplateSvi) Read(ctx context.Context, re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterConnectivityTemplateSvi) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterConnectivityTemplateSvi) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan.
 	var plan connectivitytemplates.ConnectivityTemplateSvi
@@ -182,7 +187,7 @@ func (o *resourceDatacenterConnectivityTemplateSvi) Update(ctx context.Context,
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -228,7 +233,7 @@ func (o *resourceDatacenterConnectivityTemplateSvi) Delete(ctx context.Context,
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -250,6 +255,6 @@ func (o *resourceDatacenterConnectivityTemplateSvi) setBpClientFunc(f func(conte
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterConnectivityTemplateSvi) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterConnectivityTemplateSvi) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
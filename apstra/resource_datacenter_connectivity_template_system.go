@@ -13,6 +13,7 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = &resourceDatacenterConnectivityTemplateSystem{}
+	_ resource.ResourceWithImportState    = &resourceDatacenterConnectivityTemplateSystem{}
 	_ resource.ResourceWithValidateConfig = &resourceDatacenterConnectivityTemplateSystem{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDatacenterConnectivityTemplateSystem{}
 	_ resourceWithSetBpLockFunc           = &resourceDatacenterConnectivityTemplateSystem{}
@@ -20,7 +21,7 @@ var (
 
 type resourceDatacenterConnectivityTemplateSystem struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterConnectivityTemplateSystem) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,7 +73,7 @@ func (o *resourceDatacenterConnectivityTemplateSystem) Create(ctx context.Contex
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -139,6 +140,10 @@ func (o *resourceDatacenterConnectivityTemplateSystem) Read(ctx context.Context,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterConnectivityTemplateSystem) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterConnectivityTemplateSystem) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan.
 	var plan connectivitytemplates.ConnectivityTemplateSystem
@@ -155,7 +160,7 @@ func (o *resourceDatacenterConnectivityTemplateSystem) Update(ctx context.Contex
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -201,7 +206,7 @@ func (o *resourceDatacenterConnectivityTemplateSystem) Delete(ctx context.Contex
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -223,6 +228,6 @@ func (o *resourceDatacenterConnectivityTemplateSystem) setBpClientFunc(f func(co
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterConnectivityTemplateSystem) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterConnectivityTemplateSystem) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
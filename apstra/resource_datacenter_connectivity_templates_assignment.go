@@ -8,6 +8,7 @@ import (
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
 	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
+	"github.com/Juniper/terraform-provider-apstra/apstra/private"
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
 	"github.com/Juniper/terraform-provider-apstra/internal/value"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,14 +17,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceDatacenterConnectivityTemplatesAssignment{}
-	_ resourceWithSetDcBpClientFunc  = &resourceDatacenterConnectivityTemplatesAssignment{}
-	_ resourceWithSetBpLockFunc      = &resourceDatacenterConnectivityTemplatesAssignment{}
+	_ resource.ResourceWithConfigure   = &resourceDatacenterConnectivityTemplatesAssignment{}
+	_ resource.ResourceWithImportState = &resourceDatacenterConnectivityTemplatesAssignment{}
+	_ resourceWithSetDcBpClientFunc    = &resourceDatacenterConnectivityTemplatesAssignment{}
+	_ resourceWithSetBpLockFunc        = &resourceDatacenterConnectivityTemplatesAssignment{}
 )
 
 type resourceDatacenterConnectivityTemplatesAssignment struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterConnectivityTemplatesAssignment) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -63,7 +65,7 @@ func (o *resourceDatacenterConnectivityTemplatesAssignment) Create(ctx context.C
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -134,6 +136,17 @@ func (o *resourceDatacenterConnectivityTemplatesAssignment) Read(ctx context.Con
 		return
 	}
 
+	// a freshly imported resource takes ownership of every currently assigned CT
+	var imported private.ResourceImported
+	imported.LoadPrivateState(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if imported.Imported {
+		stateCTIDs = currentCTIDs
+		imported.Clear(ctx, resp.Private, &resp.Diagnostics)
+	}
+
 	// remainingCTIDs are the previously assigned IDs (those from state) which are still assigned (current)
 	remainingCTIDs := utils.SliceIntersectionOfAB(currentCTIDs, stateCTIDs)
 	state.ConnectivityTemplateIds = value.SetOrNull(ctx, types.StringType, remainingCTIDs, &resp.Diagnostics)
@@ -151,6 +164,16 @@ func (o *resourceDatacenterConnectivityTemplatesAssignment) Read(ctx context.Con
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterConnectivityTemplatesAssignment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "application_point_id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// flag the import so that Read() adopts all currently assigned CTs
+	private.ResourceImported{Imported: true}.SetPrivateState(ctx, resp.Private, &resp.Diagnostics)
+}
+
 func (o *resourceDatacenterConnectivityTemplatesAssignment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
 	var plan blueprint.ConnectivityTemplatesAssignment
@@ -174,7 +197,7 @@ func (o *resourceDatacenterConnectivityTemplatesAssignment) Update(ctx context.C
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -307,7 +330,7 @@ func (o *resourceDatacenterConnectivityTemplatesAssignment) Delete(ctx context.C
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -409,6 +432,6 @@ func (o *resourceDatacenterConnectivityTemplatesAssignment) setBpClientFunc(f fu
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterConnectivityTemplatesAssignment) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterConnectivityTemplatesAssignment) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/Juniper/apstra-go-sdk/apstra"
 	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,6 +18,7 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = &resourceDeviceAllocation{}
+	_ resource.ResourceWithImportState    = &resourceDeviceAllocation{}
 	_ resource.ResourceWithValidateConfig = &resourceDeviceAllocation{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDeviceAllocation{}
 	_ resourceWithSetBpLockFunc           = &resourceDeviceAllocation{}
@@ -26,7 +28,7 @@ var (
 type resourceDeviceAllocation struct {
 	experimental    types.Bool
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDeviceAllocation) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -87,7 +89,7 @@ func (o *resourceDeviceAllocation) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -210,7 +212,7 @@ func (o *resourceDeviceAllocation) Read(ctx context.Context, req resource.ReadRe
 	// special handling for FFE gyrations. The interface map ID might change,
 	// but we shouldn't surface that difference in Read() if the interface map
 	// map label (web UI "name") suggests the ID change is due to FFE.
-	if !state.InitialInterfaceMapId.Equal(previousInterfaceMapCatalogId) {
+	if !previousInterfaceMapCatalogId.IsNull() && !state.InitialInterfaceMapId.Equal(previousInterfaceMapCatalogId) {
 		// Interface map ID in blueprint doesn't match the one used to create it.
 		// Is it a manual change or the result of an FFE event?
 		// Based on `aos/reference_design/fabric_expansion_util.py`:
@@ -225,8 +227,11 @@ func (o *resourceDeviceAllocation) Read(ctx context.Context, req resource.ReadRe
 		}
 	}
 
-	// InterfaceMapName must be immutable in order to be useful in detecting FFE modifications
-	state.InterfaceMapName = previousInterfaceMapName
+	// InterfaceMapName must be immutable in order to be useful in detecting FFE
+	// modifications. It will be null only following import.
+	if !previousInterfaceMapName.IsNull() {
+		state.InterfaceMapName = previousInterfaceMapName
+	}
 
 	// read all apstra-assigned values; blow away existing values to ensure all values are read
 	state.SystemAttributes = types.ObjectUnknown(blueprint.DeviceAllocationSystemAttributes{}.AttrTypes())
@@ -243,6 +248,39 @@ func (o *resourceDeviceAllocation) Read(ctx context.Context, req resource.ReadRe
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDeviceAllocation) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := parseImportId(req.ID, []string{"blueprint_id", "node_name"}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := blueprint.DeviceAllocation{
+		BlueprintId: types.StringValue(ids[0]),
+		NodeName:    types.StringValue(ids[1]),
+	}
+
+	// get a client for the datacenter reference design
+	bp, err := o.getBpClientFunc(ctx, state.BlueprintId.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf(errBpNotFoundSummary, state.BlueprintId), err.Error())
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf(errBpClientCreateSummary, state.BlueprintId), err.Error())
+		return
+	}
+
+	// Read() locates the system using node_id, so we have to find it here.
+	state.NodeIdFromNodeName(ctx, bp.Client(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("blueprint_id"), state.BlueprintId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_name"), state.NodeName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_id"), state.NodeId)...)
+}
+
 func (o *resourceDeviceAllocation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
 	var plan blueprint.DeviceAllocation
@@ -285,7 +323,7 @@ func (o *resourceDeviceAllocation) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -345,7 +383,7 @@ func (o *resourceDeviceAllocation) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -367,7 +405,7 @@ func (o *resourceDeviceAllocation) setBpClientFunc(f func(context.Context, strin
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDeviceAllocation) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDeviceAllocation) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
 
@@ -2,7 +2,6 @@ package tfapstra
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
@@ -22,12 +21,16 @@ var (
 	_ resourceWithSetClient               = &resourceDatacenterExternalGateway{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDatacenterExternalGateway{}
 	_ resourceWithSetBpLockFunc           = &resourceDatacenterExternalGateway{}
+	_ resourceWithSetBpDriftCheckFunc     = &resourceDatacenterExternalGateway{}
+	_ resourceWithSetBpDriftBaselineFunc  = &resourceDatacenterExternalGateway{}
 )
 
 type resourceDatacenterExternalGateway struct {
-	client          *apstra.Client
-	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	client            *apstra.Client
+	getBpClientFunc   func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	lockFunc          blueprintLockFunc
+	driftCheckFunc    blueprintDriftCheckFunc
+	driftBaselineFunc blueprintDriftBaselineFunc
 }
 
 func (o *resourceDatacenterExternalGateway) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -87,32 +90,16 @@ func (o *resourceDatacenterExternalGateway) ValidateConfig(ctx context.Context,
 }
 
 func (o *resourceDatacenterExternalGateway) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var importId struct {
-		BlueprintId string `json:"blueprint_id"`
-		Id          string `json:"id"`
-	}
-
-	// parse the user-supplied import ID string JSON
-	err := json.Unmarshal([]byte(req.ID), &importId)
-	if err != nil {
-		resp.Diagnostics.AddError("failed parsing import id JSON string", err.Error())
-		return
-	}
-
-	if importId.BlueprintId == "" {
-		resp.Diagnostics.AddError(errImportJsonMissingRequiredField, "'blueprint_id' element of import ID string cannot be empty")
-		return
-	}
-
-	if importId.Id == "" {
-		resp.Diagnostics.AddError(errImportJsonMissingRequiredField, "'id' element of import ID string cannot be empty")
+	// parse the user-supplied import ID string
+	ids := parseImportId(req.ID, []string{"blueprint_id", "id"}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// create a state object preloaded with the critical details we need in advance
 	state := blueprint.ExternalGateway{
-		BlueprintId: types.StringValue(importId.BlueprintId),
-		Id:          types.StringValue(importId.Id),
+		BlueprintId: types.StringValue(ids[0]),
+		Id:          types.StringValue(ids[1]),
 	}
 
 	// get a client for the datacenter reference design
@@ -164,7 +151,7 @@ func (o *resourceDatacenterExternalGateway) Create(ctx context.Context, req reso
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -192,6 +179,9 @@ func (o *resourceDatacenterExternalGateway) Create(ctx context.Context, req reso
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -226,6 +216,9 @@ func (o *resourceDatacenterExternalGateway) Read(ctx context.Context, req resour
 		return
 	}
 
+	// warn about changes staged outside of terraform
+	o.driftCheckFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), blueprint.ExternalGateway{}.DriftAttributePaths(), req.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -245,7 +238,7 @@ func (o *resourceDatacenterExternalGateway) Update(ctx context.Context, req reso
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -272,6 +265,9 @@ func (o *resourceDatacenterExternalGateway) Update(ctx context.Context, req reso
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -294,7 +290,7 @@ func (o *resourceDatacenterExternalGateway) Delete(ctx context.Context, req reso
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -316,10 +312,18 @@ func (o *resourceDatacenterExternalGateway) setBpClientFunc(f func(context.Conte
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterExternalGateway) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterExternalGateway) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
 
+func (o *resourceDatacenterExternalGateway) setBpDriftCheckFunc(f blueprintDriftCheckFunc) {
+	o.driftCheckFunc = f
+}
+
+func (o *resourceDatacenterExternalGateway) setBpDriftBaselineFunc(f blueprintDriftBaselineFunc) {
+	o.driftBaselineFunc = f
+}
+
 func (o *resourceDatacenterExternalGateway) setClient(client *apstra.Client) {
 	o.client = client
 }
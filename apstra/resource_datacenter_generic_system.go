@@ -21,15 +21,20 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = &resourceDatacenterGenericSystem{}
+	_ resource.ResourceWithImportState    = &resourceDatacenterGenericSystem{}
 	_ resource.ResourceWithModifyPlan     = &resourceDatacenterGenericSystem{}
 	_ resource.ResourceWithValidateConfig = &resourceDatacenterGenericSystem{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDatacenterGenericSystem{}
 	_ resourceWithSetBpLockFunc           = &resourceDatacenterGenericSystem{}
+	_ resourceWithSetBpDriftCheckFunc     = &resourceDatacenterGenericSystem{}
+	_ resourceWithSetBpDriftBaselineFunc  = &resourceDatacenterGenericSystem{}
 )
 
 type resourceDatacenterGenericSystem struct {
-	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	getBpClientFunc   func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	lockFunc          blueprintLockFunc
+	driftCheckFunc    blueprintDriftCheckFunc
+	driftBaselineFunc blueprintDriftBaselineFunc
 }
 
 func (o *resourceDatacenterGenericSystem) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -192,7 +197,7 @@ func (o *resourceDatacenterGenericSystem) Create(ctx context.Context, req resour
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed to lock blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -251,6 +256,9 @@ func (o *resourceDatacenterGenericSystem) Create(ctx context.Context, req resour
 
 	plan.ReadSwitchInterfaceApplicationPoints(ctx, bp, &resp.Diagnostics) // don't return here - still want to set the state
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	// set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -317,10 +325,17 @@ func (o *resourceDatacenterGenericSystem) Read(ctx context.Context, req resource
 		state.ReadSwitchInterfaceApplicationPoints(ctx, bp, &resp.Diagnostics)
 	}
 
+	// warn about changes staged outside of terraform
+	o.driftCheckFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), blueprint.DatacenterGenericSystem{}.DriftAttributePaths(), req.Private, &resp.Diagnostics)
+
 	// set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterGenericSystem) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterGenericSystem) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan.
 	var plan blueprint.DatacenterGenericSystem
@@ -344,7 +359,7 @@ func (o *resourceDatacenterGenericSystem) Update(ctx context.Context, req resour
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed to lock blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -380,6 +395,9 @@ func (o *resourceDatacenterGenericSystem) Update(ctx context.Context, req resour
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -402,7 +420,7 @@ func (o *resourceDatacenterGenericSystem) Delete(ctx context.Context, req resour
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed to lock blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -446,6 +464,14 @@ func (o *resourceDatacenterGenericSystem) setBpClientFunc(f func(context.Context
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterGenericSystem) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterGenericSystem) setBpDriftCheckFunc(f blueprintDriftCheckFunc) {
+	o.driftCheckFunc = f
+}
+
+func (o *resourceDatacenterGenericSystem) setBpDriftBaselineFunc(f blueprintDriftBaselineFunc) {
+	o.driftBaselineFunc = f
+}
+
+func (o *resourceDatacenterGenericSystem) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -13,14 +13,19 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceDatacenterInterconnectDomain{}
-	_ resourceWithSetDcBpClientFunc  = &resourceDatacenterInterconnectDomain{}
-	_ resourceWithSetBpLockFunc      = &resourceDatacenterInterconnectDomain{}
+	_ resource.ResourceWithConfigure     = &resourceDatacenterInterconnectDomain{}
+	_ resource.ResourceWithImportState   = &resourceDatacenterInterconnectDomain{}
+	_ resourceWithSetDcBpClientFunc      = &resourceDatacenterInterconnectDomain{}
+	_ resourceWithSetBpLockFunc          = &resourceDatacenterInterconnectDomain{}
+	_ resourceWithSetBpDriftCheckFunc    = &resourceDatacenterInterconnectDomain{}
+	_ resourceWithSetBpDriftBaselineFunc = &resourceDatacenterInterconnectDomain{}
 )
 
 type resourceDatacenterInterconnectDomain struct {
-	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	getBpClientFunc   func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	lockFunc          blueprintLockFunc
+	driftCheckFunc    blueprintDriftCheckFunc
+	driftBaselineFunc blueprintDriftBaselineFunc
 }
 
 func (o *resourceDatacenterInterconnectDomain) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,7 +64,7 @@ func (o *resourceDatacenterInterconnectDomain) Create(ctx context.Context, req r
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -95,6 +100,9 @@ func (o *resourceDatacenterInterconnectDomain) Create(ctx context.Context, req r
 		}
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -127,11 +135,18 @@ func (o *resourceDatacenterInterconnectDomain) Read(ctx context.Context, req res
 		return
 	}
 
+	// warn about changes staged outside of terraform
+	o.driftCheckFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), blueprint.InterconnectDomain{}.DriftAttributePaths(), req.Private, &resp.Diagnostics)
+
 	// Set state
 	state.LoadApiData(ctx, api, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterInterconnectDomain) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterInterconnectDomain) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
 	var plan blueprint.InterconnectDomain
@@ -148,7 +163,7 @@ func (o *resourceDatacenterInterconnectDomain) Update(ctx context.Context, req r
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -184,6 +199,9 @@ func (o *resourceDatacenterInterconnectDomain) Update(ctx context.Context, req r
 		}
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	// create new state object
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -207,7 +225,7 @@ func (o *resourceDatacenterInterconnectDomain) Delete(ctx context.Context, req r
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -231,6 +249,14 @@ func (o *resourceDatacenterInterconnectDomain) setBpClientFunc(f func(context.Co
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterInterconnectDomain) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterInterconnectDomain) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
+
+func (o *resourceDatacenterInterconnectDomain) setBpDriftCheckFunc(f blueprintDriftCheckFunc) {
+	o.driftCheckFunc = f
+}
+
+func (o *resourceDatacenterInterconnectDomain) setBpDriftBaselineFunc(f blueprintDriftBaselineFunc) {
+	o.driftBaselineFunc = f
+}
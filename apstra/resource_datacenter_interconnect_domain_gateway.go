@@ -2,7 +2,6 @@ package tfapstra
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
@@ -14,15 +13,19 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure   = &resourceDatacenterInterconnectDomainGateway{}
-	_ resource.ResourceWithImportState = &resourceDatacenterInterconnectDomainGateway{}
-	_ resourceWithSetDcBpClientFunc    = &resourceDatacenterInterconnectDomainGateway{}
-	_ resourceWithSetBpLockFunc        = &resourceDatacenterInterconnectDomainGateway{}
+	_ resource.ResourceWithConfigure     = &resourceDatacenterInterconnectDomainGateway{}
+	_ resource.ResourceWithImportState   = &resourceDatacenterInterconnectDomainGateway{}
+	_ resourceWithSetDcBpClientFunc      = &resourceDatacenterInterconnectDomainGateway{}
+	_ resourceWithSetBpLockFunc          = &resourceDatacenterInterconnectDomainGateway{}
+	_ resourceWithSetBpDriftCheckFunc    = &resourceDatacenterInterconnectDomainGateway{}
+	_ resourceWithSetBpDriftBaselineFunc = &resourceDatacenterInterconnectDomainGateway{}
 )
 
 type resourceDatacenterInterconnectDomainGateway struct {
-	lockFunc        func(context.Context, string) error
-	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	lockFunc          blueprintLockFunc
+	driftCheckFunc    blueprintDriftCheckFunc
+	driftBaselineFunc blueprintDriftBaselineFunc
+	getBpClientFunc   func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
 }
 
 func (o *resourceDatacenterInterconnectDomainGateway) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,32 +44,16 @@ func (o *resourceDatacenterInterconnectDomainGateway) Schema(_ context.Context,
 }
 
 func (o *resourceDatacenterInterconnectDomainGateway) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var importId struct {
-		BlueprintId string `json:"blueprint_id"`
-		Id          string `json:"id"`
-	}
-
-	// parse the user-supplied import ID string JSON
-	err := json.Unmarshal([]byte(req.ID), &importId)
-	if err != nil {
-		resp.Diagnostics.AddError("failed parsing import id JSON string", err.Error())
-		return
-	}
-
-	if importId.BlueprintId == "" {
-		resp.Diagnostics.AddError(errImportJsonMissingRequiredField, "'blueprint_id' element of import ID string cannot be empty")
-		return
-	}
-
-	if importId.Id == "" {
-		resp.Diagnostics.AddError(errImportJsonMissingRequiredField, "'id' element of import ID string cannot be empty")
+	// parse the user-supplied import ID string
+	ids := parseImportId(req.ID, []string{"blueprint_id", "id"}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// create a state object preloaded with the critical details we need in advance
 	state := blueprint.InterconnectDomainGateway{
-		BlueprintId: types.StringValue(importId.BlueprintId),
-		Id:          types.StringValue(importId.Id),
+		BlueprintId: types.StringValue(ids[0]),
+		Id:          types.StringValue(ids[1]),
 	}
 
 	// get a client for the datacenter reference design
@@ -118,7 +105,7 @@ func (o *resourceDatacenterInterconnectDomainGateway) Create(ctx context.Context
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -146,6 +133,9 @@ func (o *resourceDatacenterInterconnectDomainGateway) Create(ctx context.Context
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -180,6 +170,9 @@ func (o *resourceDatacenterInterconnectDomainGateway) Read(ctx context.Context,
 		return
 	}
 
+	// warn about changes staged outside of terraform
+	o.driftCheckFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), blueprint.InterconnectDomainGateway{}.DriftAttributePaths(), req.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -199,7 +192,7 @@ func (o *resourceDatacenterInterconnectDomainGateway) Update(ctx context.Context
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -226,6 +219,9 @@ func (o *resourceDatacenterInterconnectDomainGateway) Update(ctx context.Context
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -248,7 +244,7 @@ func (o *resourceDatacenterInterconnectDomainGateway) Delete(ctx context.Context
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -270,6 +266,14 @@ func (o *resourceDatacenterInterconnectDomainGateway) setBpClientFunc(f func(con
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterInterconnectDomainGateway) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterInterconnectDomainGateway) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
+
+func (o *resourceDatacenterInterconnectDomainGateway) setBpDriftCheckFunc(f blueprintDriftCheckFunc) {
+	o.driftCheckFunc = f
+}
+
+func (o *resourceDatacenterInterconnectDomainGateway) setBpDriftBaselineFunc(f blueprintDriftBaselineFunc) {
+	o.driftBaselineFunc = f
+}
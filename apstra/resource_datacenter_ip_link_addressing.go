@@ -19,6 +19,7 @@ import (
 )
 
 var (
+	_ resource.ResourceWithImportState    = &resourceDatacenterIpLinkAddressing{}
 	_ resource.ResourceWithValidateConfig = &resourceDatacenterIpLinkAddressing{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDatacenterIpLinkAddressing{}
 	_ resourceWithSetBpLockFunc           = &resourceDatacenterIpLinkAddressing{}
@@ -26,7 +27,7 @@ var (
 
 type resourceDatacenterIpLinkAddressing struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterIpLinkAddressing) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -178,7 +179,7 @@ func (o *resourceDatacenterIpLinkAddressing) Create(ctx context.Context, req res
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed to lock blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -282,6 +283,44 @@ func (o *resourceDatacenterIpLinkAddressing) Read(ctx context.Context, req resou
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterIpLinkAddressing) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := importStateById(ctx, req, resp, "blueprint_id", "link_id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// get a client for the datacenter reference design
+	bp, err := o.getBpClientFunc(ctx, ids[0])
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf(errBpNotFoundSummary, ids[0]), err.Error())
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf(errBpClientCreateSummary, ids[0]), err.Error())
+		return
+	}
+
+	// retrieve the link details by ID
+	apiData, err := bp.GetSubinterfaceLink(ctx, apstra.ObjectId(ids[1]))
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddError("Link not found", fmt.Sprintf("Link %s not found in blueprint %s", ids[1], ids[0]))
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to fetch link %s info", ids[1]), err.Error())
+		return
+	}
+
+	// Delete() restores the addressing found when the resource came under
+	// management. For an imported resource, that's the current addressing.
+	var privateInterfaceAddressing private.ResourceDatacenterIpLinkAddressingInterfaceAddressing
+	privateInterfaceAddressing.LoadApiData(ctx, apiData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	privateInterfaceAddressing.SetPrivateState(ctx, resp.Private, &resp.Diagnostics)
+}
+
 func (o *resourceDatacenterIpLinkAddressing) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan blueprint.IpLinkAddressing
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -301,7 +340,7 @@ func (o *resourceDatacenterIpLinkAddressing) Update(ctx context.Context, req res
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed to lock blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -366,7 +405,7 @@ func (o *resourceDatacenterIpLinkAddressing) Delete(ctx context.Context, req res
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed to lock blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -423,6 +462,6 @@ func (o *resourceDatacenterIpLinkAddressing) setBpClientFunc(f func(context.Cont
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterIpLinkAddressing) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterIpLinkAddressing) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -15,12 +15,13 @@ import (
 )
 
 var _ resource.ResourceWithConfigure = &resourceDatacenterPropertySet{}
+var _ resource.ResourceWithImportState = &resourceDatacenterPropertySet{}
 var _ resourceWithSetDcBpClientFunc = &resourceDatacenterPropertySet{}
 var _ resourceWithSetBpLockFunc = &resourceDatacenterPropertySet{}
 
 type resourceDatacenterPropertySet struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterPropertySet) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -86,7 +87,7 @@ func (o *resourceDatacenterPropertySet) Create(ctx context.Context, req resource
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -216,6 +217,10 @@ func (o *resourceDatacenterPropertySet) Read(ctx context.Context, req resource.R
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
+func (o *resourceDatacenterPropertySet) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterPropertySet) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
 	var plan blueprint.DatacenterPropertySet
@@ -261,7 +266,7 @@ func (o *resourceDatacenterPropertySet) Update(ctx context.Context, req resource
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -322,7 +327,7 @@ func (o *resourceDatacenterPropertySet) Delete(ctx context.Context, req resource
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -381,6 +386,6 @@ func (o *resourceDatacenterPropertySet) setBpClientFunc(f func(context.Context,
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterPropertySet) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterPropertySet) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -13,14 +13,19 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceDatacenterRack{}
-	_ resourceWithSetDcBpClientFunc  = &resourceDatacenterRack{}
-	_ resourceWithSetBpLockFunc      = &resourceDatacenterRack{}
+	_ resource.ResourceWithConfigure     = &resourceDatacenterRack{}
+	_ resource.ResourceWithImportState   = &resourceDatacenterRack{}
+	_ resourceWithSetDcBpClientFunc      = &resourceDatacenterRack{}
+	_ resourceWithSetBpLockFunc          = &resourceDatacenterRack{}
+	_ resourceWithSetBpDriftCheckFunc    = &resourceDatacenterRack{}
+	_ resourceWithSetBpDriftBaselineFunc = &resourceDatacenterRack{}
 )
 
 type resourceDatacenterRack struct {
-	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	getBpClientFunc   func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	lockFunc          blueprintLockFunc
+	driftCheckFunc    blueprintDriftCheckFunc
+	driftBaselineFunc blueprintDriftBaselineFunc
 }
 
 func (o *resourceDatacenterRack) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +63,7 @@ func (o *resourceDatacenterRack) Create(ctx context.Context, req resource.Create
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -103,6 +108,9 @@ func (o *resourceDatacenterRack) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	// set the state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -138,11 +146,45 @@ func (o *resourceDatacenterRack) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	// warn about changes staged outside of terraform
+	o.driftCheckFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), blueprint.Rack{}.DriftAttributePaths(), req.Private, &resp.Diagnostics)
+
 	// set state.
 	state.Name = types.StringValue(name)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterRack) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := parseImportId(req.ID, []string{"blueprint_id", "id"}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := blueprint.Rack{
+		BlueprintId: types.StringValue(ids[0]),
+		Id:          types.StringValue(ids[1]),
+	}
+
+	// get a client for the datacenter reference design
+	bp, err := o.getBpClientFunc(ctx, state.BlueprintId.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf(errBpNotFoundSummary, state.BlueprintId), err.Error())
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf(errBpClientCreateSummary, state.BlueprintId), err.Error())
+		return
+	}
+
+	// the rack type and pod IDs are not retrieved by Read()
+	state.GetImportDetails(ctx, bp.Client(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
 func (o *resourceDatacenterRack) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
 	var plan blueprint.Rack
@@ -168,6 +210,9 @@ func (o *resourceDatacenterRack) Update(ctx context.Context, req resource.Update
 	// update the name if necessary
 	if !plan.Name.Equal(state.Name) {
 		plan.SetName(ctx, "", bp.Client(), &resp.Diagnostics)
+
+		// record the node as written, so that our own changes aren't reported as drift
+		o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
 	}
 
 	// Set state
@@ -193,7 +238,7 @@ func (o *resourceDatacenterRack) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -217,6 +262,14 @@ func (o *resourceDatacenterRack) setBpClientFunc(f func(context.Context, string)
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterRack) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterRack) setBpDriftCheckFunc(f blueprintDriftCheckFunc) {
+	o.driftCheckFunc = f
+}
+
+func (o *resourceDatacenterRack) setBpDriftBaselineFunc(f blueprintDriftBaselineFunc) {
+	o.driftBaselineFunc = f
+}
+
+func (o *resourceDatacenterRack) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
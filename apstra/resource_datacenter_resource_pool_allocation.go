@@ -3,21 +3,24 @@ package tfapstra
 import (
 	"context"
 	"fmt"
+
 	"github.com/Juniper/apstra-go-sdk/apstra"
 	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.ResourceWithConfigure = &resourceResourcePoolAllocation{}
+var _ resource.ResourceWithImportState = &resourceResourcePoolAllocation{}
 var _ resourceWithSetDcBpClientFunc = &resourceResourcePoolAllocation{}
 var _ resourceWithSetBpLockFunc = &resourceResourcePoolAllocation{}
 
 type resourceResourcePoolAllocation struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceResourcePoolAllocation) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -63,7 +66,7 @@ func (o *resourceResourcePoolAllocation) Create(ctx context.Context, req resourc
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -135,6 +138,21 @@ func (o *resourceResourcePoolAllocation) Read(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceResourcePoolAllocation) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Allocations which are scoped to a routing zone (VNI and per-RZ IP pools)
+	// are imported as <blueprint_id>:<role>:<routing_zone_id>.
+	ids := parseImportIdWithOptional(req.ID, []string{"blueprint_id", "role"}, []string{"routing_zone_id"}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("blueprint_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), ids[1])...)
+	if ids[2] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("routing_zone_id"), ids[2])...)
+	}
+}
+
 func (o *resourceResourcePoolAllocation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
 	var plan blueprint.PoolAllocation
@@ -151,7 +169,7 @@ func (o *resourceResourcePoolAllocation) Update(ctx context.Context, req resourc
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -194,7 +212,7 @@ func (o *resourceResourcePoolAllocation) Delete(ctx context.Context, req resourc
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("failed locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -222,6 +240,6 @@ func (o *resourceResourcePoolAllocation) setBpClientFunc(f func(context.Context,
 	o.getBpClientFunc = f
 }
 
-func (o *resourceResourcePoolAllocation) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceResourcePoolAllocation) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -16,15 +16,20 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = &resourceDatacenterRoutingPolicy{}
+	_ resource.ResourceWithImportState    = &resourceDatacenterRoutingPolicy{}
 	_ resource.ResourceWithValidateConfig = &resourceDatacenterRoutingPolicy{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDatacenterRoutingPolicy{}
 	_ resourceWithSetBpLockFunc           = &resourceDatacenterRoutingPolicy{}
+	_ resourceWithSetBpDriftCheckFunc     = &resourceDatacenterRoutingPolicy{}
+	_ resourceWithSetBpDriftBaselineFunc  = &resourceDatacenterRoutingPolicy{}
 )
 
 type resourceDatacenterRoutingPolicy struct {
-	client          *apstra.Client
-	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	client            *apstra.Client
+	getBpClientFunc   func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	lockFunc          blueprintLockFunc
+	driftCheckFunc    blueprintDriftCheckFunc
+	driftBaselineFunc blueprintDriftBaselineFunc
 }
 
 func (o *resourceDatacenterRoutingPolicy) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -102,7 +107,7 @@ func (o *resourceDatacenterRoutingPolicy) Create(ctx context.Context, req resour
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -126,6 +131,9 @@ func (o *resourceDatacenterRoutingPolicy) Create(ctx context.Context, req resour
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -163,9 +171,16 @@ func (o *resourceDatacenterRoutingPolicy) Read(ctx context.Context, req resource
 		return
 	}
 
+	// warn about changes staged outside of terraform
+	o.driftCheckFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), blueprint.DatacenterRoutingPolicy{}.DriftAttributePaths(), req.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterRoutingPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterRoutingPolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan.
 	var plan blueprint.DatacenterRoutingPolicy
@@ -182,7 +197,7 @@ func (o *resourceDatacenterRoutingPolicy) Update(ctx context.Context, req resour
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -201,6 +216,9 @@ func (o *resourceDatacenterRoutingPolicy) Update(ctx context.Context, req resour
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -223,7 +241,7 @@ func (o *resourceDatacenterRoutingPolicy) Delete(ctx context.Context, req resour
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -245,10 +263,18 @@ func (o *resourceDatacenterRoutingPolicy) setBpClientFunc(f func(context.Context
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterRoutingPolicy) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterRoutingPolicy) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
 
+func (o *resourceDatacenterRoutingPolicy) setBpDriftCheckFunc(f blueprintDriftCheckFunc) {
+	o.driftCheckFunc = f
+}
+
+func (o *resourceDatacenterRoutingPolicy) setBpDriftBaselineFunc(f blueprintDriftBaselineFunc) {
+	o.driftBaselineFunc = f
+}
+
 func (o *resourceDatacenterRoutingPolicy) setClient(client *apstra.Client) {
 	o.client = client
 }
@@ -16,17 +16,22 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = &resourceDatacenterRoutingZone{}
+	_ resource.ResourceWithImportState    = &resourceDatacenterRoutingZone{}
 	_ resource.ResourceWithModifyPlan     = &resourceDatacenterRoutingZone{}
 	_ resource.ResourceWithValidateConfig = &resourceDatacenterRoutingZone{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDatacenterRoutingZone{}
 	_ resourceWithSetBpLockFunc           = &resourceDatacenterRoutingZone{}
+	_ resourceWithSetBpDriftCheckFunc     = &resourceDatacenterRoutingZone{}
+	_ resourceWithSetBpDriftBaselineFunc  = &resourceDatacenterRoutingZone{}
 	_ resourceWithSetClient               = &resourceDatacenterRoutingZone{}
 )
 
 type resourceDatacenterRoutingZone struct {
-	client          *apstra.Client
-	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	client            *apstra.Client
+	getBpClientFunc   func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	lockFunc          blueprintLockFunc
+	driftCheckFunc    blueprintDriftCheckFunc
+	driftBaselineFunc blueprintDriftBaselineFunc
 }
 
 func (o *resourceDatacenterRoutingZone) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -176,7 +181,7 @@ func (o *resourceDatacenterRoutingZone) Create(ctx context.Context, req resource
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -235,6 +240,9 @@ func (o *resourceDatacenterRoutingZone) Create(ctx context.Context, req resource
 		resp.Diagnostics.AddError("failed while fetching detail of just-created Routing Zone", err.Error())
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -279,10 +287,17 @@ func (o *resourceDatacenterRoutingZone) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	// warn about changes staged outside of terraform
+	o.driftCheckFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), blueprint.DatacenterRoutingZone{}.DriftAttributePaths(), req.Private, &resp.Diagnostics)
+
 	// set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
+func (o *resourceDatacenterRoutingZone) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterRoutingZone) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan.
 	var plan blueprint.DatacenterRoutingZone
@@ -306,7 +321,7 @@ func (o *resourceDatacenterRoutingZone) Update(ctx context.Context, req resource
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -363,6 +378,9 @@ func (o *resourceDatacenterRoutingZone) Update(ctx context.Context, req resource
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -385,7 +403,7 @@ func (o *resourceDatacenterRoutingZone) Delete(ctx context.Context, req resource
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -407,7 +425,15 @@ func (o *resourceDatacenterRoutingZone) setBpClientFunc(f func(context.Context,
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterRoutingZone) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterRoutingZone) setBpDriftCheckFunc(f blueprintDriftCheckFunc) {
+	o.driftCheckFunc = f
+}
+
+func (o *resourceDatacenterRoutingZone) setBpDriftBaselineFunc(f blueprintDriftBaselineFunc) {
+	o.driftBaselineFunc = f
+}
+
+func (o *resourceDatacenterRoutingZone) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
 
@@ -13,14 +13,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceDatacenterRoutingZoneConstraint{}
-	_ resourceWithSetDcBpClientFunc  = &resourceDatacenterRoutingZoneConstraint{}
-	_ resourceWithSetBpLockFunc      = &resourceDatacenterRoutingZoneConstraint{}
+	_ resource.ResourceWithConfigure   = &resourceDatacenterRoutingZoneConstraint{}
+	_ resource.ResourceWithImportState = &resourceDatacenterRoutingZoneConstraint{}
+	_ resourceWithSetDcBpClientFunc    = &resourceDatacenterRoutingZoneConstraint{}
+	_ resourceWithSetBpLockFunc        = &resourceDatacenterRoutingZoneConstraint{}
 )
 
 type resourceDatacenterRoutingZoneConstraint struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterRoutingZoneConstraint) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +59,7 @@ func (o *resourceDatacenterRoutingZoneConstraint) Create(ctx context.Context, re
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -125,6 +126,10 @@ func (o *resourceDatacenterRoutingZoneConstraint) Read(ctx context.Context, req
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterRoutingZoneConstraint) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceDatacenterRoutingZoneConstraint) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan.
 	var plan blueprint.DatacenterRoutingZoneConstraint
@@ -141,7 +146,7 @@ func (o *resourceDatacenterRoutingZoneConstraint) Update(ctx context.Context, re
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -184,7 +189,7 @@ func (o *resourceDatacenterRoutingZoneConstraint) Delete(ctx context.Context, re
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -206,6 +211,6 @@ func (o *resourceDatacenterRoutingZoneConstraint) setBpClientFunc(f func(context
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterRoutingZoneConstraint) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterRoutingZoneConstraint) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
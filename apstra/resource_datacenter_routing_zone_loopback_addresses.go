@@ -18,6 +18,7 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = (*resourceDatacenterRoutingZoneLoopbackAddresses)(nil)
+	_ resource.ResourceWithImportState    = (*resourceDatacenterRoutingZoneLoopbackAddresses)(nil)
 	_ resource.ResourceWithValidateConfig = (*resourceDatacenterRoutingZoneLoopbackAddresses)(nil)
 	_ resourceWithSetClient               = (*resourceDatacenterRoutingZoneLoopbackAddresses)(nil)
 	_ resourceWithSetDcBpClientFunc       = (*resourceDatacenterRoutingZoneLoopbackAddresses)(nil)
@@ -27,7 +28,7 @@ var (
 type resourceDatacenterRoutingZoneLoopbackAddresses struct {
 	client          *apstra.Client
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterRoutingZoneLoopbackAddresses) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,7 +90,7 @@ func (o *resourceDatacenterRoutingZoneLoopbackAddresses) Create(ctx context.Cont
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -155,6 +156,52 @@ func (o *resourceDatacenterRoutingZoneLoopbackAddresses) Read(ctx context.Contex
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceDatacenterRoutingZoneLoopbackAddresses) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := parseImportId(req.ID, []string{"blueprint_id", "routing_zone_id"}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := blueprint.RoutingZoneLoopbacks{
+		BlueprintId:   types.StringValue(ids[0]),
+		RoutingZoneId: types.StringValue(ids[1]),
+	}
+
+	// get a client for the datacenter reference design
+	bp, err := o.getBpClientFunc(ctx, state.BlueprintId.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf(errBpNotFoundSummary, state.BlueprintId), err.Error())
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf(errBpClientCreateSummary, state.BlueprintId), err.Error())
+		return
+	}
+
+	api, err := bp.GetSecurityZoneInfo(ctx, apstra.ObjectId(state.RoutingZoneId.ValueString()))
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddError("Routing Zone not found",
+				fmt.Sprintf("Blueprint %s Routing Zone %s not found", state.BlueprintId, state.RoutingZoneId))
+			return
+		}
+		resp.Diagnostics.AddError("failed to get security zone info", err.Error())
+		return
+	}
+
+	// record all currently assigned loopbacks to private state for use in Read()
+	ps := state.ImportPrivate(ctx, api, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ps.SetPrivateState(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
 func (o *resourceDatacenterRoutingZoneLoopbackAddresses) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan blueprint.RoutingZoneLoopbacks
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -174,7 +221,7 @@ func (o *resourceDatacenterRoutingZoneLoopbackAddresses) Update(ctx context.Cont
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -236,7 +283,7 @@ func (o *resourceDatacenterRoutingZoneLoopbackAddresses) Delete(ctx context.Cont
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -271,7 +318,7 @@ func (o *resourceDatacenterRoutingZoneLoopbackAddresses) setBpClientFunc(f func(
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterRoutingZoneLoopbackAddresses) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterRoutingZoneLoopbackAddresses) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
 
@@ -2,7 +2,6 @@ package tfapstra
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
@@ -20,14 +19,18 @@ var (
 	_ resource.ResourceWithImportState    = &resourceDatacenterSecurityPolicy{}
 	_ resource.ResourceWithValidateConfig = &resourceDatacenterSecurityPolicy{}
 	_ resourceWithSetBpLockFunc           = &resourceDatacenterSecurityPolicy{}
+	_ resourceWithSetBpDriftCheckFunc     = &resourceDatacenterSecurityPolicy{}
+	_ resourceWithSetBpDriftBaselineFunc  = &resourceDatacenterSecurityPolicy{}
 	_ resourceWithSetClient               = &resourceDatacenterSecurityPolicy{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDatacenterSecurityPolicy{}
 )
 
 type resourceDatacenterSecurityPolicy struct {
-	client          *apstra.Client
-	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	client            *apstra.Client
+	getBpClientFunc   func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	lockFunc          blueprintLockFunc
+	driftCheckFunc    blueprintDriftCheckFunc
+	driftBaselineFunc blueprintDriftBaselineFunc
 }
 
 func (o *resourceDatacenterSecurityPolicy) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -86,32 +89,16 @@ func (o *resourceDatacenterSecurityPolicy) ValidateConfig(ctx context.Context, r
 }
 
 func (o *resourceDatacenterSecurityPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var importId struct {
-		BlueprintId string `json:"blueprint_id"`
-		Id          string `json:"id"`
-	}
-
-	// parse the user-supplied import ID string JSON
-	err := json.Unmarshal([]byte(req.ID), &importId)
-	if err != nil {
-		resp.Diagnostics.AddError("failed parsing import id JSON string", err.Error())
-		return
-	}
-
-	if importId.BlueprintId == "" {
-		resp.Diagnostics.AddError(errImportJsonMissingRequiredField, "'blueprint_id' element of import ID string cannot be empty")
-		return
-	}
-
-	if importId.Id == "" {
-		resp.Diagnostics.AddError(errImportJsonMissingRequiredField, "'id' element of import ID string cannot be empty")
+	// parse the user-supplied import ID string
+	ids := parseImportId(req.ID, []string{"blueprint_id", "id"}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// create a state object preloaded with the critical details we need in advance
 	state := blueprint.DatacenterSecurityPolicy{
-		BlueprintId: types.StringValue(importId.BlueprintId),
-		Id:          types.StringValue(importId.Id),
+		BlueprintId: types.StringValue(ids[0]),
+		Id:          types.StringValue(ids[1]),
 	}
 
 	// get a client for the datacenter reference design
@@ -163,7 +150,7 @@ func (o *resourceDatacenterSecurityPolicy) Create(ctx context.Context, req resou
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -197,6 +184,9 @@ func (o *resourceDatacenterSecurityPolicy) Create(ctx context.Context, req resou
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	// set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -233,6 +223,9 @@ func (o *resourceDatacenterSecurityPolicy) Read(ctx context.Context, req resourc
 		return
 	}
 
+	// warn about changes staged outside of terraform
+	o.driftCheckFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), blueprint.DatacenterSecurityPolicy{}.DriftAttributePaths(), req.Private, &resp.Diagnostics)
+
 	// set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -253,7 +246,7 @@ func (o *resourceDatacenterSecurityPolicy) Update(ctx context.Context, req resou
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -283,6 +276,9 @@ func (o *resourceDatacenterSecurityPolicy) Update(ctx context.Context, req resou
 		return
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	// set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -306,7 +302,7 @@ func (o *resourceDatacenterSecurityPolicy) Delete(ctx context.Context, req resou
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -328,10 +324,18 @@ func (o *resourceDatacenterSecurityPolicy) setBpClientFunc(f func(context.Contex
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterSecurityPolicy) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterSecurityPolicy) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
 
+func (o *resourceDatacenterSecurityPolicy) setBpDriftCheckFunc(f blueprintDriftCheckFunc) {
+	o.driftCheckFunc = f
+}
+
+func (o *resourceDatacenterSecurityPolicy) setBpDriftBaselineFunc(f blueprintDriftBaselineFunc) {
+	o.driftBaselineFunc = f
+}
+
 func (o *resourceDatacenterSecurityPolicy) setClient(client *apstra.Client) {
 	o.client = client
 }
@@ -2,7 +2,6 @@ package tfapstra
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
@@ -30,7 +29,7 @@ var (
 type resourceDatacenterSwitchingZone struct {
 	client          *apstra.Client
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterSwitchingZone) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -130,32 +129,16 @@ func (o *resourceDatacenterSwitchingZone) ModifyPlan(ctx context.Context, req re
 }
 
 func (o *resourceDatacenterSwitchingZone) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	var importID struct {
-		BlueprintID string `json:"blueprint_id"`
-		ID          string `json:"id"`
-	}
-
-	// parse the user-supplied import ID string JSON
-	err := json.Unmarshal([]byte(req.ID), &importID)
-	if err != nil {
-		resp.Diagnostics.AddError("failed parsing import id JSON string", err.Error())
-		return
-	}
-
-	if importID.BlueprintID == "" {
-		resp.Diagnostics.AddError(errImportJsonMissingRequiredField, "'blueprint_id' element of import ID string cannot be empty")
-		return
-	}
-
-	if importID.ID == "" {
-		resp.Diagnostics.AddError(errImportJsonMissingRequiredField, "'id' element of import ID string cannot be empty")
+	// parse the user-supplied import ID string
+	ids := parseImportId(req.ID, []string{"blueprint_id", "id"}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// create a state object preloaded with the critical details we need in advance
 	state := blueprint.DatacenterSwitchingZone{
-		BlueprintID: types.StringValue(importID.BlueprintID),
-		ID:          types.StringValue(importID.ID),
+		BlueprintID: types.StringValue(ids[0]),
+		ID:          types.StringValue(ids[1]),
 	}
 
 	// get a client for the datacenter reference design
@@ -216,7 +199,7 @@ func (o *resourceDatacenterSwitchingZone) Create(ctx context.Context, req resour
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintID.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintID.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintID.ValueString()),
@@ -309,7 +292,7 @@ func (o *resourceDatacenterSwitchingZone) Update(ctx context.Context, req resour
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintID.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintID.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintID.ValueString()),
@@ -361,7 +344,7 @@ func (o *resourceDatacenterSwitchingZone) Delete(ctx context.Context, req resour
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintID.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintID.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintID.ValueString()),
@@ -383,7 +366,7 @@ func (o *resourceDatacenterSwitchingZone) setBpClientFunc(f func(context.Context
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterSwitchingZone) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterSwitchingZone) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
 	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
@@ -23,7 +24,7 @@ var (
 
 type resourceDatacenterTag struct {
 	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceDatacenterTag) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,11 +49,19 @@ func (o *resourceDatacenterTag) ImportState(ctx context.Context, req resource.Im
 		Name        string `json:"name"`
 	}
 
-	// parse the user-supplied import ID string JSON
-	err := json.Unmarshal([]byte(req.ID), &importId)
-	if err != nil {
-		resp.Diagnostics.AddError("failed parsing import id JSON string", err.Error())
-		return
+	// parse the user-supplied import ID string. The JSON form permits lookup by name.
+	if strings.HasPrefix(strings.TrimSpace(req.ID), "{") {
+		err := json.Unmarshal([]byte(req.ID), &importId)
+		if err != nil {
+			resp.Diagnostics.AddError("failed parsing import id JSON string", err.Error())
+			return
+		}
+	} else {
+		ids := parseImportId(req.ID, []string{"blueprint_id", "id"}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importId.BlueprintId, importId.Id = ids[0], ids[1]
 	}
 
 	if importId.BlueprintId == "" {
@@ -132,7 +141,7 @@ func (o *resourceDatacenterTag) Create(ctx context.Context, req resource.CreateR
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -238,7 +247,7 @@ func (o *resourceDatacenterTag) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -304,6 +313,6 @@ func (o *resourceDatacenterTag) setBpClientFunc(f func(context.Context, string)
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterTag) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterTag) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
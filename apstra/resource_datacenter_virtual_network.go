@@ -9,6 +9,7 @@ import (
 	"github.com/Juniper/apstra-go-sdk/datacenter"
 	"github.com/Juniper/terraform-provider-apstra/apstra/blueprint"
 	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	"github.com/Juniper/terraform-provider-apstra/apstra/private"
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,17 +20,22 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = &resourceDatacenterVirtualNetwork{}
+	_ resource.ResourceWithImportState    = &resourceDatacenterVirtualNetwork{}
 	_ resource.ResourceWithModifyPlan     = &resourceDatacenterVirtualNetwork{}
 	_ resource.ResourceWithValidateConfig = &resourceDatacenterVirtualNetwork{}
 	_ resourceWithSetDcBpClientFunc       = &resourceDatacenterVirtualNetwork{}
 	_ resourceWithSetBpLockFunc           = &resourceDatacenterVirtualNetwork{}
+	_ resourceWithSetBpDriftCheckFunc     = &resourceDatacenterVirtualNetwork{}
+	_ resourceWithSetBpDriftBaselineFunc  = &resourceDatacenterVirtualNetwork{}
 	_ resourceWithSetClient               = &resourceDatacenterVirtualNetwork{}
 )
 
 type resourceDatacenterVirtualNetwork struct {
-	client          *apstra.Client
-	getBpClientFunc func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
-	lockFunc        func(context.Context, string) error
+	client            *apstra.Client
+	getBpClientFunc   func(context.Context, string) (*apstra.TwoStageL3ClosClient, error)
+	lockFunc          blueprintLockFunc
+	driftCheckFunc    blueprintDriftCheckFunc
+	driftBaselineFunc blueprintDriftBaselineFunc
 }
 
 func (o *resourceDatacenterVirtualNetwork) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -201,7 +207,7 @@ func (o *resourceDatacenterVirtualNetwork) Create(ctx context.Context, req resou
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -314,6 +320,9 @@ func (o *resourceDatacenterVirtualNetwork) Create(ctx context.Context, req resou
 		state.DHCPEnabled = plan.DHCPEnabled
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	// set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -344,10 +353,19 @@ func (o *resourceDatacenterVirtualNetwork) Read(ctx context.Context, req resourc
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to fetch virtual network %s", state.Id), err.Error())
+		return
+	}
+
+	// a freshly imported resource has no prior bindings to compare against
+	var imported private.ResourceImported
+	imported.LoadPrivateState(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// record whether we're expecting to find VN bindings
-	bindingsShouldBeNull := state.Bindings.IsNull()
+	bindingsShouldBeNull := state.Bindings.IsNull() && !imported.Imported
 
 	// load the API response
 	state.LoadApiData(ctx, vn, &resp.Diagnostics)
@@ -357,10 +375,27 @@ func (o *resourceDatacenterVirtualNetwork) Read(ctx context.Context, req resourc
 		state.Bindings = types.MapNull(types.ObjectType{AttrTypes: blueprint.VnBinding{}.AttrTypes()})
 	}
 
+	if imported.Imported {
+		imported.Clear(ctx, resp.Private, &resp.Diagnostics)
+	}
+
+	// warn about changes staged outside of terraform
+	o.driftCheckFunc(ctx, state.BlueprintId.ValueString(), state.Id.ValueString(), blueprint.DatacenterVirtualNetwork{}.DriftAttributePaths(), req.Private, &resp.Diagnostics)
+
 	// set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
+func (o *resourceDatacenterVirtualNetwork) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// flag the import so that Read() populates bindings
+	private.ResourceImported{Imported: true}.SetPrivateState(ctx, resp.Private, &resp.Diagnostics)
+}
+
 func (o *resourceDatacenterVirtualNetwork) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan and state.
 	var plan blueprint.DatacenterVirtualNetwork
@@ -386,7 +421,7 @@ func (o *resourceDatacenterVirtualNetwork) Update(ctx context.Context, req resou
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -474,6 +509,9 @@ func (o *resourceDatacenterVirtualNetwork) Update(ctx context.Context, req resou
 		stateOut.HadPriorVNIConfig = plan.HadPriorVNIConfig
 	}
 
+	// record the node as written, so that our own changes aren't reported as drift
+	o.driftBaselineFunc(ctx, plan.BlueprintId.ValueString(), plan.Id.ValueString(), resp.Private, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateOut)...)
 }
 
@@ -496,7 +534,7 @@ func (o *resourceDatacenterVirtualNetwork) Delete(ctx context.Context, req resou
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -518,7 +556,15 @@ func (o *resourceDatacenterVirtualNetwork) setBpClientFunc(f func(context.Contex
 	o.getBpClientFunc = f
 }
 
-func (o *resourceDatacenterVirtualNetwork) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceDatacenterVirtualNetwork) setBpDriftCheckFunc(f blueprintDriftCheckFunc) {
+	o.driftCheckFunc = f
+}
+
+func (o *resourceDatacenterVirtualNetwork) setBpDriftBaselineFunc(f blueprintDriftBaselineFunc) {
+	o.driftBaselineFunc = f
+}
+
+func (o *resourceDatacenterVirtualNetwork) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
 
@@ -0,0 +1,135 @@
+package tfapstra
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	systemAgents "github.com/Juniper/terraform-provider-apstra/apstra/system_agents"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &resourceDeviceOsUpgrade{}
+	_ resource.ResourceWithValidateConfig = &resourceDeviceOsUpgrade{}
+	_ resourceWithSetClient               = &resourceDeviceOsUpgrade{}
+)
+
+type resourceDeviceOsUpgrade struct {
+	client *apstra.Client
+}
+
+func (o *resourceDeviceOsUpgrade) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_os_upgrade"
+}
+
+func (o *resourceDeviceOsUpgrade) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	configureResource(ctx, o, req, resp)
+}
+
+func (o *resourceDeviceOsUpgrade) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryDevices + "This resource upgrades the OS of a Managed Device using an " +
+			"OS image registered with Apstra. The upgrade is performed when the resource is created, and again " +
+			"whenever `image_id` changes. The provider waits for the upgrade job to finish, and fails if it " +
+			"does not succeed, in which case the upgrade is attempted again by the next apply. Destroying the " +
+			"resource does not change the software running on the device. Requires Apstra " +
+			compatibility.DeviceOsImagesOK.String() + ".",
+		Attributes: systemAgents.DeviceOsUpgrade{}.ResourceAttributes(),
+	}
+}
+
+func (o *resourceDeviceOsUpgrade) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// cannot proceed if the resource has not been configured
+	if o.client == nil {
+		return
+	}
+
+	compatibility.RequireApiVersion(o.client.ApiVersion(), compatibility.DeviceOsImagesOK, &resp.Diagnostics)
+}
+
+func (o *resourceDeviceOsUpgrade) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan systemAgents.DeviceOsUpgrade
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Upgrade(ctx, o.client, &resp.Diagnostics)
+	if plan.JobId.IsUnknown() {
+		return // the upgrade job was never started
+	}
+
+	// set state even if the upgrade failed so that the job can be located.
+	// Omit the image ID in that case so that the next apply tries again.
+	if resp.Diagnostics.HasError() {
+		plan.ImageId = types.StringNull()
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceDeviceOsUpgrade) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state systemAgents.DeviceOsUpgrade
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Refresh(ctx, o.client, &resp.Diagnostics) {
+		// system deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (o *resourceDeviceOsUpgrade) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and state values
+	var plan, state systemAgents.DeviceOsUpgrade
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// changes to skip_pre_checks and timeout_seconds alone don't warrant an upgrade
+	if plan.ImageId.Equal(state.ImageId) {
+		plan.AgentId = state.AgentId
+		plan.JobId = state.JobId
+		plan.JobState = state.JobState
+		plan.OsVersion = state.OsVersion
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	plan.Upgrade(ctx, o.client, &resp.Diagnostics)
+	if plan.JobId.IsUnknown() {
+		return // the upgrade job was never started
+	}
+
+	// set state even if the upgrade failed so that the job can be located.
+	// Keep the previous image ID in that case so that the next apply tries
+	// again.
+	if resp.Diagnostics.HasError() {
+		plan.ImageId = state.ImageId
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceDeviceOsUpgrade) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Nothing to do. An upgrade cannot be undone; the device keeps running
+	// the installed OS when the resource is removed from the terraform state.
+}
+
+func (o *resourceDeviceOsUpgrade) setClient(client *apstra.Client) {
+	o.client = client
+}
@@ -0,0 +1,219 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/design"
+	"github.com/Juniper/terraform-provider-apstra/apstra/device"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &resourceDeviceProfile{}
+	_ resource.ResourceWithImportState = &resourceDeviceProfile{}
+	_ resourceWithSetClient            = &resourceDeviceProfile{}
+)
+
+type resourceDeviceProfile struct {
+	client *apstra.Client
+}
+
+func (o *resourceDeviceProfile) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_profile"
+}
+
+func (o *resourceDeviceProfile) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	configureResource(ctx, o, req, resp)
+}
+
+func (o *resourceDeviceProfile) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryDevices + "This resource creates a Device Profile for a non-modular " +
+			"device, describing its hardware and software capabilities and the transformations (speed, breakout, " +
+			"etc.) supported by each of its ports. For chassis-based devices, see `apstra_modular_device_profile`.",
+		Attributes: device.Profile{}.ResourceAttributes(),
+	}
+}
+
+func (o *resourceDeviceProfile) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	apiData, err := o.client.GetDeviceProfile(ctx, req.ID)
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddError("Device Profile not found", fmt.Sprintf("Device Profile %q not found", req.ID))
+			return
+		}
+		resp.Diagnostics.AddError("error reading Device Profile", err.Error())
+		return
+	}
+
+	if device.IsModular(apiData) {
+		resp.Diagnostics.AddError(
+			"Device Profile is modular",
+			fmt.Sprintf("Device Profile %q describes a chassis - import it using the "+
+				"apstra_modular_device_profile resource", req.ID))
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (o *resourceDeviceProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan device.Profile
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.Request(ctx, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := o.client.CreateDeviceProfile(ctx, *request)
+	if err != nil {
+		resp.Diagnostics.AddError("error creating Device Profile", err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(id)
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceDeviceProfile) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state device.Profile
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// get device profile from api
+	apiData, err := o.client.GetDeviceProfile(ctx, state.Id.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			// resource deleted outside of terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error reading Device Profile", err.Error())
+		return
+	}
+
+	// modular device profiles are managed by a different resource
+	if device.IsModular(apiData) {
+		resp.Diagnostics.AddError(
+			"Device Profile is modular",
+			fmt.Sprintf("Device Profile %q describes a chassis - use the apstra_modular_device_profile "+
+				"resource to manage it", state.Id.ValueString()))
+		return
+	}
+
+	// load api data into state object
+	state.LoadApiData(ctx, apiData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (o *resourceDeviceProfile) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var plan device.Profile
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get state values
+	var state device.Profile
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// fetch the current device profile so that fields not modeled by the
+	// provider are sent back unchanged
+	apiData, err := o.client.GetDeviceProfile(ctx, plan.Id.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			resp.Diagnostics.AddError("Device Profile not found",
+				fmt.Sprintf("Device Profile %q was deleted outside of Terraform", plan.Id.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("error reading Device Profile", err.Error())
+		return
+	}
+
+	request := plan.Request(ctx, &apiData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// interface maps refer to ports of the device profile by ID
+	if !plan.Ports.Equal(state.Ports) {
+		o.warnInterfaceMaps(ctx, plan.Id.ValueString(), "updated", &resp.Diagnostics)
+	}
+
+	err = o.client.UpdateDeviceProfile(ctx, *request)
+	if err != nil {
+		resp.Diagnostics.AddError("error updating Device Profile", err.Error())
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceDeviceProfile) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state device.Profile
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := o.client.DeleteDeviceProfile(ctx, state.Id.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			return // 404 is okay
+		}
+		o.warnInterfaceMaps(ctx, state.Id.ValueString(), "deleted", &resp.Diagnostics)
+		resp.Diagnostics.AddError("error deleting Device Profile", err.Error())
+		return
+	}
+}
+
+// warnInterfaceMaps adds a warning to diags when the Device Profile is
+// referenced by any Interface Maps. These must be updated (or deleted) along
+// with the Device Profile.
+func (o *resourceDeviceProfile) warnInterfaceMaps(ctx context.Context, id string, action string, diags *diag.Diagnostics) {
+	ids, err := design.InterfaceMapIdsByDeviceProfile(ctx, o.client, id)
+	if err != nil {
+		diags.AddWarning("failed to determine Interface Maps which reference the Device Profile", err.Error())
+		return
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	diags.AddWarning(
+		"Device Profile is referenced by Interface Maps",
+		fmt.Sprintf("Device Profile %q is being %s, but is referenced by Interface Maps [%s], which may "+
+			"also need to be updated.", id, action, strings.Join(ids, ", ")))
+}
+
+func (o *resourceDeviceProfile) setClient(client *apstra.Client) {
+	o.client = client
+}
@@ -13,14 +13,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceFreeformAggregateLink{}
-	_ resourceWithSetFfBpClientFunc  = &resourceFreeformAggregateLink{}
-	_ resourceWithSetBpLockFunc      = &resourceFreeformAggregateLink{}
+	_ resource.ResourceWithConfigure   = &resourceFreeformAggregateLink{}
+	_ resource.ResourceWithImportState = &resourceFreeformAggregateLink{}
+	_ resourceWithSetFfBpClientFunc    = &resourceFreeformAggregateLink{}
+	_ resourceWithSetBpLockFunc        = &resourceFreeformAggregateLink{}
 )
 
 type resourceFreeformAggregateLink struct {
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o resourceFreeformAggregateLink) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +59,7 @@ func (o resourceFreeformAggregateLink) Create(ctx context.Context, req resource.
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintID.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintID.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed locking Blueprint %q mutex", plan.BlueprintID.ValueString()),
@@ -131,6 +132,10 @@ func (o resourceFreeformAggregateLink) Read(ctx context.Context, req resource.Re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o resourceFreeformAggregateLink) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o resourceFreeformAggregateLink) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
 	var plan freeform.AggregateLink
@@ -151,7 +156,7 @@ func (o resourceFreeformAggregateLink) Update(ctx context.Context, req resource.
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintID.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintID.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed locking Blueprint %q mutex", plan.BlueprintID.ValueString()),
@@ -200,7 +205,7 @@ func (o resourceFreeformAggregateLink) Delete(ctx context.Context, req resource.
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintID.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintID.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed locking Blueprint %q mutex", state.BlueprintID.ValueString()),
@@ -223,6 +228,6 @@ func (o *resourceFreeformAggregateLink) setBpClientFunc(f func(context.Context,
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformAggregateLink) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformAggregateLink) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -13,14 +13,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceFreeformAllocGroup{}
-	_ resourceWithSetFfBpClientFunc  = &resourceFreeformAllocGroup{}
-	_ resourceWithSetBpLockFunc      = &resourceFreeformAllocGroup{}
+	_ resource.ResourceWithConfigure   = &resourceFreeformAllocGroup{}
+	_ resource.ResourceWithImportState = &resourceFreeformAllocGroup{}
+	_ resourceWithSetFfBpClientFunc    = &resourceFreeformAllocGroup{}
+	_ resourceWithSetBpLockFunc        = &resourceFreeformAllocGroup{}
 )
 
 type resourceFreeformAllocGroup struct {
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceFreeformAllocGroup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +59,7 @@ func (o *resourceFreeformAllocGroup) Create(ctx context.Context, req resource.Cr
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -121,6 +122,10 @@ func (o *resourceFreeformAllocGroup) Read(ctx context.Context, req resource.Read
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceFreeformAllocGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceFreeformAllocGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
 	var plan freeform.AllocGroup
@@ -141,7 +146,7 @@ func (o *resourceFreeformAllocGroup) Update(ctx context.Context, req resource.Up
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -183,7 +188,7 @@ func (o *resourceFreeformAllocGroup) Delete(ctx context.Context, req resource.De
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -206,6 +211,6 @@ func (o *resourceFreeformAllocGroup) setBpClientFunc(f func(context.Context, str
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformAllocGroup) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformAllocGroup) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -13,17 +13,18 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceFreeformBlueprint{}
-	_ resourceWithSetClient          = &resourceFreeformBlueprint{}
-	_ resourceWithSetFfBpClientFunc  = &resourceFreeformBlueprint{}
-	_ resourceWithSetBpLockFunc      = &resourceFreeformBlueprint{}
-	_ resourceWithSetBpUnlockFunc    = &resourceFreeformBlueprint{}
+	_ resource.ResourceWithConfigure   = &resourceFreeformBlueprint{}
+	_ resource.ResourceWithImportState = &resourceFreeformBlueprint{}
+	_ resourceWithSetClient            = &resourceFreeformBlueprint{}
+	_ resourceWithSetFfBpClientFunc    = &resourceFreeformBlueprint{}
+	_ resourceWithSetBpLockFunc        = &resourceFreeformBlueprint{}
+	_ resourceWithSetBpUnlockFunc      = &resourceFreeformBlueprint{}
 )
 
 type resourceFreeformBlueprint struct {
 	client          *apstra.Client
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 	unlockFunc      func(context.Context, string) error
 }
 
@@ -97,6 +98,10 @@ func (o *resourceFreeformBlueprint) Read(ctx context.Context, req resource.ReadR
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceFreeformBlueprint) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "id")
+}
+
 // Update resource
 func (o *resourceFreeformBlueprint) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve plan.
@@ -162,7 +167,7 @@ func (o *resourceFreeformBlueprint) setBpClientFunc(f func(context.Context, stri
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformBlueprint) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformBlueprint) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
 
@@ -14,14 +14,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceFreeformConfigTemplate{}
-	_ resourceWithSetFfBpClientFunc  = &resourceFreeformConfigTemplate{}
-	_ resourceWithSetBpLockFunc      = &resourceFreeformConfigTemplate{}
+	_ resource.ResourceWithConfigure   = &resourceFreeformConfigTemplate{}
+	_ resource.ResourceWithImportState = &resourceFreeformConfigTemplate{}
+	_ resourceWithSetFfBpClientFunc    = &resourceFreeformConfigTemplate{}
+	_ resourceWithSetBpLockFunc        = &resourceFreeformConfigTemplate{}
 )
 
 type resourceFreeformConfigTemplate struct {
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceFreeformConfigTemplate) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,7 +60,7 @@ func (o *resourceFreeformConfigTemplate) Create(ctx context.Context, req resourc
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -159,6 +160,10 @@ func (o *resourceFreeformConfigTemplate) Read(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceFreeformConfigTemplate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceFreeformConfigTemplate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
 	var plan freeform.ConfigTemplate
@@ -186,7 +191,7 @@ func (o *resourceFreeformConfigTemplate) Update(ctx context.Context, req resourc
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -245,7 +250,7 @@ func (o *resourceFreeformConfigTemplate) Delete(ctx context.Context, req resourc
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -268,6 +273,6 @@ func (o *resourceFreeformConfigTemplate) setBpClientFunc(f func(context.Context,
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformConfigTemplate) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformConfigTemplate) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
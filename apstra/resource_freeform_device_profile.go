@@ -13,14 +13,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceFreeformDeviceProfile{}
-	_ resourceWithSetFfBpClientFunc  = &resourceFreeformDeviceProfile{}
-	_ resourceWithSetBpLockFunc      = &resourceFreeformDeviceProfile{}
+	_ resource.ResourceWithConfigure   = &resourceFreeformDeviceProfile{}
+	_ resource.ResourceWithImportState = &resourceFreeformDeviceProfile{}
+	_ resourceWithSetFfBpClientFunc    = &resourceFreeformDeviceProfile{}
+	_ resourceWithSetBpLockFunc        = &resourceFreeformDeviceProfile{}
 )
 
 type resourceFreeformDeviceProfile struct {
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceFreeformDeviceProfile) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +59,7 @@ func (o *resourceFreeformDeviceProfile) Create(ctx context.Context, req resource
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -109,6 +110,12 @@ func (o *resourceFreeformDeviceProfile) Read(ctx context.Context, req resource.R
 	}
 }
 
+func (o *resourceFreeformDeviceProfile) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The global catalog ID of the device profile cannot be recovered from the
+	// blueprint, so it is a required element of the import ID.
+	importStateById(ctx, req, resp, "blueprint_id", "id", "device_profile_id")
+}
+
 // Update will never run because all configurable attributes have RequiresReplace()
 func (o *resourceFreeformDeviceProfile) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
 }
@@ -131,7 +138,7 @@ func (o *resourceFreeformDeviceProfile) Delete(ctx context.Context, req resource
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -154,6 +161,6 @@ func (o *resourceFreeformDeviceProfile) setBpClientFunc(f func(context.Context,
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformDeviceProfile) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformDeviceProfile) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -13,14 +13,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceFreeformLink{}
-	_ resourceWithSetFfBpClientFunc  = &resourceFreeformLink{}
-	_ resourceWithSetBpLockFunc      = &resourceFreeformLink{}
+	_ resource.ResourceWithConfigure   = &resourceFreeformLink{}
+	_ resource.ResourceWithImportState = &resourceFreeformLink{}
+	_ resourceWithSetFfBpClientFunc    = &resourceFreeformLink{}
+	_ resourceWithSetBpLockFunc        = &resourceFreeformLink{}
 )
 
 type resourceFreeformLink struct {
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceFreeformLink) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +59,7 @@ func (o *resourceFreeformLink) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -130,6 +131,10 @@ func (o *resourceFreeformLink) Read(ctx context.Context, req resource.ReadReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceFreeformLink) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceFreeformLink) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
 	var plan freeform.Link
@@ -150,7 +155,7 @@ func (o *resourceFreeformLink) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -193,7 +198,7 @@ func (o *resourceFreeformLink) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -216,6 +221,6 @@ func (o *resourceFreeformLink) setBpClientFunc(f func(context.Context, string) (
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformLink) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformLink) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -13,14 +13,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceFreeformPropertySet{}
-	_ resourceWithSetFfBpClientFunc  = &resourceFreeformPropertySet{}
-	_ resourceWithSetBpLockFunc      = &resourceFreeformPropertySet{}
+	_ resource.ResourceWithConfigure   = &resourceFreeformPropertySet{}
+	_ resource.ResourceWithImportState = &resourceFreeformPropertySet{}
+	_ resourceWithSetFfBpClientFunc    = &resourceFreeformPropertySet{}
+	_ resourceWithSetBpLockFunc        = &resourceFreeformPropertySet{}
 )
 
 type resourceFreeformPropertySet struct {
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceFreeformPropertySet) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +59,7 @@ func (o *resourceFreeformPropertySet) Create(ctx context.Context, req resource.C
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -121,6 +122,10 @@ func (o *resourceFreeformPropertySet) Read(ctx context.Context, req resource.Rea
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceFreeformPropertySet) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceFreeformPropertySet) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
 	var plan freeform.PropertySet
@@ -141,7 +146,7 @@ func (o *resourceFreeformPropertySet) Update(ctx context.Context, req resource.U
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -183,7 +188,7 @@ func (o *resourceFreeformPropertySet) Delete(ctx context.Context, req resource.D
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -206,6 +211,6 @@ func (o *resourceFreeformPropertySet) setBpClientFunc(f func(context.Context, st
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformPropertySet) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformPropertySet) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
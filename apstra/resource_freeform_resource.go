@@ -20,6 +20,7 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = &resourceFreeformResource{}
+	_ resource.ResourceWithImportState    = &resourceFreeformResource{}
 	_ resource.ResourceWithValidateConfig = &resourceFreeformResource{}
 	_ resourceWithSetFfBpClientFunc       = &resourceFreeformResource{}
 	_ resourceWithSetBpLockFunc           = &resourceFreeformResource{}
@@ -27,7 +28,7 @@ var (
 
 type resourceFreeformResource struct {
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceFreeformResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -224,7 +225,7 @@ func (o *resourceFreeformResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -333,6 +334,10 @@ func (o *resourceFreeformResource) Read(ctx context.Context, req resource.ReadRe
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceFreeformResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceFreeformResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
 	var plan freeform.Resource
@@ -360,7 +365,7 @@ func (o *resourceFreeformResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -431,7 +436,7 @@ func (o *resourceFreeformResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -454,6 +459,6 @@ func (o *resourceFreeformResource) setBpClientFunc(f func(context.Context, strin
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformResource) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformResource) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -17,6 +17,7 @@ import (
 
 var (
 	_ resource.ResourceWithConfigure      = &resourceFreeformResourceGenerator{}
+	_ resource.ResourceWithImportState    = &resourceFreeformResourceGenerator{}
 	_ resource.ResourceWithValidateConfig = &resourceFreeformResourceGenerator{}
 	_ resourceWithSetFfBpClientFunc       = &resourceFreeformResourceGenerator{}
 	_ resourceWithSetBpLockFunc           = &resourceFreeformResourceGenerator{}
@@ -24,7 +25,7 @@ var (
 
 type resourceFreeformResourceGenerator struct {
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceFreeformResourceGenerator) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -90,7 +91,7 @@ func (o *resourceFreeformResourceGenerator) Create(ctx context.Context, req reso
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -154,6 +155,10 @@ func (o *resourceFreeformResourceGenerator) Read(ctx context.Context, req resour
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceFreeformResourceGenerator) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceFreeformResourceGenerator) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
 	var plan freeform.ResourceGenerator
@@ -174,7 +179,7 @@ func (o *resourceFreeformResourceGenerator) Update(ctx context.Context, req reso
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -217,7 +222,7 @@ func (o *resourceFreeformResourceGenerator) Delete(ctx context.Context, req reso
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -240,6 +245,6 @@ func (o *resourceFreeformResourceGenerator) setBpClientFunc(f func(context.Conte
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformResourceGenerator) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformResourceGenerator) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
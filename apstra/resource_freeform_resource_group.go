@@ -13,14 +13,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceFreeformResourceGroup{}
-	_ resourceWithSetFfBpClientFunc  = &resourceFreeformResourceGroup{}
-	_ resourceWithSetBpLockFunc      = &resourceFreeformResourceGroup{}
+	_ resource.ResourceWithConfigure   = &resourceFreeformResourceGroup{}
+	_ resource.ResourceWithImportState = &resourceFreeformResourceGroup{}
+	_ resourceWithSetFfBpClientFunc    = &resourceFreeformResourceGroup{}
+	_ resourceWithSetBpLockFunc        = &resourceFreeformResourceGroup{}
 )
 
 type resourceFreeformResourceGroup struct {
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceFreeformResourceGroup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +59,7 @@ func (o *resourceFreeformResourceGroup) Create(ctx context.Context, req resource
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -126,6 +127,10 @@ func (o *resourceFreeformResourceGroup) Read(ctx context.Context, req resource.R
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceFreeformResourceGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceFreeformResourceGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
 	var plan freeform.ResourceGroup
@@ -146,7 +151,7 @@ func (o *resourceFreeformResourceGroup) Update(ctx context.Context, req resource
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -192,7 +197,7 @@ func (o *resourceFreeformResourceGroup) Delete(ctx context.Context, req resource
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -215,6 +220,6 @@ func (o *resourceFreeformResourceGroup) setBpClientFunc(f func(context.Context,
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformResourceGroup) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformResourceGroup) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
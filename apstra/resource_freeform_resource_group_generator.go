@@ -13,14 +13,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceFreeformGroupGenerator{}
-	_ resourceWithSetFfBpClientFunc  = &resourceFreeformGroupGenerator{}
-	_ resourceWithSetBpLockFunc      = &resourceFreeformGroupGenerator{}
+	_ resource.ResourceWithConfigure   = &resourceFreeformGroupGenerator{}
+	_ resource.ResourceWithImportState = &resourceFreeformGroupGenerator{}
+	_ resourceWithSetFfBpClientFunc    = &resourceFreeformGroupGenerator{}
+	_ resourceWithSetBpLockFunc        = &resourceFreeformGroupGenerator{}
 )
 
 type resourceFreeformGroupGenerator struct {
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceFreeformGroupGenerator) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +59,7 @@ func (o *resourceFreeformGroupGenerator) Create(ctx context.Context, req resourc
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -121,6 +122,10 @@ func (o *resourceFreeformGroupGenerator) Read(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceFreeformGroupGenerator) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceFreeformGroupGenerator) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
 	var plan freeform.GroupGenerator
@@ -141,7 +146,7 @@ func (o *resourceFreeformGroupGenerator) Update(ctx context.Context, req resourc
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -184,7 +189,7 @@ func (o *resourceFreeformGroupGenerator) Delete(ctx context.Context, req resourc
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -207,6 +212,6 @@ func (o *resourceFreeformGroupGenerator) setBpClientFunc(f func(context.Context,
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformGroupGenerator) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformGroupGenerator) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -13,14 +13,15 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceFreeformSystem{}
-	_ resourceWithSetFfBpClientFunc  = &resourceFreeformSystem{}
-	_ resourceWithSetBpLockFunc      = &resourceFreeformSystem{}
+	_ resource.ResourceWithConfigure   = &resourceFreeformSystem{}
+	_ resource.ResourceWithImportState = &resourceFreeformSystem{}
+	_ resourceWithSetFfBpClientFunc    = &resourceFreeformSystem{}
+	_ resourceWithSetBpLockFunc        = &resourceFreeformSystem{}
 )
 
 type resourceFreeformSystem struct {
 	getBpClientFunc func(context.Context, string) (*apstra.FreeformClient, error)
-	lockFunc        func(context.Context, string) error
+	lockFunc        blueprintLockFunc
 }
 
 func (o *resourceFreeformSystem) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +59,7 @@ func (o *resourceFreeformSystem) Create(ctx context.Context, req resource.Create
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -121,6 +122,10 @@ func (o *resourceFreeformSystem) Read(ctx context.Context, req resource.ReadRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (o *resourceFreeformSystem) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateById(ctx, req, resp, "blueprint_id", "id")
+}
+
 func (o *resourceFreeformSystem) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
 	var plan freeform.System
@@ -141,7 +146,7 @@ func (o *resourceFreeformSystem) Update(ctx context.Context, req resource.Update
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, plan.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, plan.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", plan.BlueprintId.ValueString()),
@@ -183,7 +188,7 @@ func (o *resourceFreeformSystem) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Lock the blueprint mutex.
-	err = o.lockFunc(ctx, state.BlueprintId.ValueString())
+	err = o.lockFunc(ctx, state.BlueprintId.ValueString(), &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error locking blueprint %q mutex", state.BlueprintId.ValueString()),
@@ -206,6 +211,6 @@ func (o *resourceFreeformSystem) setBpClientFunc(f func(context.Context, string)
 	o.getBpClientFunc = f
 }
 
-func (o *resourceFreeformSystem) setBpLockFunc(f func(context.Context, string) error) {
+func (o *resourceFreeformSystem) setBpLockFunc(f blueprintLockFunc) {
 	o.lockFunc = f
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/Juniper/apstra-go-sdk/device"
 	"github.com/Juniper/apstra-go-sdk/enum"
 	"github.com/Juniper/apstra-go-sdk/speed"
+	"github.com/Juniper/terraform-provider-apstra/apstra/design"
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -30,9 +31,8 @@ import (
 )
 
 const (
-	ldInterfaceSep     = "/"
-	ldInterfaceExample = "1" + ldInterfaceSep + "2"
-	ldInterfaceSynax   = "<panel>" + ldInterfaceSep + "<port>"
+	ldInterfaceExample = "1" + design.InterfaceMapLdPortSep + "2"
+	ldInterfaceSynax   = "<panel>" + design.InterfaceMapLdPortSep + "<port>"
 )
 
 var (
@@ -68,9 +68,10 @@ func (o *resourceInterfaceMap) Schema(_ context.Context, _ resource.SchemaReques
 				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 			"device_profile_id": schema.StringAttribute{
-				MarkdownDescription: "ID of Device Profile to be mapped.",
-				Required:            true,
-				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				MarkdownDescription: "ID of Device Profile to be mapped. Either a predefined Device Profile or " +
+					"one created by the `apstra_device_profile` resource.",
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 			"logical_device_id": schema.StringAttribute{
 				MarkdownDescription: "ID of Logical Device to be mapped.",
@@ -78,9 +79,10 @@ func (o *resourceInterfaceMap) Schema(_ context.Context, _ resource.SchemaReques
 				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
 			"interfaces": schema.SetNestedAttribute{
-				MarkdownDescription: "Set of interface mapping info.",
-				Required:            true,
-				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+				MarkdownDescription: "Set of interface mapping info. The `apstra_interface_map_generator` data " +
+					"source can generate a complete set of mappings.",
+				Required:   true,
+				Validators: []validator.Set{setvalidator.SizeAtLeast(1)},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: rInterfaceMapInterface{}.attributes(),
 				},
@@ -678,7 +680,7 @@ type rInterfaceMapInterface struct {
 }
 
 func (o rInterfaceMapInterface) attributes() map[string]schema.Attribute {
-	ldpValidator := regexp.MustCompile("^[1-9][0-9]*" + ldInterfaceSep + "[1-9][0-9]*$")
+	ldpValidator := regexp.MustCompile("^[1-9][0-9]*" + design.InterfaceMapLdPortSep + "[1-9][0-9]*$")
 
 	return map[string]schema.Attribute{
 		"physical_interface_name": schema.StringAttribute{
@@ -745,12 +747,12 @@ func (o *rInterfaceMapInterface) loadApiData(_ context.Context, in *apstra.Inter
 	if in.Mapping.LDPanel == -1 || in.Mapping.LDPort == -1 { // "-1/-1" is the sign of an unallocated interface
 		o.LogicalDevicePort = types.StringNull()
 	} else {
-		o.LogicalDevicePort = types.StringValue(fmt.Sprintf("%d%s%d", in.Mapping.LDPanel, ldInterfaceSep, in.Mapping.LDPort))
+		o.LogicalDevicePort = types.StringValue(fmt.Sprintf("%d%s%d", in.Mapping.LDPanel, design.InterfaceMapLdPortSep, in.Mapping.LDPort))
 	}
 }
 
 func ldPanelAndPortFromString(in string, diags *diag.Diagnostics) (int, int) {
-	panelAndPort := strings.Split(in, ldInterfaceSep)
+	panelAndPort := strings.Split(in, design.InterfaceMapLdPortSep)
 	if len(panelAndPort) != 2 {
 		diags.AddError(errProviderBug,
 			fmt.Sprintf("error splitting interface name '%s'", in))
@@ -783,7 +785,7 @@ func getLogicalDevicePortInfo(ld *apstra.LogicalDevice) map[string]ldPortInfo {
 		nextPort := 1
 		for _, portGrp := range panel.PortGroups {
 			for i := 0; i < portGrp.Count; i++ {
-				result[fmt.Sprintf("%d%s%d", panelNum, ldInterfaceSep, nextPort)] = ldPortInfo{
+				result[fmt.Sprintf("%d%s%d", panelNum, design.InterfaceMapLdPortSep, nextPort)] = ldPortInfo{
 					Speed: portGrp.Speed,
 					Roles: portGrp.Roles,
 				}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
 	systemAgents "github.com/Juniper/terraform-provider-apstra/apstra/system_agents"
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,8 +16,9 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &resourceManagedDevice{}
-	_ resourceWithSetClient          = &resourceManagedDevice{}
+	_ resource.ResourceWithConfigure      = &resourceManagedDevice{}
+	_ resource.ResourceWithValidateConfig = &resourceManagedDevice{}
+	_ resourceWithSetClient               = &resourceManagedDevice{}
 )
 
 type resourceManagedDevice struct {
@@ -34,19 +37,58 @@ func (o *resourceManagedDevice) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: docCategoryDevices + "This resource creates/installs an Agent for an Apstra Managed Device. " +
 			"Optionally, it will 'Acknowledge' the discovered system if the `device key` (serial number)" +
-			"reported by the agent matches the optional `device_key` field.",
-		Attributes: systemAgents.ManagedDevice{}.ResourceAttributes(),
+			"reported by the agent matches the optional `device_key` field, or if `acknowledge` is `true`. " +
+			"The resource waits for the Agent to be installed and for the device to connect. Credentials may " +
+			"be supplied by the Agent Profile or, per device, through the write-only `username_wo` and " +
+			"`password_wo` attributes.",
+		Attributes: systemAgents.ManagedDeviceResource{}.ResourceAttributes(),
 	}
 }
 
+func (o *resourceManagedDevice) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// cannot proceed to config + api version validation if the provider has not been configured
+	if o.client == nil {
+		return
+	}
+
+	var config systemAgents.ManagedDeviceResource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiVersion, err := version.NewVersion(o.client.ApiVersion())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("cannot parse API version %q", o.client.ApiVersion()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(
+		compatibility.ValidateConfigConstraints(
+			ctx,
+			compatibility.ValidateConfigConstraintsRequest{
+				Version:     apiVersion,
+				Constraints: config.VersionConstraints(),
+			},
+		)...,
+	)
+}
+
 func (o *resourceManagedDevice) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan systemAgents.ManagedDevice
+	var plan systemAgents.ManagedDeviceResource
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Write-only values are available only in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("username_wo"), &plan.UsernameWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	plan.ValidateAgentProfile(ctx, o.client, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -67,46 +109,10 @@ func (o *resourceManagedDevice) Create(ctx context.Context, req resource.CreateR
 	}
 	plan.AgentId = types.StringValue(string(agentId))
 
-	// Install the new agent
-	_, err = o.client.SystemAgentRunJob(ctx, agentId, apstra.AgentJobTypeInstall)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Could not run %q job on new agent %q", apstra.AgentJobTypeInstall.String(), agentId),
-			err.Error())
-		return
-	}
-
-	// figure out the new switch system ID
-	agentInfo, err := o.client.GetSystemAgent(ctx, agentId)
-	if err != nil {
-		resp.Diagnostics.AddError("error fetching Agent info", err.Error())
-		return
-	}
-	plan.SystemId = types.StringValue(string(agentInfo.Status.SystemId))
-
-	if !plan.DeviceKey.IsNull() {
-		// figure out the actual serial number (device_key)
-		systemInfo, err := o.client.GetSystemInfo(ctx, agentInfo.Status.SystemId)
-		if err != nil {
-			resp.Diagnostics.AddError("error fetching system info", err.Error())
-			return
-		}
-
-		// validate discovered device_key (serial number)
-		if plan.DeviceKey.ValueString() == systemInfo.DeviceKey {
-			// "acknowledge" the managed device:q
-			plan.SetUserConfig(ctx, systemInfo, o.client, &resp.Diagnostics)
-		} else {
-			// device_key supplied by config does not match discovered asset
-			resp.Diagnostics.AddAttributeError(
-				path.Root("device_key"),
-				"error system device_key mismatch",
-				fmt.Sprintf("config expects switch device_key %q, device reports %q",
-					plan.DeviceKey.ValueString(), systemInfo.DeviceKey),
-			)
-			// do not return here -- the state needs to be set below
-		}
-	}
+	// Install the new agent, wait for the device to connect and acknowledge it
+	// if required. Errors are not fatal here: the state needs to be set below
+	// so that the new Agent is not orphaned.
+	plan.Onboard(ctx, o.client, &resp.Diagnostics)
 
 	// set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -117,7 +123,7 @@ func (o *resourceManagedDevice) Create(ctx context.Context, req resource.CreateR
 
 func (o *resourceManagedDevice) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state systemAgents.ManagedDevice
+	var state systemAgents.ManagedDeviceResource
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -137,7 +143,11 @@ func (o *resourceManagedDevice) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	var newState systemAgents.ManagedDevice
+	newState := systemAgents.ManagedDeviceResource{
+		CredentialsWoVersion: state.CredentialsWoVersion,
+		Acknowledge:          state.Acknowledge,
+		TimeoutSeconds:       state.TimeoutSeconds,
+	}
 	newState.LoadApiData(ctx, agentInfo, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -155,6 +165,17 @@ func (o *resourceManagedDevice) Read(ctx context.Context, req resource.ReadReque
 
 	newState.LoadUserConfig(ctx, systemInfo.UserConfig, &resp.Diagnostics)
 
+	// refresh the computed status attributes. A failure here leaves them
+	// null rather than failing the refresh.
+	err = newState.LoadStatus(ctx, o.client, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("failed reading status of Agent %q", state.AgentId.ValueString()), err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Device_key has 'requiresReplace()', so if it's not set in the state,
 	// then it's also not set in the config. Only fetch the serial number if
 	// the config is expecting a serial number.
@@ -172,19 +193,26 @@ func (o *resourceManagedDevice) Read(ctx context.Context, req resource.ReadReque
 // Update resource
 func (o *resourceManagedDevice) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get state values
-	var state systemAgents.ManagedDevice
+	var state systemAgents.ManagedDeviceResource
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Get plan values
-	var plan systemAgents.ManagedDevice
+	var plan systemAgents.ManagedDeviceResource
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Write-only values are available only in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("username_wo"), &plan.UsernameWo)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Check Agent Profile for credentials, etc...
 	plan.ValidateAgentProfile(ctx, o.client, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -207,6 +235,28 @@ func (o *resourceManagedDevice) Update(ctx context.Context, req resource.UpdateR
 		}
 	}
 
+	// send the per-device credentials only when the user has signaled a change
+	if !plan.CredentialsWoVersion.Equal(state.CredentialsWoVersion) && plan.HasCredentials() {
+		err := systemAgents.UpdateAgentCredentials(ctx, o.client, plan.AgentId.ValueString(),
+			plan.UsernameWo.ValueString(), plan.PasswordWo.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"error updating managed device agent credentials",
+				fmt.Sprintf("error while updating credentials of managed device agent %q (%s) - %s",
+					plan.AgentId.ValueString(), plan.ManagementIp.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	// acknowledge the System if newly requested
+	if plan.Acknowledge.ValueBool() && !state.Acknowledge.ValueBool() {
+		plan.Ack(ctx, o.client, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Location is one of only a handful of values permitted to change (others trigger replacement)
 	if !plan.Location.Equal(state.Location) {
 		// Get System info from Api
@@ -225,7 +275,17 @@ func (o *resourceManagedDevice) Update(ctx context.Context, req resource.UpdateR
 		}
 	}
 
-	// set state to match plan
+	// refresh the computed status attributes
+	err := plan.LoadStatus(ctx, o.client, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("failed reading status of Agent %q", plan.AgentId.ValueString()), err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -234,7 +294,7 @@ func (o *resourceManagedDevice) Update(ctx context.Context, req resource.UpdateR
 
 // Delete resource
 func (o *resourceManagedDevice) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state systemAgents.ManagedDevice
+	var state systemAgents.ManagedDeviceResource
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
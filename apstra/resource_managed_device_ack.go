@@ -35,6 +35,8 @@ func (o *resourceManagedDeviceAck) Schema(_ context.Context, _ resource.SchemaRe
 			"Any modification to the inputs of this resource will cause it " +
 			"to be removed from the Terraform state and recreated. Modifying " +
 			"or deleting this resource has no effect on Apstra.",
+		DeprecationMessage: "This resource will be removed in a future version. Please migrate to the " +
+			"`acknowledge` and `device_key` attributes of the `apstra_managed_device` resource.",
 		Attributes: systemAgents.SystemAck{}.ResourceAttributes(),
 	}
 }
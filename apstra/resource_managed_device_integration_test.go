@@ -0,0 +1,173 @@
+//go:build integration
+
+package tfapstra_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	tfapstra "github.com/Juniper/terraform-provider-apstra/apstra"
+	systemAgents "github.com/Juniper/terraform-provider-apstra/apstra/system_agents"
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+const resourceManagedDeviceHCL = `
+resource %q "test" {
+  name     = %q
+  platform = %q
+}
+
+resource %q "test" {
+  agent_profile_id       = %s.test.id
+  management_ip          = %q
+  acknowledge            = true
+  timeout_seconds        = 900
+  username_wo            = %q
+  password_wo            = %q
+  credentials_wo_version = %d
+}
+`
+
+const resourceManagedDeviceUnreachableHCL = `
+resource %q "test" {
+  name     = %q
+  platform = "junos"
+}
+
+resource %q "test" {
+  agent_profile_id = %s.test.id
+  management_ip    = "192.0.2.1" // TEST-NET-1: never reachable
+  timeout_seconds  = 300
+  username_wo      = %q
+  password_wo      = %q
+}
+`
+
+func TestResourceManagedDevice(t *testing.T) {
+	ctx := context.Background()
+
+	device := testutils.GetManagedDeviceConfig(t)
+	client := testutils.GetTestClient(t, ctx)
+
+	apType := tfapstra.ResourceName(ctx, &tfapstra.ResourceAgentProfile)
+	mdType := tfapstra.ResourceName(ctx, &tfapstra.ResourceManagedDevice)
+	mdName := mdType + ".test"
+	apName := acctest.RandString(6)
+
+	render := func(username, password string, version int) string {
+		return insecureProviderConfigHCL + fmt.Sprintf(resourceManagedDeviceHCL,
+			apType, apName, device.Platform,
+			mdType, apType, device.Ip, username, password, version,
+		)
+	}
+
+	var agentId string
+	extractAgentId := func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[mdName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", mdName)
+		}
+		agentId = rs.Primary.Attributes["agent_id"]
+		return nil
+	}
+
+	// acknowledge = true should have taken the System out of the
+	// "unacknowledged" list by giving it a user config
+	checkAcknowledged := func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[mdName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", mdName)
+		}
+
+		systemInfo, err := client.GetSystemInfo(ctx, apstra.SystemId(rs.Primary.Attributes["system_id"]))
+		if err != nil {
+			return err
+		}
+		if systemInfo.UserConfig.AdminState != apstra.SystemAdminStateNormal {
+			return fmt.Errorf("expected System admin state %q, got %q", apstra.SystemAdminStateNormal, systemInfo.UserConfig.AdminState)
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_11_0)},
+		Steps: []resource.TestStep{
+			{
+				Config: render(device.Username, device.Password, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(mdName, "agent_id"),
+					resource.TestCheckResourceAttrSet(mdName, "system_id"),
+					resource.TestCheckResourceAttr(mdName, "management_ip", device.Ip),
+					resource.TestCheckResourceAttr(mdName, "credentials_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(mdName, "username_wo"),
+					resource.TestCheckNoResourceAttr(mdName, "password_wo"),
+					resource.TestCheckResourceAttr(mdName, "agent_state", "connected"),
+					resource.TestCheckResourceAttrSet(mdName, "last_heartbeat"),
+					resource.TestCheckResourceAttr(mdName, "platform", device.Platform),
+					resource.TestCheckResourceAttrSet(mdName, "os_version"),
+					checkAcknowledged,
+					extractAgentId,
+				),
+			},
+			{
+				// break the Agent's credentials, then have the provider
+				// restore them by bumping credentials_wo_version
+				PreConfig: func() {
+					err := systemAgents.UpdateAgentCredentials(ctx, client, agentId, acctest.RandString(6), acctest.RandString(6))
+					require.NoError(t, err)
+				},
+				Config: render(device.Username, device.Password, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(mdName, "agent_id", func(v string) error {
+						if v != agentId {
+							return fmt.Errorf("expected agent_id %q, got %q", agentId, v)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(mdName, "credentials_wo_version", "2"),
+					resource.TestCheckNoResourceAttr(mdName, "username_wo"),
+					resource.TestCheckNoResourceAttr(mdName, "password_wo"),
+				),
+			},
+			{
+				// changed credentials without a new version are not a change
+				Config:   render(acctest.RandString(6), acctest.RandString(6), 2),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestResourceManagedDeviceUnreachable checks that a failed Agent
+// installation is reported along with the installation job log.
+func TestResourceManagedDeviceUnreachable(t *testing.T) {
+	ctx := context.Background()
+
+	testutils.GetTestClient(t, ctx)
+
+	apType := tfapstra.ResourceName(ctx, &tfapstra.ResourceAgentProfile)
+	mdType := tfapstra.ResourceName(ctx, &tfapstra.ResourceManagedDevice)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_11_0)},
+		Steps: []resource.TestStep{
+			{
+				Config: insecureProviderConfigHCL + fmt.Sprintf(resourceManagedDeviceUnreachableHCL,
+					apType, acctest.RandString(6),
+					mdType, apType, acctest.RandString(6), acctest.RandString(6),
+				),
+				ExpectError: regexp.MustCompile(`(?s)Agent "[^"]+" \(192\.0\.2\.1\) did not (succeed|connect).*Log of Agent job`),
+			},
+		},
+	})
+}
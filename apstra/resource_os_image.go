@@ -0,0 +1,165 @@
+package tfapstra
+
+import (
+	"context"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	systemAgents "github.com/Juniper/terraform-provider-apstra/apstra/system_agents"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &resourceOsImage{}
+	_ resource.ResourceWithValidateConfig = &resourceOsImage{}
+	_ resource.ResourceWithImportState    = &resourceOsImage{}
+	_ resourceWithSetClient               = &resourceOsImage{}
+)
+
+type resourceOsImage struct {
+	client *apstra.Client
+}
+
+func (o *resourceOsImage) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_os_image"
+}
+
+func (o *resourceOsImage) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	configureResource(ctx, o, req, resp)
+}
+
+func (o *resourceOsImage) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryDevices + "This resource registers a device OS image hosted on an " +
+			"external server. Registered images can be installed on Managed Devices with the " +
+			"`apstra_device_os_upgrade` resource. Requires Apstra " +
+			compatibility.DeviceOsImagesOK.String() + ".",
+		Attributes: systemAgents.OsImage{}.ResourceAttributes(),
+	}
+}
+
+func (o *resourceOsImage) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// cannot proceed if the resource has not been configured
+	if o.client == nil {
+		return
+	}
+
+	compatibility.RequireApiVersion(o.client.ApiVersion(), compatibility.DeviceOsImagesOK, &resp.Diagnostics)
+}
+
+func (o *resourceOsImage) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (o *resourceOsImage) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan systemAgents.OsImage
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.Request(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := systemAgents.CreateOsImage(ctx, o.client, request)
+	if err != nil {
+		resp.Diagnostics.AddError("error registering OS image", err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(id)
+
+	// fetch the image to learn the computed image name
+	apiData, err := systemAgents.GetOsImage(ctx, o.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading newly registered OS image", err.Error())
+		return
+	}
+
+	plan.LoadApiData(ctx, apiData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceOsImage) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state systemAgents.OsImage
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiData, err := systemAgents.GetOsImage(ctx, o.client, state.Id.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			// resource deleted outside of terraform
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error reading OS image", err.Error())
+		return
+	}
+
+	state.LoadApiData(ctx, apiData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (o *resourceOsImage) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var plan systemAgents.OsImage
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.Request(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := systemAgents.UpdateOsImage(ctx, o.client, plan.Id.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError("error updating OS image", err.Error())
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (o *resourceOsImage) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state systemAgents.OsImage
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := systemAgents.DeleteOsImage(ctx, o.client, state.Id.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			return // 404 is okay
+		}
+		resp.Diagnostics.AddError("error deleting OS image", err.Error())
+		return
+	}
+}
+
+func (o *resourceOsImage) setClient(client *apstra.Client) {
+	o.client = client
+}
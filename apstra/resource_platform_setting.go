@@ -0,0 +1,281 @@
+package tfapstra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
+	"github.com/Juniper/terraform-provider-apstra/apstra/platform"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &resourcePlatformSetting[platform.DnsData]{}
+	_ resource.ResourceWithValidateConfig = &resourcePlatformSetting[platform.DnsData]{}
+	_ resource.ResourceWithImportState    = &resourcePlatformSetting[platform.DnsData]{}
+	_ resourceWithSetClient               = &resourcePlatformSetting[platform.DnsData]{}
+)
+
+// resourcePlatformSetting implements the singleton apstra_platform_*
+// resources, which differ only in their model, the API functions used to read
+// and write the setting, and (for SMTP) a write-only secret. D is the API
+// representation of the setting.
+type resourcePlatformSetting[D any] struct {
+	client *apstra.Client
+
+	typeName    string                                            // resource type name without the provider prefix
+	id          string                                            // fixed resource ID, also the import ID
+	label       string                                            // setting name in messages, e.g. "DNS configuration"
+	secretName  string                                            // write-only attribute name without the "_wo" suffix, if any
+	description string                                            // schema description following the doc category
+	partial     bool                                              // only the configured parts of the setting are managed
+	newModel    func() platform.Setting[D]                        // returns a pointer to an empty model
+	get         func(context.Context, *apstra.Client) (*D, error) // reads the setting
+	set         func(context.Context, *apstra.Client, *D) error   // writes the setting
+}
+
+func newResourcePlatformDns() resource.Resource {
+	return &resourcePlatformSetting[platform.DnsData]{
+		typeName:    "_platform_dns",
+		id:          platform.DnsId,
+		label:       "DNS configuration",
+		description: "This resource manages the DNS resolver configuration of the Apstra server itself.",
+		newModel:    func() platform.Setting[platform.DnsData] { return new(platform.Dns) },
+		get:         platform.GetDns,
+		set:         platform.SetDns,
+	}
+}
+
+func newResourcePlatformFeatureFlags() resource.Resource {
+	return &resourcePlatformSetting[platform.FeatureFlagsData]{
+		typeName: "_platform_feature_flags",
+		id:       platform.FeatureFlagsId,
+		label:    "feature flags",
+		description: "This resource manages feature flags of the Apstra server. Only the flags named in the " +
+			"configuration are changed, and they are left in their current state when the resource is destroyed.",
+		partial:  true,
+		newModel: func() platform.Setting[platform.FeatureFlagsData] { return new(platform.FeatureFlags) },
+		get:      platform.GetFeatureFlags,
+		set:      platform.SetFeatureFlags,
+	}
+}
+
+func newResourcePlatformNtp() resource.Resource {
+	return &resourcePlatformSetting[platform.NtpData]{
+		typeName:    "_platform_ntp",
+		id:          platform.NtpId,
+		label:       "NTP configuration",
+		description: "This resource manages the NTP servers used by the Apstra server itself.",
+		newModel:    func() platform.Setting[platform.NtpData] { return new(platform.Ntp) },
+		get:         platform.GetNtp,
+		set:         platform.SetNtp,
+	}
+}
+
+func newResourcePlatformSessionTimeouts() resource.Resource {
+	return &resourcePlatformSetting[platform.SessionTimeoutsData]{
+		typeName:    "_platform_session_timeouts",
+		id:          platform.SessionTimeoutsId,
+		label:       "session timeout configuration",
+		description: "This resource manages the API and web UI session timeouts of the Apstra server.",
+		newModel:    func() platform.Setting[platform.SessionTimeoutsData] { return new(platform.SessionTimeouts) },
+		get:         platform.GetSessionTimeouts,
+		set:         platform.SetSessionTimeouts,
+	}
+}
+
+func newResourcePlatformSmtp() resource.Resource {
+	return &resourcePlatformSetting[platform.SmtpData]{
+		typeName:   "_platform_smtp",
+		id:         platform.SmtpId,
+		label:      "SMTP configuration",
+		secretName: "password",
+		description: "This resource manages the mail server used by the Apstra server to send alert " +
+			"notifications. The mail server password is accepted only through the write-only `password_wo` " +
+			"attribute, so it never appears in the Terraform plan or state.",
+		newModel: func() platform.Setting[platform.SmtpData] { return new(platform.Smtp) },
+		get:      platform.GetSmtp,
+		set:      platform.SetSmtp,
+	}
+}
+
+func newResourcePlatformSyslog() resource.Resource {
+	return &resourcePlatformSetting[platform.SyslogData]{
+		typeName:    "_platform_syslog",
+		id:          platform.SyslogId,
+		label:       "syslog configuration",
+		description: "This resource manages the syslog servers to which the Apstra server forwards its own logs.",
+		newModel:    func() platform.Setting[platform.SyslogData] { return new(platform.Syslog) },
+		get:         platform.GetSyslog,
+		set:         platform.SetSyslog,
+	}
+}
+
+func (o *resourcePlatformSetting[D]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + o.typeName
+}
+
+func (o *resourcePlatformSetting[D]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	configureResource(ctx, o, req, resp)
+}
+
+func (o *resourcePlatformSetting[D]) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := o.description
+	if !o.partial {
+		description += fmt.Sprintf(" There is only one %s per Apstra server: the configuration is overwritten "+
+			"when the resource is created, and left in place when it is destroyed.", o.label)
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: docCategoryPlatform + description + " Requires Apstra " +
+			compatibility.PlatformSettingsOK.String() + ".",
+		Attributes: o.newModel().ResourceAttributes(),
+	}
+}
+
+func (o *resourcePlatformSetting[D]) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// cannot proceed if the resource has not been configured
+	if o.client == nil {
+		return
+	}
+
+	compatibility.RequireApiVersion(o.client.ApiVersion(), compatibility.PlatformSettingsOK, &resp.Diagnostics)
+}
+
+func (o *resourcePlatformSetting[D]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateSingleton(ctx, req, resp, o.id)
+}
+
+func (o *resourcePlatformSetting[D]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	plan := o.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are available only in the configuration
+	var secret types.String
+	if o.secretName != "" {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(o.secretName+"_wo"), &secret)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	request := plan.Request(ctx, secret.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := o.set(ctx, o.client, request)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error setting %s", o.label), err.Error())
+		return
+	}
+
+	// read the setting back so that the state reflects what Apstra stored
+	apiData, err := o.get(ctx, o.client)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error reading %s", o.label), err.Error())
+		return
+	}
+
+	plan.LoadApiData(ctx, apiData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (o *resourcePlatformSetting[D]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	state := o.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiData, err := o.get(ctx, o.client)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error reading %s", o.label), err.Error())
+		return
+	}
+
+	state.LoadApiData(ctx, apiData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (o *resourcePlatformSetting[D]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	plan := o.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// send the secret only when the user has signaled a change. Apstra retains
+	// the previous value when the secret is omitted.
+	var secret types.String
+	if o.secretName != "" {
+		var planVersion, stateVersion types.Int64
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(o.secretName+"_wo_version"), &planVersion)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(o.secretName+"_wo_version"), &stateVersion)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !planVersion.Equal(stateVersion) {
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(o.secretName+"_wo"), &secret)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	request := plan.Request(ctx, secret.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := o.set(ctx, o.client, request)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error updating %s", o.label), err.Error())
+		return
+	}
+
+	// read the setting back so that the state reflects what Apstra stored
+	apiData, err := o.get(ctx, o.client)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error reading %s", o.label), err.Error())
+		return
+	}
+
+	plan.LoadApiData(ctx, apiData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (o *resourcePlatformSetting[D]) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Nothing to do. Apstra settings cannot be deleted, so they are left
+	// as-is when the resource is removed from the terraform state.
+}
+
+func (o *resourcePlatformSetting[D]) setClient(client *apstra.Client) {
+	o.client = client
+}
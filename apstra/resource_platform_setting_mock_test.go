@@ -0,0 +1,270 @@
+package tfapstra_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/Juniper/terraform-provider-apstra/apstra/test_utils/mockapstra"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// checkMockPlatformSetting returns a check which compares the platform
+// setting stored by the mock server at path with expected.
+func checkMockPlatformSetting(srv *mockapstra.Server, path string, expected map[string]any) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		setting, ok := srv.PlatformSetting(path)
+		if !ok {
+			return fmt.Errorf("platform setting %q not found on mock server", path)
+		}
+
+		// compare JSON renderings so that numeric types don't matter
+		e, _ := json.Marshal(expected)
+		a, _ := json.Marshal(setting)
+		if string(e) != string(a) {
+			return fmt.Errorf("expected platform setting %q to be %s, got %s", path, e, a)
+		}
+		return nil
+	}
+}
+
+// TestResourcePlatformSettingsMock creates each singleton apstra_platform_*
+// resource (other than apstra_platform_smtp), checks for drift after the
+// setting is changed behind terraform's back, and updates it.
+func TestResourcePlatformSettingsMock(t *testing.T) {
+	type testCase struct {
+		resourceType string
+		apiPath      string
+		configA      string
+		settingA     map[string]any
+		drift        map[string]any
+		configB      string
+		settingB     map[string]any
+	}
+
+	testCases := map[string]testCase{
+		"dns": {
+			resourceType: "apstra_platform_dns",
+			apiPath:      "/api/platform/dns",
+			configA:      `nameservers = ["192.0.2.1"]`,
+			settingA:     map[string]any{"nameservers": []string{"192.0.2.1"}, "search_domains": []string{}},
+			drift:        map[string]any{"nameservers": []string{"192.0.2.9"}, "search_domains": []string{}},
+			configB: `nameservers    = ["192.0.2.1", "192.0.2.2"]
+  search_domains = ["example.com"]`,
+			settingB: map[string]any{"nameservers": []string{"192.0.2.1", "192.0.2.2"}, "search_domains": []string{"example.com"}},
+		},
+		"feature_flags": {
+			resourceType: "apstra_platform_feature_flags",
+			apiPath:      "/api/platform/feature-flags",
+			configA:      `flags = { a = true }`,
+			settingA: map[string]any{"feature_flags": []map[string]any{
+				{"enabled": true, "name": "a"},
+			}},
+			drift: map[string]any{"feature_flags": []map[string]any{
+				{"enabled": false, "name": "a"},
+			}},
+			configB: `flags = { a = false, b = true }`,
+			settingB: map[string]any{"feature_flags": []map[string]any{
+				{"enabled": false, "name": "a"},
+				{"enabled": true, "name": "b"},
+			}},
+		},
+		"ntp": {
+			resourceType: "apstra_platform_ntp",
+			apiPath:      "/api/platform/ntp",
+			configA:      `servers = ["ntp1.example.com"]`,
+			settingA:     map[string]any{"servers": []string{"ntp1.example.com"}},
+			drift:        map[string]any{"servers": []string{"ntp9.example.com"}},
+			configB:      `servers = ["ntp2.example.com", "ntp1.example.com"]`,
+			settingB:     map[string]any{"servers": []string{"ntp2.example.com", "ntp1.example.com"}},
+		},
+		"session_timeouts": {
+			resourceType: "apstra_platform_session_timeouts",
+			apiPath:      "/api/platform/session-timeouts",
+			configA: `api_timeout_minutes = 60
+  ui_timeout_minutes  = 30`,
+			settingA: map[string]any{"api_timeout": 60, "ui_timeout": 30},
+			drift:    map[string]any{"api_timeout": 60, "ui_timeout": 5},
+			configB: `api_timeout_minutes = 120
+  ui_timeout_minutes  = 30`,
+			settingB: map[string]any{"api_timeout": 120, "ui_timeout": 30},
+		},
+		"syslog": {
+			resourceType: "apstra_platform_syslog",
+			apiPath:      "/api/platform/syslog",
+			configA:      `receivers = [{ address = "192.0.2.1", port = 514, protocol = "udp" }]`,
+			settingA: map[string]any{"facility": "local0", "receivers": []map[string]any{
+				{"address": "192.0.2.1", "port": 514, "protocol": "udp"},
+			}},
+			drift: map[string]any{"facility": "local0", "receivers": []map[string]any{
+				{"address": "192.0.2.9", "port": 514, "protocol": "udp"},
+			}},
+			configB: `facility  = "local7"
+  receivers = [{ address = "192.0.2.1", port = 1514, protocol = "tcp" }]`,
+			settingB: map[string]any{"facility": "local7", "receivers": []map[string]any{
+				{"address": "192.0.2.1", "port": 1514, "protocol": "tcp"},
+			}},
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			srv := testutils.MockApstra(t)
+
+			config := func(body string) string {
+				return mockProviderConfigHCL + fmt.Sprintf("resource %q \"test\" {\n  %s\n}\n", tCase.resourceType, body)
+			}
+			rName := tCase.resourceType + ".test"
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config(tCase.configA),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttrSet(rName, "id"),
+							checkMockPlatformSetting(srv, tCase.apiPath, tCase.settingA),
+						),
+					},
+					{
+						// a change made outside of terraform is detected by Read
+						PreConfig:          func() { srv.SetPlatformSetting(tCase.apiPath, tCase.drift) },
+						Config:             config(tCase.configA),
+						PlanOnly:           true,
+						ExpectNonEmptyPlan: true,
+					},
+					{
+						// ... and reverted by the next apply
+						Config: config(tCase.configA),
+						Check:  checkMockPlatformSetting(srv, tCase.apiPath, tCase.settingA),
+					},
+					{
+						Config: config(tCase.configB),
+						Check:  checkMockPlatformSetting(srv, tCase.apiPath, tCase.settingB),
+					},
+					{
+						ResourceName:      rName,
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
+				},
+			})
+		})
+	}
+}
+
+const resourcePlatformSmtpMockHCL = `
+resource "apstra_platform_smtp" "test" {
+  host                = %q
+  username            = "apstra"
+  password_wo         = %q
+  password_wo_version = %d
+  sender              = "apstra@example.com"
+}
+`
+
+// TestResourcePlatformSmtpMock checks that the SMTP password is sent when the
+// resource is created and when password_wo_version changes, and is otherwise
+// left unchanged by updates.
+func TestResourcePlatformSmtpMock(t *testing.T) {
+	srv := testutils.MockApstra(t)
+
+	const apiPath = "/api/platform/smtp"
+
+	checkPassword := func(expected string) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			password, _ := srv.SmtpPassword()
+			if password != expected {
+				return fmt.Errorf("expected SMTP password %q, got %q", expected, password)
+			}
+			return nil
+		}
+	}
+
+	setting := func(host string) map[string]any {
+		return map[string]any{
+			"host":       host,
+			"port":       587,
+			"recipients": []string{},
+			"security":   "starttls",
+			"sender":     "apstra@example.com",
+			"username":   "apstra",
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_11_0)},
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourcePlatformSmtpMockHCL, "a.example.com", "p1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("apstra_platform_smtp.test", "password_wo"),
+					checkMockPlatformSetting(srv, apiPath, setting("a.example.com")),
+					checkPassword("p1"),
+				),
+			},
+			{
+				// a change made outside of terraform is detected by Read
+				PreConfig:          func() { srv.SetPlatformSetting(apiPath, setting("z.example.com")) },
+				Config:             mockProviderConfigHCL + fmt.Sprintf(resourcePlatformSmtpMockHCL, "a.example.com", "p1", 1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// the password is not sent unless its version changes
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourcePlatformSmtpMockHCL, "b.example.com", "p2", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkMockPlatformSetting(srv, apiPath, setting("b.example.com")),
+					checkPassword("p1"),
+				),
+			},
+			{
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourcePlatformSmtpMockHCL, "b.example.com", "p2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apstra_platform_smtp.test", "password_wo_version", "2"),
+					checkMockPlatformSetting(srv, apiPath, setting("b.example.com")),
+					checkPassword("p2"),
+				),
+			},
+		},
+	})
+}
+
+// TestResourcePlatformFeatureFlagsPartialMock checks that flags not named in
+// the configuration are neither changed nor loaded into state.
+func TestResourcePlatformFeatureFlagsPartialMock(t *testing.T) {
+	srv := testutils.MockApstra(t)
+
+	const apiPath = "/api/platform/feature-flags"
+	const rName = "apstra_platform_feature_flags.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					srv.SetPlatformSetting(apiPath, map[string]any{"feature_flags": []any{
+						map[string]any{"enabled": false, "name": "a"},
+						map[string]any{"enabled": true, "name": "z"},
+					}})
+				},
+				Config: mockProviderConfigHCL + `resource "apstra_platform_feature_flags" "test" {
+  flags = { a = true }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "flags.%", "1"),
+					resource.TestCheckResourceAttr(rName, "flags.a", "true"),
+					checkMockPlatformSetting(srv, apiPath, map[string]any{"feature_flags": []map[string]any{
+						{"enabled": true, "name": "a"},
+						{"enabled": true, "name": "z"},
+					}}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package tfapstra_test
+
+import (
+	"fmt"
+	"testing"
+
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const resourceRoleMockHCL = `
+resource "apstra_role" "test" {
+  name        = %q
+  description = %s
+  permissions = [%s]
+}
+`
+
+// TestResourceRoleMock checks that a role's label and permissions are written
+// to Apstra on create and update, and survive import.
+func TestResourceRoleMock(t *testing.T) {
+	srv := testutils.MockApstra(t)
+	role := &mockServerObject{srv: srv, resourceName: "apstra_role.test", collection: "/api/aaa/roles"}
+
+	checkServerState := func(label string, permissions int) resource.TestCheckFunc {
+		return role.Check(func(_ string, obj map[string]any) error {
+			if obj["label"] != label {
+				return fmt.Errorf("expected role label %q, got %q", label, obj["label"])
+			}
+			if l := len(obj["permissions"].([]any)); l != permissions {
+				return fmt.Errorf("expected %d permissions, got %d", permissions, l)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourceRoleMockHCL, "a", `"first role"`, `"blueprint.view"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apstra_role.test", "id"),
+					resource.TestCheckResourceAttr("apstra_role.test", "name", "a"),
+					resource.TestCheckResourceAttr("apstra_role.test", "description", "first role"),
+					resource.TestCheckResourceAttr("apstra_role.test", "permissions.#", "1"),
+					checkServerState("a", 1),
+				),
+			},
+			{
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourceRoleMockHCL, "b", "null", `"blueprint.view", "device.view"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apstra_role.test", "name", "b"),
+					resource.TestCheckNoResourceAttr("apstra_role.test", "description"),
+					resource.TestCheckResourceAttr("apstra_role.test", "permissions.#", "2"),
+					checkServerState("b", 2),
+				),
+			},
+			{
+				ResourceName:      "apstra_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: role.CheckDestroy,
+	})
+}
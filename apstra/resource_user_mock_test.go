@@ -0,0 +1,83 @@
+package tfapstra_test
+
+import (
+	"fmt"
+	"testing"
+
+	testutils "github.com/Juniper/terraform-provider-apstra/apstra/test_utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const resourceUserMockHCL = `
+resource "apstra_user" "test" {
+  username            = "test_user"
+  email               = %q
+  role_ids            = ["administrator"]
+  password_wo         = %q
+  password_wo_version = %d
+}
+`
+
+// TestResourceUserMock checks that the write-only password of apstra_user is
+// sent only when password_wo_version changes.
+func TestResourceUserMock(t *testing.T) {
+	srv := testutils.MockApstra(t)
+	user := &mockServerObject{srv: srv, resourceName: "apstra_user.test", collection: "/api/aaa/users"}
+
+	checkServerState := func(email, password string) resource.TestCheckFunc {
+		return user.Check(func(id string, obj map[string]any) error {
+			if obj["email"] != email {
+				return fmt.Errorf("expected user email %q, got %q", email, obj["email"])
+			}
+
+			p, ok := srv.UserPassword(id)
+			if !ok {
+				return fmt.Errorf("user %q has no password on mock server", id)
+			}
+			if p != password {
+				return fmt.Errorf("expected user password %q, got %q", password, p)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testMockProtoV6ProviderFactories,
+		TerraformVersionChecks:   []tfversion.TerraformVersionCheck{tfversion.SkipBelow(tfversion.Version1_11_0)},
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourceUserMockHCL, "a@example.com", "password1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apstra_user.test", "id"),
+					resource.TestCheckResourceAttr("apstra_user.test", "email", "a@example.com"),
+					resource.TestCheckNoResourceAttr("apstra_user.test", "password_wo"),
+					checkServerState("a@example.com", "password1"),
+				),
+			},
+			{
+				// a new password without a new version is not sent
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourceUserMockHCL, "b@example.com", "password2", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apstra_user.test", "email", "b@example.com"),
+					checkServerState("b@example.com", "password1"),
+				),
+			},
+			{
+				// bumping the version sends the password
+				Config: mockProviderConfigHCL + fmt.Sprintf(resourceUserMockHCL, "b@example.com", "password2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apstra_user.test", "password_wo_version", "2"),
+					checkServerState("b@example.com", "password2"),
+				),
+			},
+			{
+				ResourceName:            "apstra_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_wo_version"},
+			},
+		},
+		CheckDestroy: user.CheckDestroy,
+	})
+}
@@ -0,0 +1,285 @@
+package systemAgents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	agentJobPollInterval          = 10 * time.Second
+	deviceOsUpgradeDefaultTimeout = 3600
+	deviceOsUpgradeMinimumTimeout = 60
+)
+
+type DeviceOsUpgrade struct {
+	SystemId       types.String `tfsdk:"system_id"`
+	ImageId        types.String `tfsdk:"image_id"`
+	SkipPreChecks  types.Bool   `tfsdk:"skip_pre_checks"`
+	TimeoutSeconds types.Int64  `tfsdk:"timeout_seconds"`
+	AgentId        types.String `tfsdk:"agent_id"`
+	JobId          types.String `tfsdk:"job_id"`
+	JobState       types.String `tfsdk:"job_state"`
+	OsVersion      types.String `tfsdk:"os_version"`
+}
+
+func (o DeviceOsUpgrade) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"system_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the System to be upgraded. Use the `system_id` attribute of " +
+				"an `apstra_managed_device` resource.",
+			Required:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:    []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"image_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the OS image to install. Use the `id` attribute of an " +
+				"`apstra_os_image` resource. Changing this value upgrades the System again.",
+			Required:   true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"skip_pre_checks": resourceSchema.BoolAttribute{
+			MarkdownDescription: "Before starting the upgrade, the provider checks that the OS image exists, " +
+				"that its `platform` matches the OS family reported by the System, and that no other job is " +
+				"running on the System's Agent. Set `true` to skip these checks.",
+			Optional: true,
+		},
+		"timeout_seconds": resourceSchema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("Number of seconds to wait for the upgrade job to finish. An "+
+				"error is produced if the job fails or has not finished within the allotted time. Default: `%d`",
+				deviceOsUpgradeDefaultTimeout),
+			Optional:   true,
+			Computed:   true,
+			Default:    int64default.StaticInt64(deviceOsUpgradeDefaultTimeout),
+			Validators: []validator.Int64{int64validator.AtLeast(deviceOsUpgradeMinimumTimeout)},
+		},
+		"agent_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the Agent which manages the System.",
+			Computed:            true,
+		},
+		"job_id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the Agent job which performed the most recent upgrade.",
+			Computed:            true,
+		},
+		"job_state": resourceSchema.StringAttribute{
+			MarkdownDescription: "State of the Agent job which performed the most recent upgrade.",
+			Computed:            true,
+		},
+		"os_version": resourceSchema.StringAttribute{
+			MarkdownDescription: "OS version reported by the System.",
+			Computed:            true,
+		},
+	}
+}
+
+// Upgrade performs the pre-checks (unless disabled), starts the upgrade job
+// and waits for it to finish. The computed attributes are populated whenever
+// possible, even if the upgrade fails, so that the job can be located.
+func (o *DeviceOsUpgrade) Upgrade(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	agent := o.findAgent(ctx, client, diags)
+	if diags.HasError() {
+		return
+	}
+	o.AgentId = types.StringValue(string(agent.Id))
+
+	if !o.SkipPreChecks.ValueBool() {
+		o.preCheck(ctx, client, diags)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	agentId := apstra.ObjectId(o.AgentId.ValueString())
+	jobId, err := StartOsUpgrade(ctx, client, agentId, o.ImageId.ValueString())
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed starting OS upgrade of System %q", o.SystemId.ValueString()), err.Error())
+		return
+	}
+	o.JobId = types.StringValue(jobIdString(jobId))
+	o.JobState = types.StringNull()
+
+	timeout := time.Duration(o.TimeoutSeconds.ValueInt64()) * time.Second
+	job, err := waitForAgentJob(ctx, client, agentId, jobId, timeout)
+	if job != nil {
+		o.JobState = types.StringValue(job.State.String())
+	}
+	if err != nil {
+		diags.AddError(fmt.Sprintf("OS upgrade of System %q did not succeed", o.SystemId.ValueString()), err.Error())
+	}
+
+	o.loadOsVersion(ctx, client, diags)
+	if o.OsVersion.IsUnknown() {
+		o.OsVersion = types.StringNull()
+	}
+}
+
+// Refresh reloads the computed attributes. It returns false if the System no
+// longer exists.
+func (o *DeviceOsUpgrade) Refresh(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) bool {
+	systemInfo, err := client.GetSystemInfo(ctx, apstra.SystemId(o.SystemId.ValueString()))
+	if err != nil {
+		if utils.IsApstra404(err) {
+			return false
+		}
+		diags.AddError(fmt.Sprintf("failed reading System %q", o.SystemId.ValueString()), err.Error())
+		return true
+	}
+
+	if !o.AgentId.IsNull() && !o.JobId.IsNull() {
+		jobId, err := parseJobId(o.JobId.ValueString())
+		if err != nil {
+			diags.AddError(fmt.Sprintf("failed parsing Agent job ID %q", o.JobId.ValueString()), err.Error())
+			return true
+		}
+
+		job, err := GetAgentJob(ctx, client, apstra.ObjectId(o.AgentId.ValueString()), jobId)
+		switch {
+		case err == nil && job != nil:
+			o.JobState = types.StringValue(job.State.String())
+		case err == nil || utils.IsApstra404(err):
+			// the job (or the agent) may legitimately have been removed since
+			// the upgrade. Keep the last known state.
+		default:
+			diags.AddError(fmt.Sprintf("failed reading Agent job %q", o.JobId.ValueString()), err.Error())
+			return true
+		}
+	}
+
+	o.OsVersion = value.StringOrNull(ctx, systemInfo.Facts.OsVersion, diags)
+	return true
+}
+
+// findAgent returns the system agent which manages the System.
+func (o *DeviceOsUpgrade) findAgent(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) *apstra.SystemAgent {
+	agents, err := client.GetAllSystemAgents(ctx)
+	if err != nil {
+		diags.AddError("failed reading System Agents", err.Error())
+		return nil
+	}
+
+	for _, agent := range agents {
+		if string(agent.Status.SystemId) == o.SystemId.ValueString() {
+			return &agent
+		}
+	}
+
+	diags.AddAttributeError(path.Root("system_id"), "System Agent not found",
+		fmt.Sprintf("no Agent manages System %q", o.SystemId.ValueString()))
+	return nil
+}
+
+func (o *DeviceOsUpgrade) preCheck(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	image, err := GetOsImage(ctx, client, o.ImageId.ValueString())
+	if err != nil {
+		if utils.IsApstra404(err) {
+			diags.AddAttributeError(path.Root("image_id"), "OS image not found",
+				fmt.Sprintf("OS image %q does not exist", o.ImageId.ValueString()))
+			return
+		}
+		diags.AddError(fmt.Sprintf("failed reading OS image %q", o.ImageId.ValueString()), err.Error())
+		return
+	}
+
+	systemInfo, err := client.GetSystemInfo(ctx, apstra.SystemId(o.SystemId.ValueString()))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed reading System %q", o.SystemId.ValueString()), err.Error())
+		return
+	}
+
+	if !osFamilyMatchesPlatform(systemInfo.Facts.OsFamily, image.Platform) {
+		diags.AddAttributeError(path.Root("image_id"), "OS image platform mismatch",
+			fmt.Sprintf("System %q reports OS family %q, but OS image %q has platform %q",
+				o.SystemId.ValueString(), systemInfo.Facts.OsFamily, image.Id, image.Platform))
+	}
+
+	jobs, err := client.GetSystemAgentJobHistory(ctx, apstra.ObjectId(o.AgentId.ValueString()))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed reading jobs of Agent %q", o.AgentId.ValueString()), err.Error())
+		return
+	}
+
+	for _, job := range jobs {
+		if !agentJobDone(job) {
+			diags.AddError("Agent busy",
+				fmt.Sprintf("Agent %q is running %q job %d (state %q); try again once it has finished",
+					o.AgentId.ValueString(), job.JobType, job.JobId, job.State))
+		}
+	}
+}
+
+func (o *DeviceOsUpgrade) loadOsVersion(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	systemInfo, err := client.GetSystemInfo(ctx, apstra.SystemId(o.SystemId.ValueString()))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed reading System %q", o.SystemId.ValueString()), err.Error())
+		return
+	}
+
+	o.OsVersion = value.StringOrNull(ctx, systemInfo.Facts.OsVersion, diags)
+}
+
+// osFamilyMatchesPlatform determines whether an OS image with the given
+// platform may be installed on a device reporting the given OS family. The
+// OS family reported by devices varies in case and may carry a suffix (e.g.
+// "Junos-EVO"), so only the prefix is compared.
+func osFamilyMatchesPlatform(osFamily, platform string) bool {
+	return platform != "" && strings.HasPrefix(strings.ToLower(osFamily), strings.ToLower(platform))
+}
+
+// waitForAgentJob polls the specified agent job until it finishes. It returns
+// the most recently fetched job along with an error if the job failed or did
+// not finish before timeout elapsed.
+func waitForAgentJob(ctx context.Context, client *apstra.Client, agentId apstra.ObjectId, jobId apstra.JobId, timeout time.Duration) (*apstra.AgentJobStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(agentJobPollInterval)
+	defer ticker.Stop()
+
+	var job *apstra.AgentJobStatus
+	for {
+		latest, err := GetAgentJob(ctx, client, agentId, jobId)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return job, fmt.Errorf("job %d not finished after %s", jobId, timeout)
+			}
+			return job, err
+		}
+
+		// a new job may not appear in the history right away
+		if latest != nil {
+			job = latest
+
+			switch job.State {
+			case apstra.AgentJobStateSuccess:
+				return job, nil
+			case apstra.AgentJobStateFailed:
+				return job, fmt.Errorf("job %d failed: %s", jobId, job.Error)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if job == nil {
+				return nil, fmt.Errorf("job %d not found after %s", jobId, timeout)
+			}
+			return job, fmt.Errorf("job %d not finished after %s: state %q", jobId, timeout, job.State)
+		case <-ticker.C:
+		}
+	}
+}
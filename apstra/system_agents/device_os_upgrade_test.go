@@ -0,0 +1,32 @@
+package systemAgents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOsFamilyMatchesPlatform(t *testing.T) {
+	type testCase struct {
+		osFamily string
+		platform string
+		expected bool
+	}
+
+	testCases := map[string]testCase{
+		"junos":           {osFamily: "Junos", platform: "junos", expected: true},
+		"junos_evo":       {osFamily: "Junos-EVO", platform: "junos", expected: true},
+		"eos":             {osFamily: "EOS", platform: "eos", expected: true},
+		"sonic":           {osFamily: "SONiC", platform: "sonic", expected: true},
+		"mismatch":        {osFamily: "EOS", platform: "junos", expected: false},
+		"empty_os_family": {osFamily: "", platform: "junos", expected: false},
+		"empty_platform":  {osFamily: "Junos", platform: "", expected: false},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tCase.expected, osFamilyMatchesPlatform(tCase.osFamily, tCase.platform))
+		})
+	}
+}
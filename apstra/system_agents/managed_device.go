@@ -2,13 +2,19 @@ package systemAgents
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/compatibility"
 	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
 	apstravalidator "github.com/Juniper/terraform-provider-apstra/apstra/validator"
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -22,6 +28,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const (
+	managedDeviceDefaultTimeout = 600
+	managedDeviceMinimumTimeout = 60
+)
+
 type ManagedDevice struct {
 	AgentId        types.String `tfsdk:"agent_id"`
 	SystemId       types.String `tfsdk:"system_id"`
@@ -32,6 +43,22 @@ type ManagedDevice struct {
 	Location       types.String `tfsdk:"location"`
 }
 
+// ManagedDeviceResource is the model of the apstra_managed_device resource.
+// It extends ManagedDevice, which is shared with data sources, with attributes
+// which exist only in the resource.
+type ManagedDeviceResource struct {
+	ManagedDevice
+	UsernameWo           types.String `tfsdk:"username_wo"`
+	PasswordWo           types.String `tfsdk:"password_wo"`
+	CredentialsWoVersion types.Int64  `tfsdk:"credentials_wo_version"`
+	Acknowledge          types.Bool   `tfsdk:"acknowledge"`
+	TimeoutSeconds       types.Int64  `tfsdk:"timeout_seconds"`
+	OsVersion            types.String `tfsdk:"os_version"`
+	Platform             types.String `tfsdk:"platform"`
+	AgentState           types.String `tfsdk:"agent_state"`
+	LastHeartbeat        types.String `tfsdk:"last_heartbeat"`
+}
+
 func (o ManagedDevice) DataSourceAttributes() map[string]dataSourceSchema.Attribute {
 	return map[string]dataSourceSchema.Attribute{
 		"agent_id": dataSourceSchema.StringAttribute{
@@ -159,13 +186,114 @@ func (o ManagedDevice) ResourceAttributes() map[string]resourceSchema.Attribute
 	}
 }
 
-func (o *ManagedDevice) Request(_ context.Context, _ *diag.Diagnostics) *apstra.SystemAgentRequest {
+func (o ManagedDeviceResource) ResourceAttributes() map[string]resourceSchema.Attribute {
+	result := o.ManagedDevice.ResourceAttributes()
+	result["username_wo"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Username used by the Agent to log in to the device, overriding the credentials " +
+			"of the Agent Profile. This attribute is write-only: its value is sent to Apstra when the Agent is " +
+			"created (and whenever `credentials_wo_version` changes), but is never saved in the Terraform plan " +
+			"or state. The value may be supplied by an ephemeral resource. Requires Terraform 1.11 or later.",
+		Optional:  true,
+		WriteOnly: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.AlsoRequires(path.MatchRoot("password_wo")),
+		},
+	}
+	result["password_wo"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Password used with `username_wo`, overriding the credentials of the Agent " +
+			"Profile. This attribute is write-only: its value is sent to Apstra when the Agent is created (and " +
+			"whenever `credentials_wo_version` changes), but is never saved in the Terraform plan or state. The " +
+			"value may be supplied by an ephemeral resource. Requires Terraform 1.11 or later.",
+		Optional:  true,
+		WriteOnly: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.AlsoRequires(path.MatchRoot("username_wo")),
+		},
+	}
+	result["credentials_wo_version"] = resourceSchema.Int64Attribute{
+		MarkdownDescription: utils.WriteOnlyVersionDescription("username_wo", "password_wo") +
+			" Requires both `username_wo` and `password_wo`. Setting this attribute requires Apstra " +
+			compatibility.AgentCredentialsUpdateOK.String() + ".",
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+			int64validator.AlsoRequires(path.MatchRoot("username_wo"), path.MatchRoot("password_wo")),
+		},
+	}
+	result["acknowledge"] = resourceSchema.BoolAttribute{
+		MarkdownDescription: "When `true`, the System discovered by the Agent is *acknowledged*, even when " +
+			"`device_key` is omitted. Systems are always acknowledged when `device_key` is set and matches the " +
+			"key reported by the device. Acknowledgement cannot be undone by this resource. This attribute " +
+			"supersedes the `apstra_managed_device_ack` resource.",
+		Optional: true,
+	}
+	result["timeout_seconds"] = resourceSchema.Int64Attribute{
+		MarkdownDescription: fmt.Sprintf("Number of seconds to wait for the Agent to be installed and for "+
+			"the device to connect. An error including the installation job log is produced if installation "+
+			"fails or the device has not connected within the allotted time. Default: `%d`",
+			managedDeviceDefaultTimeout),
+		Optional:   true,
+		Validators: []validator.Int64{int64validator.AtLeast(managedDeviceMinimumTimeout)},
+	}
+	result["os_version"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "OS version reported by the System.",
+		Computed:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	result["platform"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Platform reported by the Agent.",
+		Computed:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	result["agent_state"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Connection state of the Agent, e.g. `connected`.",
+		Computed:            true,
+	}
+	result["last_heartbeat"] = resourceSchema.StringAttribute{
+		MarkdownDescription: "Time of the most recent heartbeat received from the Agent. Requires Apstra " +
+			compatibility.AgentHeartbeatOK.String() + "; always `null` with earlier versions.",
+		Computed: true,
+	}
+	return result
+}
+
+// Request returns the API request used to create the Agent. UsernameWo and
+// PasswordWo must have been loaded from the configuration by the caller
+// because write-only values are not available in the plan.
+func (o *ManagedDeviceResource) Request(_ context.Context, _ *diag.Diagnostics) *apstra.SystemAgentRequest {
 	return &apstra.SystemAgentRequest{
 		AgentTypeOffbox: apstra.AgentTypeOffbox(o.OffBox.ValueBool()),
 		ManagementIp:    o.ManagementIp.ValueString(),
 		Profile:         apstra.ObjectId(o.AgentProfileId.ValueString()),
 		OperationMode:   apstra.SystemManagementLevelFullControl,
+		Username:        o.UsernameWo.ValueString(),
+		Password:        o.PasswordWo.ValueString(),
+	}
+}
+
+// HasCredentials returns true when per-device credentials have been loaded
+// into the write-only attributes.
+func (o *ManagedDeviceResource) HasCredentials() bool {
+	return !o.UsernameWo.IsNull() && !o.PasswordWo.IsNull()
+}
+
+// VersionConstraints returns the Apstra versions required by the configuration.
+// Credentials are replaced by calling the API directly (see
+// UpdateAgentCredentials), so credentials_wo_version requires the version
+// whose API that request was written against.
+func (o ManagedDeviceResource) VersionConstraints() compatibility.ConfigConstraints {
+	var response compatibility.ConfigConstraints
+
+	if utils.HasValue(o.CredentialsWoVersion) {
+		response.AddAttributeConstraints(compatibility.AttributeConstraint{
+			Path:        path.Root("credentials_wo_version"),
+			Constraints: compatibility.AgentCredentialsUpdateOK,
+		})
 	}
+
+	return response
 }
 
 func (o *ManagedDevice) LoadApiData(_ context.Context, in *apstra.SystemAgent, _ *diag.Diagnostics) {
@@ -183,7 +311,7 @@ func (o *ManagedDevice) LoadUserConfig(_ context.Context, in apstra.SystemUserCo
 	}
 }
 
-func (o *ManagedDevice) ValidateAgentProfile(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+func (o *ManagedDeviceResource) ValidateAgentProfile(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
 	agentProfile, err := client.GetAgentProfile(ctx, apstra.ObjectId(o.AgentProfileId.ValueString()))
 	if err != nil {
 		if utils.IsApstra404(err) {
@@ -196,12 +324,13 @@ func (o *ManagedDevice) ValidateAgentProfile(ctx context.Context, client *apstra
 		return
 	}
 
-	// require credentials (we can't automate login otherwise)
-	if !agentProfile.HasCredentials() {
+	// require credentials (we can't automate login otherwise) unless the device has its own
+	if !agentProfile.HasCredentials() && !o.HasCredentials() {
 		diags.AddAttributeError(
 			path.Root("agent_profile_id"),
 			"Agent Profile needs credentials",
-			fmt.Sprintf("selected agent_profile_id %q (%s) must have credentials - please fix via Web UI",
+			fmt.Sprintf("selected agent_profile_id %q (%s) must have credentials - set them with the "+
+				"`username_wo` and `password_wo` attributes of the Agent Profile or of this resource",
 				agentProfile.Label, agentProfile.Id))
 	}
 
@@ -216,7 +345,11 @@ func (o *ManagedDevice) ValidateAgentProfile(ctx context.Context, client *apstra
 }
 
 func (o *ManagedDevice) SetUserConfig(ctx context.Context, si *apstra.ManagedSystemInfo, client *apstra.Client, diags *diag.Diagnostics) {
-	err := client.UpdateSystem(ctx, apstra.SystemId(o.SystemId.ValueString()), &apstra.SystemUserConfig{
+	o.setUserConfig(ctx, si, clientOnboardingApi{client: client}, diags)
+}
+
+func (o *ManagedDevice) setUserConfig(ctx context.Context, si *apstra.ManagedSystemInfo, api onboardingApi, diags *diag.Diagnostics) {
+	err := api.updateSystem(ctx, apstra.SystemId(o.SystemId.ValueString()), &apstra.SystemUserConfig{
 		AosHclModel: si.Facts.AosHclModel,
 		AdminState:  apstra.SystemAdminStateNormal,
 		Location:    o.Location.ValueString(),
@@ -253,6 +386,263 @@ func (o *ManagedDevice) GetDeviceKey(ctx context.Context, client *apstra.Client,
 	}
 }
 
+// onboardingApi is the part of the Apstra API used to onboard a managed
+// device. It is implemented by clientOnboardingApi, and by a fake in tests.
+type onboardingApi interface {
+	install(ctx context.Context, agentId apstra.ObjectId, timeout time.Duration) error
+	getAgent(ctx context.Context, agentId apstra.ObjectId) (*apstra.SystemAgent, error)
+	getLastHeartbeat(ctx context.Context, agentId apstra.ObjectId) (string, error)
+	getJobHistory(ctx context.Context, agentId apstra.ObjectId) ([]apstra.AgentJobStatus, error)
+	getJobLog(ctx context.Context, agentId apstra.ObjectId, jobId apstra.JobId) (string, error)
+	getSystemInfo(ctx context.Context, systemId apstra.SystemId) (*apstra.ManagedSystemInfo, error)
+	updateSystem(ctx context.Context, systemId apstra.SystemId, cfg *apstra.SystemUserConfig) error
+}
+
+var _ onboardingApi = clientOnboardingApi{}
+
+type clientOnboardingApi struct {
+	client *apstra.Client
+}
+
+// install runs the Agent's install job, which must finish within timeout.
+func (o clientOnboardingApi) install(ctx context.Context, agentId apstra.ObjectId, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	status, err := o.client.SystemAgentRunJob(ctx, agentId, apstra.AgentJobTypeInstall)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%q job not finished after %s", apstra.AgentJobTypeInstall, timeout)
+		}
+		return err
+	}
+
+	if status.State != apstra.AgentJobStateSuccess {
+		return fmt.Errorf("%q job %d finished with state %q: %s", apstra.AgentJobTypeInstall, status.JobId, status.State, status.Error)
+	}
+
+	return nil
+}
+
+func (o clientOnboardingApi) getAgent(ctx context.Context, agentId apstra.ObjectId) (*apstra.SystemAgent, error) {
+	return o.client.GetSystemAgent(ctx, agentId)
+}
+
+func (o clientOnboardingApi) getLastHeartbeat(ctx context.Context, agentId apstra.ObjectId) (string, error) {
+	// the heartbeat is read from the API directly, so it is left null with
+	// versions whose API the request was not written against
+	if !compatibility.AgentHeartbeatOK.Check(version.Must(version.NewVersion(o.client.ApiVersion()))) {
+		return "", nil
+	}
+	return GetAgentLastHeartbeat(ctx, o.client, agentId)
+}
+
+func (o clientOnboardingApi) getJobHistory(ctx context.Context, agentId apstra.ObjectId) ([]apstra.AgentJobStatus, error) {
+	return o.client.GetSystemAgentJobHistory(ctx, agentId)
+}
+
+func (o clientOnboardingApi) getJobLog(ctx context.Context, agentId apstra.ObjectId, jobId apstra.JobId) (string, error) {
+	// the log only adds detail to onboarding errors, and is read from the API
+	// directly, so it is omitted with versions whose API the request was not
+	// written against
+	if !compatibility.AgentJobLogOK.Check(version.Must(version.NewVersion(o.client.ApiVersion()))) {
+		return "", nil
+	}
+	return GetAgentJobLog(ctx, o.client, agentId, jobId)
+}
+
+func (o clientOnboardingApi) getSystemInfo(ctx context.Context, systemId apstra.SystemId) (*apstra.ManagedSystemInfo, error) {
+	return o.client.GetSystemInfo(ctx, systemId)
+}
+
+func (o clientOnboardingApi) updateSystem(ctx context.Context, systemId apstra.SystemId, cfg *apstra.SystemUserConfig) error {
+	return o.client.UpdateSystem(ctx, systemId, cfg)
+}
+
+// Onboard installs the Agent, waits for the device to connect and, when
+// requested, acknowledges the discovered System. The computed attributes are
+// populated whenever possible, even if onboarding fails, so that the Agent
+// can be located.
+func (o *ManagedDeviceResource) Onboard(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	o.onboard(ctx, clientOnboardingApi{client: client}, diags)
+}
+
+func (o *ManagedDeviceResource) onboard(ctx context.Context, api onboardingApi, diags *diag.Diagnostics) {
+	o.SystemId = types.StringNull()
+	o.OsVersion = types.StringNull()
+	o.Platform = types.StringNull()
+	o.AgentState = types.StringNull()
+	o.LastHeartbeat = types.StringNull()
+
+	agentId := apstra.ObjectId(o.AgentId.ValueString())
+
+	timeout := time.Duration(managedDeviceDefaultTimeout) * time.Second
+	if !o.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(o.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+	deadline := time.Now().Add(timeout)
+
+	err := api.install(ctx, agentId, timeout)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("installation of Agent %q (%s) did not succeed", agentId, o.ManagementIp.ValueString()),
+			err.Error()+installJobLog(ctx, api, agentId))
+		_ = o.loadStatus(ctx, api, diags) // best effort: the error has been reported above
+		return
+	}
+
+	agent, err := waitForAgentConnection(ctx, api, agentId, time.Until(deadline))
+	if agent != nil {
+		o.SystemId = value.StringOrNull(ctx, string(agent.Status.SystemId), diags)
+	}
+	if err != nil {
+		diags.AddError(fmt.Sprintf("device managed by Agent %q (%s) did not connect", agentId, o.ManagementIp.ValueString()),
+			err.Error()+installJobLog(ctx, api, agentId))
+		_ = o.loadStatus(ctx, api, diags) // best effort: the error has been reported above
+		return
+	}
+
+	if !o.DeviceKey.IsNull() || o.Acknowledge.ValueBool() {
+		o.ack(ctx, api, diags)
+		// do not return here -- the status should be loaded regardless
+	}
+
+	err = o.loadStatus(ctx, api, diags)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed reading status of Agent %q", agentId), err.Error())
+	}
+}
+
+// Ack acknowledges the System discovered by the Agent after ensuring that the
+// device key reported by the device matches the configured device_key, if any.
+func (o *ManagedDeviceResource) Ack(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) {
+	o.ack(ctx, clientOnboardingApi{client: client}, diags)
+}
+
+func (o *ManagedDeviceResource) ack(ctx context.Context, api onboardingApi, diags *diag.Diagnostics) {
+	systemInfo, err := api.getSystemInfo(ctx, apstra.SystemId(o.SystemId.ValueString()))
+	if err != nil {
+		diags.AddError("error fetching system info", err.Error())
+		return
+	}
+
+	// validate discovered device_key (serial number)
+	if !o.DeviceKey.IsNull() && o.DeviceKey.ValueString() != systemInfo.DeviceKey {
+		diags.AddAttributeError(
+			path.Root("device_key"),
+			"error system device_key mismatch",
+			fmt.Sprintf("config expects switch device_key %q, device reports %q",
+				o.DeviceKey.ValueString(), systemInfo.DeviceKey),
+		)
+		return
+	}
+
+	o.setUserConfig(ctx, systemInfo, api, diags)
+}
+
+// LoadStatus populates the computed status attributes from the Agent and the
+// System it manages. Attributes which cannot be determined are set to null.
+// The returned error reports a failure to reach the API, which callers may
+// treat as a warning.
+func (o *ManagedDeviceResource) LoadStatus(ctx context.Context, client *apstra.Client, diags *diag.Diagnostics) error {
+	return o.loadStatus(ctx, clientOnboardingApi{client: client}, diags)
+}
+
+func (o *ManagedDeviceResource) loadStatus(ctx context.Context, api onboardingApi, diags *diag.Diagnostics) error {
+	o.Platform = types.StringNull()
+	o.AgentState = types.StringNull()
+	o.LastHeartbeat = types.StringNull()
+	o.OsVersion = types.StringNull()
+
+	agent, err := api.getAgent(ctx, apstra.ObjectId(o.AgentId.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	o.Platform = value.StringOrNull(ctx, string(agent.Status.Platform), diags)
+	o.AgentState = value.StringOrNull(ctx, agent.Status.ConnectionState.String(), diags)
+
+	lastHeartbeat, err := api.getLastHeartbeat(ctx, agent.Id)
+	if err != nil {
+		return err
+	}
+	o.LastHeartbeat = value.StringOrNull(ctx, lastHeartbeat, diags)
+
+	if agent.Status.SystemId == "" {
+		return nil // no system to interrogate
+	}
+
+	systemInfo, err := api.getSystemInfo(ctx, agent.Status.SystemId)
+	if err != nil {
+		if utils.IsApstra404(err) {
+			return nil
+		}
+		return fmt.Errorf("failed reading System %q: %w", agent.Status.SystemId, err)
+	}
+
+	o.OsVersion = value.StringOrNull(ctx, systemInfo.Facts.OsVersion, diags)
+	return nil
+}
+
+// installJobLog returns the log of the Agent's most recent install job
+// formatted for inclusion in a diagnostic, or an empty string if the log
+// cannot be retrieved.
+func installJobLog(ctx context.Context, api onboardingApi, agentId apstra.ObjectId) string {
+	jobs, err := api.getJobHistory(ctx, agentId)
+	if err != nil {
+		return ""
+	}
+
+	var job *apstra.AgentJobStatus
+	for i := range jobs {
+		if jobs[i].JobType == apstra.AgentJobTypeInstall && (job == nil || jobs[i].JobId > job.JobId) {
+			job = &jobs[i]
+		}
+	}
+	if job == nil {
+		return ""
+	}
+
+	log, err := api.getJobLog(ctx, agentId, job.JobId)
+	if err != nil || log == "" {
+		return ""
+	}
+	return fmt.Sprintf("\n\nLog of Agent job %d:\n%s", job.JobId, log)
+}
+
+// waitForAgentConnection polls the specified agent until its device has
+// connected and been assigned a System ID. It returns the most recently
+// fetched agent along with an error if the device did not connect before
+// timeout elapsed.
+func waitForAgentConnection(ctx context.Context, api onboardingApi, agentId apstra.ObjectId, timeout time.Duration) (*apstra.SystemAgent, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(min(agentJobPollInterval, timeout/10))
+	defer ticker.Stop()
+
+	var agent *apstra.SystemAgent
+	for {
+		latest, err := api.getAgent(ctx, agentId)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return agent, fmt.Errorf("agent %q not connected after %s", agentId, timeout)
+			}
+			return agent, err
+		}
+		agent = latest
+
+		if agent.Status.ConnectionState == apstra.AgentCxnStateConnected && agent.Status.SystemId != "" {
+			return agent, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return agent, fmt.Errorf("agent %q not connected after %s: connection state %q", agentId, timeout, agent.Status.ConnectionState.String())
+		case <-ticker.C:
+		}
+	}
+}
+
 // IpNetFromManagementIp is generally called when a ManagedDevice object is used
 // as a filter for matching other ManagedDevice objects. In that case, the
 // ManagementIp element might contain a CIDR block rather than an individual
@@ -0,0 +1,62 @@
+package systemAgents
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+)
+
+const (
+	apiUrlSystemAgentById = "/api/system-agents/%s"
+)
+
+// agentCredentialsData is the JSON payload used to replace the credentials
+// of a system agent. Credentials are never returned by the API.
+type agentCredentialsData struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// GetAgentLastHeartbeat fetches the time of the most recent heartbeat received
+// from the specified system agent. The remainder of the agent's status is
+// available from the SDK's GetSystemAgent, which does not decode this field.
+// The response format is that of Apstra 6.1.0 (see
+// compatibility.AgentHeartbeatOK).
+func GetAgentLastHeartbeat(ctx context.Context, client *apstra.Client, agentId apstra.ObjectId) (string, error) {
+	u, err := url.Parse(fmt.Sprintf(apiUrlSystemAgentById, url.PathEscape(agentId.String())))
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		Status struct {
+			LastHeartbeat string `json:"last_heartbeat_time"`
+		} `json:"status"`
+	}
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodGet, Url: u}, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Status.LastHeartbeat, nil
+}
+
+// UpdateAgentCredentials replaces the credentials which the specified system
+// agent uses to log in to its device. The SDK cannot change the credentials of
+// an existing agent, so the API is called directly. The payload follows the
+// Apstra 6.1.0 API (see compatibility.AgentCredentialsUpdateOK).
+func UpdateAgentCredentials(ctx context.Context, client *apstra.Client, agentId, username, password string) error {
+	u, err := url.Parse(fmt.Sprintf(apiUrlSystemAgentById, url.PathEscape(agentId)))
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{
+		Method:  http.MethodPatch,
+		Url:     u,
+		Payload: agentCredentialsData{Username: username, Password: password},
+	}, nil)
+}
@@ -0,0 +1,172 @@
+package systemAgents
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+var _ onboardingApi = &fakeOnboardingApi{}
+
+// fakeOnboardingApi stands in for the Apstra API while onboarding. The device
+// connects on the connectAfter'th status poll, or never if connectAfter is 0.
+type fakeOnboardingApi struct {
+	installErr   error
+	connectAfter int
+	jobs         []apstra.AgentJobStatus
+	jobsErr      error
+	logs         map[apstra.JobId]string
+	deviceKey    string
+
+	polls   int
+	updated bool
+}
+
+func (o *fakeOnboardingApi) install(_ context.Context, _ apstra.ObjectId, _ time.Duration) error {
+	return o.installErr
+}
+
+func (o *fakeOnboardingApi) getAgent(_ context.Context, agentId apstra.ObjectId) (*apstra.SystemAgent, error) {
+	o.polls++
+
+	agent := new(apstra.SystemAgent)
+	agent.Id = agentId
+	agent.Status.Platform = "junos"
+	if o.connectAfter > 0 && o.polls >= o.connectAfter {
+		agent.Status.ConnectionState = apstra.AgentCxnStateConnected
+		agent.Status.SystemId = "system1"
+	}
+	return agent, nil
+}
+
+func (o *fakeOnboardingApi) getLastHeartbeat(_ context.Context, _ apstra.ObjectId) (string, error) {
+	return "2026-10-16T12:00:00Z", nil
+}
+
+func (o *fakeOnboardingApi) getJobHistory(_ context.Context, _ apstra.ObjectId) ([]apstra.AgentJobStatus, error) {
+	return o.jobs, o.jobsErr
+}
+
+func (o *fakeOnboardingApi) getJobLog(_ context.Context, _ apstra.ObjectId, jobId apstra.JobId) (string, error) {
+	return o.logs[jobId], nil
+}
+
+func (o *fakeOnboardingApi) getSystemInfo(_ context.Context, _ apstra.SystemId) (*apstra.ManagedSystemInfo, error) {
+	return &apstra.ManagedSystemInfo{DeviceKey: o.deviceKey}, nil
+}
+
+func (o *fakeOnboardingApi) updateSystem(_ context.Context, _ apstra.SystemId, _ *apstra.SystemUserConfig) error {
+	o.updated = true
+	return nil
+}
+
+func TestManagedDeviceOnboard(t *testing.T) {
+	installJobs := []apstra.AgentJobStatus{
+		{JobId: 1, JobType: apstra.AgentJobTypeInstall},
+		{JobId: 3, JobType: apstra.AgentJobTypeInstall},
+		{JobId: 2, JobType: apstra.AgentJobTypeInstall},
+	}
+	installLogs := map[apstra.JobId]string{1: "old log", 2: "older log", 3: "latest log"}
+
+	type testCase struct {
+		api         fakeOnboardingApi
+		deviceKey   types.String
+		acknowledge bool
+		expErr      string // substring of the error detail; empty when no error is expected
+		expSystemId types.String
+		expAck      bool
+	}
+
+	testCases := map[string]testCase{
+		"connected": {
+			api:         fakeOnboardingApi{connectAfter: 3},
+			expSystemId: types.StringValue("system1"),
+		},
+		"connected_and_acknowledged": {
+			api:         fakeOnboardingApi{connectAfter: 1, deviceKey: "abc"},
+			deviceKey:   types.StringValue("abc"),
+			expSystemId: types.StringValue("system1"),
+			expAck:      true,
+		},
+		"device_key_mismatch": {
+			api:         fakeOnboardingApi{connectAfter: 1, deviceKey: "xyz"},
+			deviceKey:   types.StringValue("abc"),
+			expErr:      `device reports "xyz"`,
+			expSystemId: types.StringValue("system1"),
+		},
+		"install_failed": {
+			api:         fakeOnboardingApi{installErr: errors.New("install job failed"), jobs: installJobs, logs: installLogs},
+			acknowledge: true,
+			expErr:      "install job failed\n\nLog of Agent job 3:\nlatest log",
+			expSystemId: types.StringNull(),
+		},
+		"install_failed_without_log": {
+			api:         fakeOnboardingApi{installErr: errors.New("install job failed"), jobsErr: errors.New("no history")},
+			expErr:      "install job failed",
+			expSystemId: types.StringNull(),
+		},
+		"connection_timeout": {
+			api:         fakeOnboardingApi{jobs: installJobs, logs: installLogs},
+			acknowledge: true,
+			expErr:      "Log of Agent job 3:\nlatest log",
+			expSystemId: types.StringNull(),
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			api := tCase.api
+			md := ManagedDeviceResource{
+				ManagedDevice: ManagedDevice{
+					AgentId:      types.StringValue("agent1"),
+					ManagementIp: types.StringValue("192.0.2.1"),
+					DeviceKey:    tCase.deviceKey,
+				},
+				Acknowledge:    types.BoolValue(tCase.acknowledge),
+				TimeoutSeconds: types.Int64Value(1),
+			}
+
+			var diags diag.Diagnostics
+			md.onboard(context.Background(), &api, &diags)
+
+			if tCase.expErr == "" {
+				require.False(t, diags.HasError(), diags)
+			} else {
+				require.True(t, diags.HasError())
+				require.Contains(t, diags.Errors()[0].Detail(), tCase.expErr)
+			}
+
+			require.Equal(t, tCase.expSystemId, md.SystemId)
+			require.Equal(t, tCase.expAck, api.updated)
+
+			// the status is loaded even when onboarding fails
+			require.Equal(t, types.StringValue("junos"), md.Platform)
+			require.Equal(t, types.StringValue("2026-10-16T12:00:00Z"), md.LastHeartbeat)
+		})
+	}
+}
+
+func TestWaitForAgentConnection(t *testing.T) {
+	ctx := context.Background()
+
+	api := fakeOnboardingApi{connectAfter: 3}
+	agent, err := waitForAgentConnection(ctx, &api, "agent1", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, apstra.SystemId("system1"), agent.Status.SystemId)
+	require.Equal(t, 3, api.polls)
+
+	api = fakeOnboardingApi{}
+	start := time.Now()
+	agent, err = waitForAgentConnection(ctx, &api, "agent1", time.Second)
+	require.Error(t, err)
+	require.NotNil(t, agent)
+	require.Less(t, time.Since(start), 2*time.Second)
+}
@@ -0,0 +1,99 @@
+package systemAgents
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/Juniper/terraform-provider-apstra/internal/value"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OsImagePlatforms are the device platforms for which OS images may be
+// registered. The values correspond to the OS family reported by managed
+// devices.
+var OsImagePlatforms = []string{"eos", "junos", "nxos", "sonic"}
+
+var (
+	osImageUrlRegexp      = regexp.MustCompile(`^(https?|ftp)://[^/\s]+/\S+$`)
+	osImageChecksumRegexp = regexp.MustCompile(`^([0-9a-fA-F]{32}|[0-9a-fA-F]{128})$`)
+)
+
+type OsImage struct {
+	Id          types.String `tfsdk:"id"`
+	Platform    types.String `tfsdk:"platform"`
+	Description types.String `tfsdk:"description"`
+	ImageUrl    types.String `tfsdk:"image_url"`
+	Checksum    types.String `tfsdk:"checksum"`
+	ImageName   types.String `tfsdk:"image_name"`
+}
+
+func (o OsImage) ResourceAttributes() map[string]resourceSchema.Attribute {
+	return map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			MarkdownDescription: "Apstra ID of the OS image. Use this value with `apstra_device_os_upgrade`.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"platform": resourceSchema.StringAttribute{
+			MarkdownDescription: "Platform (device OS family) which runs the image. Must be one of `" +
+				strings.Join(OsImagePlatforms, "`, `") + "`.",
+			Required:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:    []validator.String{stringvalidator.OneOf(OsImagePlatforms...)},
+		},
+		"description": resourceSchema.StringAttribute{
+			MarkdownDescription: "OS image description displayed in the Apstra web UI.",
+			Optional:            true,
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"image_url": resourceSchema.StringAttribute{
+			MarkdownDescription: "HTTP, HTTPS or FTP URL from which devices download the image.",
+			Required:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators: []validator.String{stringvalidator.RegexMatches(osImageUrlRegexp,
+				"must be an http://, https:// or ftp:// URL which includes the image file name")},
+		},
+		"checksum": resourceSchema.StringAttribute{
+			MarkdownDescription: "MD5 or SHA512 checksum of the image, in hexadecimal. Devices verify the " +
+				"downloaded image against this value before installing it.",
+			Required:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators: []validator.String{stringvalidator.RegexMatches(osImageChecksumRegexp,
+				"must be an MD5 (32 hexadecimal characters) or SHA512 (128 hexadecimal characters) checksum")},
+		},
+		"image_name": resourceSchema.StringAttribute{
+			MarkdownDescription: "Image file name, as determined by Apstra from `image_url`.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+	}
+}
+
+func (o *OsImage) Request(_ context.Context, _ *diag.Diagnostics) *OsImageData {
+	return &OsImageData{
+		Description: o.Description.ValueString(),
+		Platform:    o.Platform.ValueString(),
+		Type:        osImageTypeUrl,
+		ImageUrl:    o.ImageUrl.ValueString(),
+		Checksum:    o.Checksum.ValueString(),
+	}
+}
+
+func (o *OsImage) LoadApiData(ctx context.Context, in *OsImageData, diags *diag.Diagnostics) {
+	o.Platform = types.StringValue(in.Platform)
+	o.Description = value.StringOrNull(ctx, in.Description, diags)
+	o.ImageUrl = types.StringValue(in.ImageUrl)
+	o.ImageName = value.StringOrNull(ctx, in.ImageName, diags)
+
+	// Apstra may normalize the case of the checksum. Don't report that as drift.
+	if !strings.EqualFold(o.Checksum.ValueString(), in.Checksum) {
+		o.Checksum = types.StringValue(in.Checksum)
+	}
+}
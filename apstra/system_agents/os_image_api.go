@@ -0,0 +1,94 @@
+package systemAgents
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+)
+
+// The SDK does not manage device OS images, so the functions in this file call
+// the /api/device-os/images endpoints directly. The payloads follow the Apstra
+// 6.1.0 API (see compatibility.DeviceOsImagesOK).
+const (
+	apiUrlOsImages    = "/api/device-os/images"
+	apiUrlOsImageById = apiUrlOsImages + "/%s"
+
+	osImageTypeUrl = "url"
+)
+
+// OsImageData is the JSON representation of a device OS image used by the
+// /api/device-os/images endpoints. Only images registered by URL (as opposed
+// to images uploaded to the Apstra server) are handled by the provider.
+type OsImageData struct {
+	Id          string `json:"id,omitempty"`
+	Description string `json:"description"`
+	Platform    string `json:"platform"`
+	Type        string `json:"type"`
+	ImageUrl    string `json:"image_url"`
+	Checksum    string `json:"checksum"`
+	ImageName   string `json:"image_name,omitempty"`
+}
+
+func osImageUrl(id string) (*url.URL, error) {
+	if id == "" {
+		return url.Parse(apiUrlOsImages)
+	}
+	return url.Parse(fmt.Sprintf(apiUrlOsImageById, url.PathEscape(id)))
+}
+
+// GetOsImage fetches the specified device OS image.
+func GetOsImage(ctx context.Context, client *apstra.Client, id string) (*OsImageData, error) {
+	u, err := osImageUrl(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var result OsImageData
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodGet, Url: u}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateOsImage registers a device OS image and returns its ID.
+func CreateOsImage(ctx context.Context, client *apstra.Client, in *OsImageData) (string, error) {
+	u, err := osImageUrl("")
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		Id string `json:"id"`
+	}
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPost, Url: u, Payload: in}, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Id, nil
+}
+
+// UpdateOsImage replaces the specified device OS image.
+func UpdateOsImage(ctx context.Context, client *apstra.Client, id string, in *OsImageData) error {
+	u, err := osImageUrl(id)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodPut, Url: u, Payload: in}, nil)
+}
+
+// DeleteOsImage deletes the specified device OS image.
+func DeleteOsImage(ctx context.Context, client *apstra.Client, id string) error {
+	u, err := osImageUrl(id)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodDelete, Url: u}, nil)
+}
@@ -0,0 +1,104 @@
+package systemAgents
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+)
+
+const (
+	apiUrlSystemAgentJobs   = "/api/system-agents/%s/jobs"
+	apiUrlSystemAgentJobLog = apiUrlSystemAgentJobs + "/%d/log"
+
+	agentJobTypeUpgrade = "upgradeDevice"
+)
+
+// agentJobRequestData is the JSON payload used to start a system agent job.
+type agentJobRequestData struct {
+	JobType string `json:"job_type"`
+	ImageId string `json:"image_id,omitempty"`
+}
+
+// StartOsUpgrade starts a job which upgrades the system managed by the
+// specified agent to the specified OS image. It returns the job ID. The SDK's
+// SystemAgentRunJob offers no way to specify the image, so the job is started
+// with a raw API call. The payload follows the Apstra 6.1.0 API (see
+// compatibility.DeviceOsImagesOK).
+func StartOsUpgrade(ctx context.Context, client *apstra.Client, agentId apstra.ObjectId, imageId string) (apstra.JobId, error) {
+	u, err := url.Parse(fmt.Sprintf(apiUrlSystemAgentJobs, url.PathEscape(agentId.String())))
+	if err != nil {
+		return 0, err
+	}
+
+	var response struct {
+		Id apstra.JobId `json:"id"`
+	}
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{
+		Method:  http.MethodPost,
+		Url:     u,
+		Payload: agentJobRequestData{JobType: agentJobTypeUpgrade, ImageId: imageId},
+	}, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Id, nil
+}
+
+// GetAgentJob returns the specified job from the job history of the specified
+// agent, or nil if the job does not (yet) appear in the history.
+func GetAgentJob(ctx context.Context, client *apstra.Client, agentId apstra.ObjectId, jobId apstra.JobId) (*apstra.AgentJobStatus, error) {
+	jobs, err := client.GetSystemAgentJobHistory(ctx, agentId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, job := range jobs {
+		if job.JobId == jobId {
+			return &job, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GetAgentJobLog fetches the log of the specified system agent job. The SDK
+// does not expose job logs. The response format is that of Apstra 6.1.0 (see
+// compatibility.AgentJobLogOK).
+func GetAgentJobLog(ctx context.Context, client *apstra.Client, agentId apstra.ObjectId, jobId apstra.JobId) (string, error) {
+	u, err := url.Parse(fmt.Sprintf(apiUrlSystemAgentJobLog, url.PathEscape(agentId.String()), jobId))
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		Log string `json:"log"`
+	}
+	err = client.DoRawJsonTransaction(ctx, apstra.RawJsonRequest{Method: http.MethodGet, Url: u}, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Log, nil
+}
+
+// agentJobDone returns true when the job has finished, successfully or
+// otherwise.
+func agentJobDone(job apstra.AgentJobStatus) bool {
+	return job.State == apstra.AgentJobStateSuccess || job.State == apstra.AgentJobStateFailed
+}
+
+// jobIdString renders a job ID for use in the terraform state.
+func jobIdString(jobId apstra.JobId) string {
+	return strconv.Itoa(int(jobId))
+}
+
+// parseJobId parses a job ID rendered by jobIdString.
+func parseJobId(s string) (apstra.JobId, error) {
+	i, err := strconv.Atoi(s)
+	return apstra.JobId(i), err
+}
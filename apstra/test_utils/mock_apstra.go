@@ -0,0 +1,60 @@
+package testutils
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Juniper/apstra-go-sdk/apstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/constants"
+	"github.com/Juniper/terraform-provider-apstra/apstra/test_utils/mockapstra"
+	"github.com/Juniper/terraform-provider-apstra/apstra/utils"
+)
+
+// MockApstra starts an in-process mock Apstra server and points the
+// environment variables consulted by the provider at it. Because the
+// environment is modified with t.Setenv(), tests which use MockApstra cannot
+// use t.Parallel(). The server is shut down when the test completes.
+//
+// Unlike GetTestClient(), MockApstra ignores the .testconfig.hcl file, so
+// tests using it never reach a real Apstra controller.
+func MockApstra(t testing.TB, opts ...mockapstra.Option) *mockapstra.Server {
+	t.Helper()
+
+	srv := mockapstra.New(t, opts...)
+
+	t.Setenv(constants.EnvUrl, srv.Url())
+	t.Setenv(constants.EnvUsername, srv.Username())
+	t.Setenv(constants.EnvPassword, srv.Password())
+	t.Setenv(constants.EnvTlsNoVerify, "true")
+	t.Setenv(constants.EnvBlueprintMutexEnabled, "false")
+
+	return srv
+}
+
+// GetMockClient returns an SDK client logged in to the mock server. The client
+// is not shared with other tests.
+func GetMockClient(t testing.TB, ctx context.Context, srv *mockapstra.Server) *apstra.Client {
+	t.Helper()
+
+	t.Setenv(constants.EnvUrl, srv.Url())
+	t.Setenv(constants.EnvUsername, srv.Username())
+	t.Setenv(constants.EnvPassword, srv.Password())
+
+	clientCfg, err := utils.NewClientConfig("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientCfg.Timeout = timeout
+	clientCfg.HttpClient.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify = true
+
+	client, err := clientCfg.NewClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = client.Logout(context.Background()) })
+
+	return client
+}
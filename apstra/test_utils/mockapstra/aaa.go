@@ -0,0 +1,184 @@
+package mockapstra
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	aaaUsersPath             = "/api/aaa/users"
+	aaaRolesPath             = "/api/aaa/roles"
+	aaaProvidersPath         = "/api/aaa/providers"
+	blueprintPermissionsPart = "/blueprint-permissions/"
+)
+
+// providerSecretKeys are the keys under which the external authentication
+// provider types carry their secret within the provider "config" object.
+var providerSecretKeys = []string{"key", "password"}
+
+// validBlueprintPermissions are the values accepted by the role blueprint
+// permission endpoint.
+var validBlueprintPermissions = map[string]bool{
+	"read_only":  true,
+	"read_write": true,
+}
+
+// UserPassword returns the password most recently set for the specified user
+// ID. Apstra never returns passwords, so they are kept apart from the user
+// objects served by the API.
+func (o *Server) UserPassword(userId string) (string, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	password, ok := o.passwords[userId]
+	return password, ok
+}
+
+// BlueprintPermission returns the access granted by the specified role to
+// the specified blueprint.
+func (o *Server) BlueprintPermission(roleId, blueprintId string) (string, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	permission, ok := o.bpPermissions[roleId+"/"+blueprintId]
+	return permission, ok
+}
+
+// ProviderSecret returns the secret (RADIUS or TACACS+ key, or LDAP bind
+// password) most recently set for the specified external authentication
+// provider ID. Like user passwords, these are never returned by the API.
+func (o *Server) ProviderSecret(providerId string) (string, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	secret, ok := o.providerSecrets[providerId]
+	return secret, ok
+}
+
+// serveUsers handles the user endpoints. Passwords found in POST and PUT
+// request bodies are recorded and removed from the stored user object.
+func (o *Server) serveUsers(w http.ResponseWriter, r *http.Request, path string) {
+	o.serveWithSecret(w, r, path, aaaUsersPath, o.passwords, true, func(user map[string]any) (string, bool) {
+		password, ok := user["password"].(string)
+		delete(user, "password")
+		return password, ok
+	})
+}
+
+// serveProviders handles the external authentication provider endpoints.
+// Secrets found within the "config" object of POST and PUT request bodies are
+// recorded and removed from the stored provider object.
+func (o *Server) serveProviders(w http.ResponseWriter, r *http.Request, path string) {
+	o.serveWithSecret(w, r, path, aaaProvidersPath, o.providerSecrets, false, func(provider map[string]any) (string, bool) {
+		config, _ := provider["config"].(map[string]any)
+		for _, k := range providerSecretKeys {
+			if secret, ok := config[k].(string); ok {
+				delete(config, k)
+				return secret, true
+			}
+		}
+		return "", false
+	})
+}
+
+// serveWithSecret handles a collection whose objects carry a secret which the
+// API accepts but never returns. extract removes the secret from a request
+// body. Secrets are recorded in secrets, keyed by object ID. When required is
+// true, POST requests without a secret are rejected. PUT requests without a
+// secret leave the recorded secret unchanged. Other methods are handled by
+// serveCollection.
+func (o *Server) serveWithSecret(w http.ResponseWriter, r *http.Request, path, collectionPath string, secrets map[string]string, required bool, extract func(map[string]any) (string, bool)) {
+	if r.Method != http.MethodPost && r.Method != http.MethodPut {
+		o.serveCollection(w, r, path, nil)
+		return
+	}
+
+	var obj map[string]any
+	if !readJson(w, r, &obj) {
+		return
+	}
+
+	secret, hasSecret := extract(obj)
+
+	c := o.collection(collectionPath)
+	switch r.Method {
+	case http.MethodPost:
+		if required && !hasSecret {
+			writeError(w, http.StatusUnprocessableEntity, "password is required")
+			return
+		}
+		id := o.newId()
+		obj["id"] = id
+		obj["created_at"] = now()
+		obj["last_modified_at"] = obj["created_at"]
+		c.objects[id] = obj
+		if hasSecret {
+			secrets[id] = secret
+		}
+		writeJson(w, http.StatusCreated, map[string]string{"id": id})
+	case http.MethodPut:
+		_, id := splitPath(path)
+		existing, ok := c.objects[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "object with id '"+id+"' not found")
+			return
+		}
+		obj["id"] = id
+		obj["created_at"] = existing["created_at"]
+		obj["last_modified_at"] = now()
+		c.objects[id] = obj
+		if hasSecret {
+			secrets[id] = secret
+		}
+		writeJson(w, http.StatusAccepted, struct{}{})
+	}
+}
+
+// serveBlueprintPermission handles
+// /api/aaa/roles/<role_id>/blueprint-permissions/<blueprint_id>
+func (o *Server) serveBlueprintPermission(w http.ResponseWriter, r *http.Request, path string) {
+	rolePath, blueprintId, _ := strings.Cut(path, blueprintPermissionsPart)
+	_, roleId := splitPath(rolePath)
+
+	if _, ok := o.collection(aaaRolesPath).objects[roleId]; !ok {
+		writeError(w, http.StatusNotFound, "role with id '"+roleId+"' not found")
+		return
+	}
+	if _, ok := o.blueprints[blueprintId]; !ok {
+		writeError(w, http.StatusNotFound, "blueprint with id '"+blueprintId+"' not found")
+		return
+	}
+
+	key := roleId + "/" + blueprintId
+	switch r.Method {
+	case http.MethodGet:
+		permission, ok := o.bpPermissions[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "role '"+roleId+"' has no permission for blueprint '"+blueprintId+"'")
+			return
+		}
+		writeJson(w, http.StatusOK, map[string]string{"permission": permission})
+	case http.MethodPut:
+		var body struct {
+			Permission string `json:"permission"`
+		}
+		if !readJson(w, r, &body) {
+			return
+		}
+		if !validBlueprintPermissions[body.Permission] {
+			writeError(w, http.StatusUnprocessableEntity, "invalid permission '"+body.Permission+"'")
+			return
+		}
+		o.bpPermissions[key] = body.Permission
+		writeJson(w, http.StatusAccepted, struct{}{})
+	case http.MethodDelete:
+		if _, ok := o.bpPermissions[key]; !ok {
+			writeError(w, http.StatusNotFound, "role '"+roleId+"' has no permission for blueprint '"+blueprintId+"'")
+			return
+		}
+		delete(o.bpPermissions, key)
+		writeJson(w, http.StatusAccepted, struct{}{})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
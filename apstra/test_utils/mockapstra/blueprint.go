@@ -0,0 +1,403 @@
+package mockapstra
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	designDatacenter = "two_stage_l3clos"
+	designFreeform   = "freeform"
+)
+
+// blueprint is the mock representation of an Apstra blueprint. Objects
+// created via blueprint-scoped collection endpoints (e.g.
+// "/api/blueprints/<id>/virtual-networks") are kept in the Server's generic
+// object store; the blueprint itself tracks only its graph nodes and the
+// staged and deployed revision numbers.
+type blueprint struct {
+	id              string
+	label           string
+	design          string
+	createdAt       string
+	modifiedAt      string
+	version         int
+	deployedVersion int
+	deployComment   string
+	nodes           map[string]map[string]any
+	deployedNodes   map[string]map[string]any
+}
+
+// deploy copies the staging graph to the active graph.
+func (o *blueprint) deploy() {
+	o.deployedNodes = make(map[string]map[string]any, len(o.nodes))
+	for id, node := range o.nodes {
+		o.deployedNodes[id] = deepCopy(node)
+	}
+	o.deployedVersion = o.version
+}
+
+// changed increments the staging blueprint revision.
+func (o *blueprint) changed() {
+	o.version++
+	o.modifiedAt = now()
+}
+
+func (o *blueprint) status() map[string]any {
+	return map[string]any{
+		"id":                      o.id,
+		"label":                   o.label,
+		"design":                  o.design,
+		"version":                 o.version,
+		"deployed_version":        o.deployedVersion,
+		"has_uncommitted_changes": o.version != o.deployedVersion,
+		"status":                  "created",
+		"created_at":              o.createdAt,
+		"last_modified_at":        o.modifiedAt,
+		"build_errors_count":      0,
+		"build_warnings_count":    0,
+		"anomaly_counts":          map[string]int{"all": 0},
+		"deployment_status": map[string]any{
+			"service_config": map[string]int{"num_failed": 0, "num_pending": 0, "num_succeeded": 0},
+		},
+	}
+}
+
+// AddNode adds a node to the graph of the specified blueprint and returns the
+// node ID. An ID is generated when the node does not have an "id" key. It is
+// intended for seeding the graph with objects which would ordinarily be
+// created by Apstra itself, such as the systems instantiated from a template.
+func (o *Server) AddNode(blueprintId string, node map[string]any) string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	bp, ok := o.blueprints[blueprintId]
+	if !ok {
+		panic("mockapstra: AddNode called with unknown blueprint '" + blueprintId + "'")
+	}
+
+	node = deepCopy(node)
+	id, _ := node["id"].(string)
+	if id == "" {
+		id = o.newId()
+		node["id"] = id
+	}
+	bp.nodes[id] = node
+	bp.changed()
+
+	return id
+}
+
+// AddBlueprint creates a datacenter blueprint and returns its ID.
+func (o *Server) AddBlueprint(label string) string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.newBlueprint(label, designDatacenter).id
+}
+
+// BlueprintVersions returns the staged and deployed revision numbers of the
+// specified blueprint.
+func (o *Server) BlueprintVersions(blueprintId string) (staged, deployed int, ok bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	bp, ok := o.blueprints[blueprintId]
+	if !ok {
+		return 0, 0, false
+	}
+
+	return bp.version, bp.deployedVersion, true
+}
+
+func (o *Server) newBlueprint(label, design string) *blueprint {
+	ts := now()
+	bp := &blueprint{
+		id:         o.newId(),
+		label:      label,
+		design:     design,
+		createdAt:  ts,
+		modifiedAt: ts,
+		version:    1,
+		nodes:      make(map[string]map[string]any),
+	}
+
+	if design == designDatacenter {
+		id := o.newId()
+		bp.nodes[id] = map[string]any{
+			"id":       id,
+			"type":     "security_zone",
+			"label":    "Default routing zone",
+			"vrf_name": "default",
+			"sz_type":  "l3_fabric",
+		}
+	}
+
+	id := o.newId()
+	bp.nodes[id] = map[string]any{
+		"id":    id,
+		"type":  "metadata",
+		"label": label,
+	}
+
+	o.blueprints[bp.id] = bp
+	return bp
+}
+
+func (o *Server) serveBlueprints(w http.ResponseWriter, r *http.Request, path string) {
+	// parts[0] is the blueprint ID, parts[1:] address objects within it
+	parts := strings.Split(strings.TrimPrefix(strings.TrimPrefix(path, "/api/blueprints"), "/"), "/")
+
+	if parts[0] == "" {
+		switch r.Method {
+		case http.MethodGet:
+			ids := make([]string, 0, len(o.blueprints))
+			for id := range o.blueprints {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+
+			items := make([]map[string]any, len(ids))
+			for i, id := range ids {
+				items[i] = o.blueprints[id].status()
+			}
+			writeJson(w, http.StatusOK, map[string]any{"items": items})
+		case http.MethodPost:
+			var req struct {
+				Label  string `json:"label"`
+				Design string `json:"design"`
+			}
+			if !readJson(w, r, &req) {
+				return
+			}
+			if req.Design == "" {
+				req.Design = designDatacenter
+			}
+			for _, bp := range o.blueprints {
+				if bp.label == req.Label {
+					writeError(w, http.StatusConflict, "blueprint with label '"+req.Label+"' already exists")
+					return
+				}
+			}
+			bp := o.newBlueprint(req.Label, req.Design)
+			writeJson(w, http.StatusCreated, map[string]string{"id": bp.id})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+		return
+	}
+
+	bp, ok := o.blueprints[parts[0]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "blueprint '"+parts[0]+"' not found")
+		return
+	}
+
+	if len(parts) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			result := bp.status()
+			result["nodes"] = bp.nodes
+			result["relationships"] = map[string]any{}
+			writeJson(w, http.StatusOK, result)
+		case http.MethodDelete:
+			delete(o.blueprints, bp.id)
+			prefix := "/api/blueprints/" + bp.id + "/"
+			for p := range o.collections {
+				if strings.HasPrefix(p, prefix) {
+					delete(o.collections, p)
+				}
+			}
+			writeJson(w, http.StatusAccepted, struct{}{})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+		return
+	}
+
+	switch parts[1] {
+	case "nodes":
+		o.serveBlueprintNodes(w, r, bp, parts[2:])
+	case "qe":
+		o.serveBlueprintQuery(w, r, bp)
+	case "deploy":
+		o.serveBlueprintDeploy(w, r, bp)
+	case "iba":
+		o.serveIba(w, r, bp, parts[2:])
+	case ibaProbesPart:
+		// IBA probes are not part of the staging blueprint
+		o.serveCollection(w, r, path, nil)
+	default:
+		o.serveCollection(w, r, path, bp.changed)
+	}
+}
+
+func (o *Server) serveBlueprintNodes(w http.ResponseWriter, r *http.Request, bp *blueprint, parts []string) {
+	if len(parts) == 0 {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+
+		nodeType := r.URL.Query().Get("node_type")
+		nodes := make(map[string]map[string]any)
+		for id, node := range bp.nodes {
+			if nodeType == "" || node["type"] == nodeType {
+				nodes[id] = node
+			}
+		}
+		writeJson(w, http.StatusOK, map[string]any{"nodes": nodes})
+		return
+	}
+
+	node, ok := bp.nodes[parts[0]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "node '"+parts[0]+"' not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJson(w, http.StatusOK, node)
+	case http.MethodPatch:
+		var patch map[string]any
+		if !readJson(w, r, &patch) {
+			return
+		}
+		mergePatch(node, patch)
+		node["id"] = parts[0]
+		bp.changed()
+		writeJson(w, http.StatusAccepted, struct{}{})
+	case http.MethodDelete:
+		delete(bp.nodes, parts[0])
+		bp.changed()
+		writeJson(w, http.StatusAccepted, struct{}{})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+var (
+	qeSimpleNodeQuery = regexp.MustCompile(`^node\((.*)\)$`)
+	qeStringAttribute = regexp.MustCompile(`^\s*(\w+)\s*=\s*'([^']*)'\s*$`)
+)
+
+// serveBlueprintQuery answers graph queries consisting of a single node()
+// matcher with string-valued attributes, e.g.
+//
+//	node(type='virtual_network', label='vn1', name='n_vn')
+//
+// Results are returned under the key given by the "name" attribute. The
+// staging graph is queried unless the "type" URL parameter is "operation", in
+// which case the graph as of the most recent deployment is used. Queries
+// which traverse relationships or use other matchers are not supported and
+// produce an empty result.
+func (o *Server) serveBlueprintQuery(w http.ResponseWriter, r *http.Request, bp *blueprint) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req struct {
+		Query string `json:"query"`
+	}
+	if !readJson(w, r, &req) {
+		return
+	}
+
+	// the "operation" graph is the active blueprint
+	nodes := bp.nodes
+	if r.URL.Query().Get("type") == "operation" {
+		nodes = bp.deployedNodes
+	}
+
+	items := make([]map[string]any, 0)
+	name, filter, ok := parseSimpleNodeQuery(req.Query)
+	if ok {
+		ids := make([]string, 0, len(nodes))
+		for id := range nodes {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+	NODE:
+		for _, id := range ids {
+			node := nodes[id]
+			for k, v := range filter {
+				if s, _ := node[k].(string); s != v {
+					continue NODE
+				}
+			}
+			items = append(items, map[string]any{name: node})
+		}
+	}
+
+	writeJson(w, http.StatusOK, map[string]any{"count": len(items), "items": items})
+}
+
+// parseSimpleNodeQuery returns the result name and attribute filter of a
+// single-node graph query. The boolean return value is false when the query
+// is not of the supported form.
+func parseSimpleNodeQuery(query string) (string, map[string]string, bool) {
+	m := qeSimpleNodeQuery.FindStringSubmatch(strings.TrimSpace(query))
+	if m == nil || strings.Contains(m[1], ")") {
+		return "", nil, false
+	}
+
+	var name string
+	filter := make(map[string]string)
+	for _, arg := range strings.Split(m[1], ",") {
+		if strings.TrimSpace(arg) == "" {
+			continue
+		}
+
+		kv := qeStringAttribute.FindStringSubmatch(arg)
+		if kv == nil {
+			return "", nil, false
+		}
+
+		if kv[1] == "name" {
+			name = kv[2]
+			continue
+		}
+		filter[kv[1]] = kv[2]
+	}
+
+	if name == "" {
+		return "", nil, false
+	}
+
+	return name, filter, true
+}
+
+func (o *Server) serveBlueprintDeploy(w http.ResponseWriter, r *http.Request, bp *blueprint) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJson(w, http.StatusOK, map[string]any{
+			"version":     bp.deployedVersion,
+			"description": bp.deployComment,
+			"status":      "success",
+			"state":       "success",
+			"error":       nil,
+		})
+	case http.MethodPut:
+		var req struct {
+			Version     *int   `json:"version"`
+			Description string `json:"description"`
+		}
+		if !readJson(w, r, &req) {
+			return
+		}
+		if req.Version == nil || *req.Version != bp.version {
+			writeError(w, http.StatusConflict, "deploy request does not reference the current staging version")
+			return
+		}
+		bp.deploy()
+		bp.deployComment = req.Description
+		writeJson(w, http.StatusAccepted, struct{}{})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
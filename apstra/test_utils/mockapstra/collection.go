@@ -0,0 +1,240 @@
+package mockapstra
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// knownCollections are registered when the server starts. A GET request for
+// "<collection>/<id>" is treated as a request for a single object only when
+// "<collection>" is registered, otherwise it is treated as a request to list a
+// (possibly empty) collection. Collections are also registered on demand by
+// the first POST request which creates an object within them.
+var knownCollections = []string{
+	"/api/aaa/providers",
+	"/api/aaa/roles",
+	"/api/aaa/users",
+	"/api/design/configlets",
+	"/api/design/interface-maps",
+	"/api/design/logical-devices",
+	"/api/design/property-sets",
+	"/api/design/rack-types",
+	"/api/design/tags",
+	"/api/design/templates",
+	"/api/device-profiles",
+	"/api/resources/asn-pools",
+	"/api/resources/integer-pools",
+	"/api/resources/ip-pools",
+	"/api/resources/ipv6-pools",
+	"/api/resources/vlan-pools",
+	"/api/resources/vni-pools",
+	"/api/systems",
+	"/api/system-agents",
+	"/api/system-agent-profiles",
+}
+
+// uniqueLabelCollections reject the creation of an object whose label is
+// already in use within the collection. The blueprint mutex locking scheme
+// relies on this behavior of Global Catalog Tags.
+var uniqueLabelCollections = map[string]bool{
+	"/api/design/tags": true,
+}
+
+// collection is a set of JSON objects keyed by ID.
+type collection struct {
+	objects map[string]map[string]any
+
+	// decorate, when set, adds computed values to an object before it is
+	// returned to the client.
+	decorate func(map[string]any)
+}
+
+func (o *collection) render(obj map[string]any) map[string]any {
+	result := deepCopy(obj)
+	if o.decorate != nil {
+		o.decorate(result)
+	}
+	return result
+}
+
+func (o *collection) list() []map[string]any {
+	ids := make([]string, 0, len(o.objects))
+	for id := range o.objects {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	result := make([]map[string]any, len(ids))
+	for i, id := range ids {
+		result[i] = o.render(o.objects[id])
+	}
+	return result
+}
+
+// collection returns the collection at path, creating it if necessary.
+func (o *Server) collection(path string) *collection {
+	c, ok := o.collections[path]
+	if !ok {
+		c = &collection{
+			objects:  make(map[string]map[string]any),
+			decorate: decoratorFor(path),
+		}
+		o.collections[path] = c
+	}
+	return c
+}
+
+// serveCollection handles requests against the generic object store. When
+// onChange is non-nil it is invoked after any successful mutation.
+func (o *Server) serveCollection(w http.ResponseWriter, r *http.Request, path string, onChange func()) {
+	if onChange == nil {
+		onChange = func() {}
+	}
+
+	if r.Method == http.MethodPost {
+		var obj map[string]any
+		if !readJson(w, r, &obj) {
+			return
+		}
+
+		c := o.collection(path)
+		id, _ := obj["id"].(string)
+		if id == "" {
+			id = o.newId()
+		}
+		if _, ok := c.objects[id]; ok {
+			writeError(w, http.StatusConflict, "object with id '"+id+"' already exists")
+			return
+		}
+		if uniqueLabelCollections[path] {
+			for _, existing := range c.objects {
+				if existing["label"] == obj["label"] {
+					writeError(w, http.StatusConflict, fmt.Sprintf("object with label '%v' already exists", obj["label"]))
+					return
+				}
+			}
+		}
+
+		obj["id"] = id
+		obj["created_at"] = now()
+		obj["last_modified_at"] = obj["created_at"]
+		c.objects[id] = obj
+		onChange()
+
+		writeJson(w, http.StatusCreated, map[string]string{"id": id})
+		return
+	}
+
+	parent, id := splitPath(path)
+	c, parentKnown := o.collections[parent]
+
+	if r.Method == http.MethodGet && !parentKnown {
+		items := make([]map[string]any, 0)
+		if c, ok := o.collections[path]; ok {
+			items = c.list()
+		}
+		writeJson(w, http.StatusOK, map[string]any{"items": items})
+		return
+	}
+
+	if !parentKnown {
+		writeError(w, http.StatusNotFound, "resource '"+path+"' not found")
+		return
+	}
+
+	obj, ok := c.objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "object with id '"+id+"' not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJson(w, http.StatusOK, c.render(obj))
+	case http.MethodPut:
+		var replacement map[string]any
+		if !readJson(w, r, &replacement) {
+			return
+		}
+		replacement["id"] = id
+		replacement["created_at"] = obj["created_at"]
+		replacement["last_modified_at"] = now()
+		c.objects[id] = replacement
+		onChange()
+		writeJson(w, http.StatusAccepted, struct{}{})
+	case http.MethodPatch:
+		var patch map[string]any
+		if !readJson(w, r, &patch) {
+			return
+		}
+		mergePatch(obj, patch)
+		obj["id"] = id
+		obj["last_modified_at"] = now()
+		onChange()
+		writeJson(w, http.StatusAccepted, struct{}{})
+	case http.MethodDelete:
+		delete(c.objects, id)
+		onChange()
+		writeJson(w, http.StatusAccepted, struct{}{})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// mergePatch applies patch to target using JSON merge patch (RFC 7396)
+// semantics: nested objects are merged and null values remove keys.
+func mergePatch(target, patch map[string]any) {
+	for k, v := range patch {
+		if v == nil {
+			delete(target, k)
+			continue
+		}
+
+		vMap, vIsMap := v.(map[string]any)
+		tMap, tIsMap := target[k].(map[string]any)
+		if vIsMap && tIsMap {
+			mergePatch(tMap, vMap)
+			continue
+		}
+
+		target[k] = v
+	}
+}
+
+func deepCopy(in map[string]any) map[string]any {
+	out := make(map[string]any, len(in))
+	for k, v := range in {
+		out[k] = deepCopyValue(v)
+	}
+	return out
+}
+
+func deepCopyValue(in any) any {
+	switch in := in.(type) {
+	case map[string]any:
+		return deepCopy(in)
+	case []any:
+		out := make([]any, len(in))
+		for i, v := range in {
+			out[i] = deepCopyValue(v)
+		}
+		return out
+	default:
+		return in
+	}
+}
+
+// decoratorFor returns a function which adds values computed by Apstra to
+// objects in the collection at path.
+func decoratorFor(path string) func(map[string]any) {
+	switch {
+	case strings.HasPrefix(path, "/api/resources/ip-pools"),
+		strings.HasPrefix(path, "/api/resources/ipv6-pools"):
+		return decorateIpPool
+	case strings.HasPrefix(path, "/api/resources/"):
+		return decorateIntPool
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package mockapstra
+
+import "net/http"
+
+const (
+	ibaProbesPart           = "probes"
+	ibaPredefinedProbesPart = "predefined-probes"
+)
+
+// AddIbaProbe adds an IBA probe to the specified blueprint and returns the
+// probe ID. The probe is stored verbatim in the blueprint's "probes"
+// collection, so it can be read, replaced and deleted via the generic object
+// store. An ID is generated when the probe does not have an "id" key.
+func (o *Server) AddIbaProbe(blueprintId string, probe map[string]any) string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, ok := o.blueprints[blueprintId]; !ok {
+		panic("mockapstra: AddIbaProbe called with unknown blueprint '" + blueprintId + "'")
+	}
+
+	probe = deepCopy(probe)
+	id, _ := probe["id"].(string)
+	if id == "" {
+		id = o.newId()
+		probe["id"] = id
+	}
+	probe["created_at"] = now()
+	probe["last_modified_at"] = probe["created_at"]
+
+	o.collection(ibaProbesPath(blueprintId)).objects[id] = probe
+
+	return id
+}
+
+// serveIba handles the IBA endpoints of a blueprint. Only the re-rendering of
+// an existing predefined probe instance ("PUT iba/predefined-probes/<name>/<id>")
+// is implemented: the probe is replaced by the supplied configuration, tagged
+// with the name of the predefined probe. As with the generic "probes"
+// collection, IBA probe changes do not affect the staging revision.
+func (o *Server) serveIba(w http.ResponseWriter, r *http.Request, bp *blueprint, parts []string) {
+	if len(parts) != 3 || parts[0] != ibaPredefinedProbesPart {
+		writeError(w, http.StatusNotFound, "resource not found")
+		return
+	}
+
+	if r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	predefinedProbe, id := parts[1], parts[2]
+
+	probes := o.collection(ibaProbesPath(bp.id))
+	existing, ok := probes.objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "probe with id '"+id+"' not found")
+		return
+	}
+
+	var config map[string]any
+	if !readJson(w, r, &config) {
+		return
+	}
+
+	config["id"] = id
+	config["predefined_probe"] = predefinedProbe
+	config["created_at"] = existing["created_at"]
+	config["last_modified_at"] = now()
+	probes.objects[id] = config
+
+	writeJson(w, http.StatusAccepted, struct{}{})
+}
+
+func ibaProbesPath(blueprintId string) string {
+	return "/api/blueprints/" + blueprintId + "/" + ibaProbesPart
+}
@@ -0,0 +1,127 @@
+package mockapstra
+
+import "net/http"
+
+const (
+	platformPath             = "/api/platform"
+	platformSmtpPath         = platformPath + "/smtp"
+	platformFeatureFlagsPath = platformPath + "/feature-flags"
+)
+
+// platformSettings are the singleton settings served under platformPath.
+var platformSettings = map[string]bool{
+	platformPath + "/dns":              true,
+	platformPath + "/ntp":              true,
+	platformPath + "/session-timeouts": true,
+	platformPath + "/syslog":           true,
+	platformSmtpPath:                   true,
+	platformFeatureFlagsPath:           true,
+}
+
+// PlatformSetting returns a copy of the platform setting stored at the
+// specified API path, e.g. "/api/platform/dns". The boolean return value is
+// false when the setting has never been written.
+func (o *Server) PlatformSetting(path string) (map[string]any, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	setting, ok := o.platform[path]
+	if !ok {
+		return nil, false
+	}
+
+	return deepCopy(setting), true
+}
+
+// SetPlatformSetting replaces the platform setting stored at the specified
+// API path, e.g. to simulate a change made outside of terraform. Unlike a PUT
+// request, it does not affect the SMTP password or merge feature flags.
+func (o *Server) SetPlatformSetting(path string, setting map[string]any) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if !platformSettings[path] {
+		panic("mockapstra: SetPlatformSetting called with unknown path '" + path + "'")
+	}
+
+	o.platform[path] = deepCopy(setting)
+}
+
+// SmtpPassword returns the SMTP password most recently set. Like user
+// passwords, it is never returned by the API.
+func (o *Server) SmtpPassword() (string, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.smtpPassword, o.smtpPassword != ""
+}
+
+// servePlatform handles the singleton platform settings. A setting which has
+// never been written is returned as an empty object. PUT replaces the
+// setting, except that a PUT to the SMTP setting without a password leaves
+// the password unchanged, and a PUT to the feature flags changes only the
+// flags it lists.
+func (o *Server) servePlatform(w http.ResponseWriter, r *http.Request, path string) {
+	if !platformSettings[path] {
+		writeError(w, http.StatusNotFound, "resource '"+path+"' not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		setting, ok := o.platform[path]
+		if !ok {
+			setting = map[string]any{}
+		}
+		writeJson(w, http.StatusOK, setting)
+	case http.MethodPut:
+		var setting map[string]any
+		if !readJson(w, r, &setting) {
+			return
+		}
+
+		switch path {
+		case platformSmtpPath:
+			if password, ok := setting["password"].(string); ok {
+				o.smtpPassword = password
+			}
+			delete(setting, "password")
+		case platformFeatureFlagsPath:
+			setting = mergeFeatureFlags(o.platform[path], setting)
+		}
+
+		o.platform[path] = setting
+		writeJson(w, http.StatusAccepted, struct{}{})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// mergeFeatureFlags returns the feature flags setting which results from
+// applying the flags listed in update to existing.
+func mergeFeatureFlags(existing, update map[string]any) map[string]any {
+	var flags []any
+	index := make(map[string]int)
+	if existing != nil {
+		flags, _ = existing["feature_flags"].([]any)
+		for i, flag := range flags {
+			f, _ := flag.(map[string]any)
+			name, _ := f["name"].(string)
+			index[name] = i
+		}
+	}
+
+	updates, _ := update["feature_flags"].([]any)
+	for _, flag := range updates {
+		f, _ := flag.(map[string]any)
+		name, _ := f["name"].(string)
+		if i, ok := index[name]; ok {
+			flags[i] = flag
+			continue
+		}
+		index[name] = len(flags)
+		flags = append(flags, flag)
+	}
+
+	return map[string]any{"feature_flags": flags}
+}
@@ -0,0 +1,71 @@
+package mockapstra
+
+import (
+	"math/big"
+	"net"
+)
+
+// decorateIntPool adds usage statistics to ASN, VNI, VLAN and integer pools.
+// Nothing is ever allocated from a mock pool, so usage is always zero.
+func decorateIntPool(pool map[string]any) {
+	var total float64
+	ranges, _ := pool["ranges"].([]any)
+	for _, r := range ranges {
+		r, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		first, _ := r["first"].(float64)
+		last, _ := r["last"].(float64)
+		size := last - first + 1
+		total += size
+
+		r["status"] = "pool_element_available"
+		r["total"] = size
+		r["used"] = 0
+		r["used_percentage"] = 0
+	}
+
+	pool["status"] = "not_in_use"
+	pool["total"] = total
+	pool["used"] = 0
+	pool["used_percentage"] = 0
+	if _, ok := pool["tags"]; !ok {
+		pool["tags"] = []any{}
+	}
+}
+
+// decorateIpPool adds usage statistics to IPv4 and IPv6 pools. Apstra
+// represents IP pool sizes as strings because they may exceed 64 bits.
+func decorateIpPool(pool map[string]any) {
+	total := new(big.Int)
+	subnets, _ := pool["subnets"].([]any)
+	for _, s := range subnets {
+		s, ok := s.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		size := new(big.Int)
+		network, _ := s["network"].(string)
+		if _, ipNet, err := net.ParseCIDR(network); err == nil {
+			ones, bits := ipNet.Mask.Size()
+			size.Lsh(big.NewInt(1), uint(bits-ones))
+		}
+		total.Add(total, size)
+
+		s["status"] = "pool_element_available"
+		s["total"] = size.String()
+		s["used"] = "0"
+		s["used_percentage"] = 0
+	}
+
+	pool["status"] = "not_in_use"
+	pool["total"] = total.String()
+	pool["used"] = "0"
+	pool["used_percentage"] = 0
+	if _, ok := pool["tags"]; !ok {
+		pool["tags"] = []any{}
+	}
+}
@@ -0,0 +1,301 @@
+// Package mockapstra provides an in-process, stateful imitation of the Apstra
+// API. It implements just enough of the API (login, version discovery, a
+// generic object store for design and resource objects, users, external
+// authentication providers, role blueprint permissions, platform settings,
+// blueprints, graph nodes, simple graph queries, IBA probe updates and
+// deployment) to allow the
+// provider and the SDK client to be exercised without a live Apstra
+// controller.
+//
+// The mock is not a re-implementation of Apstra: objects are stored and
+// returned more or less verbatim, and no validation or reference-design logic
+// is performed. Tests which rely on Apstra-generated values must continue to
+// run against a real controller using the `integration` build tag.
+package mockapstra
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	apiversions "github.com/Juniper/terraform-provider-apstra/apstra/api_versions"
+)
+
+const (
+	DefaultUsername   = "admin"
+	DefaultPassword   = "admin"
+	DefaultApiVersion = apiversions.Apstra612
+
+	authTokenHeader = "AuthToken"
+	timestampFormat = "2006-01-02T15:04:05.000000Z"
+)
+
+// Option modifies the behavior of a Server created by New.
+type Option func(*Server)
+
+// WithApiVersion causes the server to report the specified Apstra version.
+func WithApiVersion(v string) Option {
+	return func(o *Server) { o.apiVersion = v }
+}
+
+// WithCredentials sets the username and password accepted by the server.
+func WithCredentials(username, password string) Option {
+	return func(o *Server) {
+		o.username = username
+		o.password = password
+	}
+}
+
+// Server is a mock Apstra API server. Its exported methods are safe for
+// concurrent use.
+type Server struct {
+	srv        *httptest.Server
+	username   string
+	password   string
+	apiVersion string
+
+	mu          sync.Mutex
+	nextId      int
+	tokens      map[string]struct{}
+	collections map[string]*collection
+	blueprints  map[string]*blueprint
+	requests    map[string]int
+
+	passwords       map[string]string // keyed by user ID
+	providerSecrets map[string]string // keyed by provider ID
+	bpPermissions   map[string]string // keyed by "<role_id>/<blueprint_id>"
+
+	platform     map[string]map[string]any // keyed by API path
+	smtpPassword string
+}
+
+// New starts a mock Apstra server using TLS with a self-signed certificate.
+// The server is shut down automatically when the test completes.
+func New(t testing.TB, opts ...Option) *Server {
+	t.Helper()
+
+	o := &Server{
+		username:    DefaultUsername,
+		password:    DefaultPassword,
+		apiVersion:  DefaultApiVersion,
+		tokens:      make(map[string]struct{}),
+		collections: make(map[string]*collection),
+		blueprints:  make(map[string]*blueprint),
+		requests:    make(map[string]int),
+
+		passwords:       make(map[string]string),
+		providerSecrets: make(map[string]string),
+		bpPermissions:   make(map[string]string),
+
+		platform: make(map[string]map[string]any),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	for _, path := range knownCollections {
+		o.collection(path)
+	}
+
+	o.srv = httptest.NewTLSServer(o)
+	t.Cleanup(o.srv.Close)
+
+	return o
+}
+
+// Url returns the base URL of the server, e.g. "https://127.0.0.1:12345".
+func (o *Server) Url() string {
+	return o.srv.URL
+}
+
+// Username returns the username accepted by the server.
+func (o *Server) Username() string {
+	return o.username
+}
+
+// Password returns the password accepted by the server.
+func (o *Server) Password() string {
+	return o.password
+}
+
+// ApiVersion returns the Apstra version reported by the server.
+func (o *Server) ApiVersion() string {
+	return o.apiVersion
+}
+
+// Close shuts down the server. It is not necessary to call Close when the
+// server was created by New, because the server is closed when the test ends.
+func (o *Server) Close() {
+	o.srv.Close()
+}
+
+// RequestCount returns the number of requests received with the specified
+// method and URL path, e.g. RequestCount("POST", "/api/aaa/login").
+func (o *Server) RequestCount(method, path string) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.requests[method+" "+path]
+}
+
+// Object returns a copy of the object stored at the specified API path, e.g.
+// "/api/resources/asn-pools/<id>". The boolean return value indicates whether
+// the object exists.
+func (o *Server) Object(path string) (map[string]any, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	parent, id := splitPath(path)
+	c, ok := o.collections[parent]
+	if !ok {
+		return nil, false
+	}
+
+	obj, ok := c.objects[id]
+	if !ok {
+		return nil, false
+	}
+
+	return c.render(obj), true
+}
+
+// ServeHTTP implements http.Handler.
+func (o *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.requests[r.Method+" "+path]++
+
+	switch {
+	case path == "/api/aaa/login":
+		o.serveLogin(w, r)
+		return
+	case path == "/api/version" || strings.HasPrefix(path, "/api/versions/"):
+		o.serveVersion(w, r)
+		return
+	}
+
+	if _, ok := o.tokens[r.Header.Get(authTokenHeader)]; !ok {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	switch {
+	case path == "/api/aaa/logout":
+		delete(o.tokens, r.Header.Get(authTokenHeader))
+		writeJson(w, http.StatusOK, struct{}{})
+	case path == "/api/blueprints" || strings.HasPrefix(path, "/api/blueprints/"):
+		o.serveBlueprints(w, r, path)
+	case path == aaaUsersPath || strings.HasPrefix(path, aaaUsersPath+"/"):
+		o.serveUsers(w, r, path)
+	case path == aaaProvidersPath || strings.HasPrefix(path, aaaProvidersPath+"/"):
+		o.serveProviders(w, r, path)
+	case strings.HasPrefix(path, aaaRolesPath+"/") && strings.Contains(path, blueprintPermissionsPart):
+		o.serveBlueprintPermission(w, r, path)
+	case strings.HasPrefix(path, platformPath+"/"):
+		o.servePlatform(w, r, path)
+	default:
+		o.serveCollection(w, r, path, nil)
+	}
+}
+
+func (o *Server) serveLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var creds struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if !readJson(w, r, &creds) {
+		return
+	}
+
+	if creds.Username != o.username || creds.Password != o.password {
+		writeError(w, http.StatusUnauthorized, "invalid credentials")
+		return
+	}
+
+	token := randomHex(32)
+	o.tokens[token] = struct{}{}
+
+	writeJson(w, http.StatusCreated, map[string]string{
+		"token": token,
+		"id":    "mock-" + creds.Username,
+	})
+}
+
+func (o *Server) serveVersion(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	major, minor, _ := strings.Cut(o.apiVersion, ".")
+	minor, _, _ = strings.Cut(minor, ".")
+
+	writeJson(w, http.StatusOK, map[string]string{
+		"version": o.apiVersion,
+		"major":   major,
+		"minor":   minor,
+		"build":   o.apiVersion + "-1",
+		"status":  "OK",
+	})
+}
+
+// newId returns a new object ID. IDs are predictable so that test failures
+// are easy to reason about.
+func (o *Server) newId() string {
+	o.nextId++
+	return fmt.Sprintf("mock-%06d", o.nextId)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n/2)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func now() string {
+	return time.Now().UTC().Format(timestampFormat)
+}
+
+// splitPath separates the final element of a URL path from its parent.
+func splitPath(path string) (string, string) {
+	path = strings.TrimSuffix(path, "/")
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return "", path
+	}
+	return path[:i], path[i+1:]
+}
+
+func readJson(w http.ResponseWriter, r *http.Request, v any) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("failed to parse request body - %s", err))
+		return false
+	}
+	return true
+}
+
+func writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJson(w, status, map[string]string{"errors": msg})
+}
@@ -0,0 +1,422 @@
+package mockapstra
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testClient struct {
+	t      *testing.T
+	srv    *Server
+	client *http.Client
+	token  string
+}
+
+func newTestClient(t *testing.T, srv *Server) *testClient {
+	return &testClient{
+		t:      t,
+		srv:    srv,
+		client: &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}},
+	}
+}
+
+func (o *testClient) do(method, path string, in any, out any) int {
+	o.t.Helper()
+
+	var body bytes.Buffer
+	if in != nil {
+		require.NoError(o.t, json.NewEncoder(&body).Encode(in))
+	}
+
+	req, err := http.NewRequest(method, o.srv.Url()+path, &body)
+	require.NoError(o.t, err)
+	if o.token != "" {
+		req.Header.Set(authTokenHeader, o.token)
+	}
+
+	resp, err := o.client.Do(req)
+	require.NoError(o.t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	if out != nil {
+		require.NoError(o.t, json.NewDecoder(resp.Body).Decode(out))
+	}
+
+	return resp.StatusCode
+}
+
+func (o *testClient) login() {
+	o.t.Helper()
+
+	var resp struct {
+		Token string `json:"token"`
+	}
+	status := o.do(http.MethodPost, "/api/aaa/login", map[string]string{"username": o.srv.Username(), "password": o.srv.Password()}, &resp)
+	require.Equal(o.t, http.StatusCreated, status)
+	require.NotEmpty(o.t, resp.Token)
+	o.token = resp.Token
+}
+
+func TestLoginAndVersion(t *testing.T) {
+	srv := New(t, WithApiVersion("5.1.0"), WithCredentials("u", "p"))
+	c := newTestClient(t, srv)
+
+	var version map[string]string
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/api/version", nil, &version))
+	require.Equal(t, "5.1.0", version["version"])
+	require.Equal(t, "5", version["major"])
+	require.Equal(t, "1", version["minor"])
+
+	require.Equal(t, http.StatusUnauthorized, c.do(http.MethodGet, "/api/resources/asn-pools", nil, nil))
+	require.Equal(t, http.StatusUnauthorized, c.do(http.MethodPost, "/api/aaa/login", map[string]string{"username": "u", "password": "wrong"}, nil))
+
+	c.login()
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/api/resources/asn-pools", nil, nil))
+	require.Equal(t, 2, srv.RequestCount(http.MethodPost, "/api/aaa/login"))
+
+	require.Equal(t, http.StatusOK, c.do(http.MethodPost, "/api/aaa/logout", nil, nil))
+	require.Equal(t, http.StatusUnauthorized, c.do(http.MethodGet, "/api/resources/asn-pools", nil, nil))
+}
+
+func TestCollectionCrud(t *testing.T) {
+	srv := New(t)
+	c := newTestClient(t, srv)
+	c.login()
+
+	const path = "/api/resources/asn-pools"
+
+	var created struct {
+		Id string `json:"id"`
+	}
+	pool := map[string]any{"display_name": "a", "ranges": []map[string]int{{"first": 10, "last": 19}}}
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, path, pool, &created))
+	require.NotEmpty(t, created.Id)
+
+	var got map[string]any
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, path+"/"+created.Id, nil, &got))
+	require.Equal(t, "a", got["display_name"])
+	require.Equal(t, float64(10), got["total"])
+	require.Equal(t, "not_in_use", got["status"])
+	require.NotEmpty(t, got["created_at"])
+
+	var list struct {
+		Items []map[string]any `json:"items"`
+	}
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, path, nil, &list))
+	require.Len(t, list.Items, 1)
+
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPatch, path+"/"+created.Id, map[string]any{"display_name": "b"}, nil))
+	obj, ok := srv.Object(path + "/" + created.Id)
+	require.True(t, ok)
+	require.Equal(t, "b", obj["display_name"])
+
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, path+"/"+created.Id, map[string]any{"display_name": "c"}, nil))
+	obj, _ = srv.Object(path + "/" + created.Id)
+	require.Equal(t, "c", obj["display_name"])
+	require.NotContains(t, obj, "ranges")
+
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodDelete, path+"/"+created.Id, nil, nil))
+	require.Equal(t, http.StatusNotFound, c.do(http.MethodGet, path+"/"+created.Id, nil, nil))
+	require.Equal(t, http.StatusNotFound, c.do(http.MethodDelete, path+"/"+created.Id, nil, nil))
+
+	// caller-specified IDs are honored and must be unique
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, "/api/design/tags", map[string]any{"id": "x", "label": "x"}, nil))
+	require.Equal(t, http.StatusConflict, c.do(http.MethodPost, "/api/design/tags", map[string]any{"id": "x", "label": "x"}, nil))
+
+	// tag labels must be unique, as the blueprint mutex scheme expects
+	require.Equal(t, http.StatusConflict, c.do(http.MethodPost, "/api/design/tags", map[string]any{"id": "y", "label": "x"}, nil))
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, "/api/design/tags", map[string]any{"id": "y", "label": "y"}, nil))
+}
+
+func TestIpPoolDecoration(t *testing.T) {
+	srv := New(t)
+	c := newTestClient(t, srv)
+	c.login()
+
+	var created struct {
+		Id string `json:"id"`
+	}
+	pool := map[string]any{"display_name": "a", "subnets": []map[string]string{{"network": "10.0.0.0/24"}, {"network": "10.1.0.0/25"}}}
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, "/api/resources/ip-pools", pool, &created))
+
+	var got map[string]any
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/api/resources/ip-pools/"+created.Id, nil, &got))
+	require.Equal(t, "384", got["total"])
+}
+
+func TestBlueprintLifecycle(t *testing.T) {
+	srv := New(t)
+	c := newTestClient(t, srv)
+	c.login()
+
+	var created struct {
+		Id string `json:"id"`
+	}
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, "/api/blueprints", map[string]string{"label": "bp", "design": designDatacenter}, &created))
+	require.Equal(t, http.StatusConflict, c.do(http.MethodPost, "/api/blueprints", map[string]string{"label": "bp", "design": designDatacenter}, nil))
+	bpPath := "/api/blueprints/" + created.Id
+
+	var status map[string]any
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, bpPath, nil, &status))
+	require.Equal(t, "bp", status["label"])
+	require.Equal(t, true, status["has_uncommitted_changes"])
+
+	// the default routing zone is found by a simple graph query
+	var qr struct {
+		Count int                         `json:"count"`
+		Items []map[string]map[string]any `json:"items"`
+	}
+	require.Equal(t, http.StatusOK, c.do(http.MethodPost, bpPath+"/qe", map[string]string{"query": "node(type='security_zone', vrf_name='default', name='n_sz')"}, &qr))
+	require.Equal(t, 1, qr.Count)
+	require.Equal(t, "Default routing zone", qr.Items[0]["n_sz"]["label"])
+
+	// unsupported queries produce no results
+	require.Equal(t, http.StatusOK, c.do(http.MethodPost, bpPath+"/qe", map[string]string{"query": "node(type='system', name='n').out(type='hosted_interfaces')"}, &qr))
+	require.Zero(t, qr.Count)
+
+	// deploy the current revision
+	staged, deployed, ok := srv.BlueprintVersions(created.Id)
+	require.True(t, ok)
+	require.Zero(t, deployed)
+	require.Equal(t, http.StatusConflict, c.do(http.MethodPut, bpPath+"/deploy", map[string]any{"version": staged + 1}, nil))
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, bpPath+"/deploy", map[string]any{"version": staged, "description": "first"}, nil))
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, bpPath, nil, &status))
+	require.Equal(t, false, status["has_uncommitted_changes"])
+
+	// blueprint-scoped objects are stored generically and bump the revision
+	var vn struct {
+		Id string `json:"id"`
+	}
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, bpPath+"/virtual-networks", map[string]string{"label": "vn"}, &vn))
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, bpPath+"/virtual-networks/"+vn.Id, nil, nil))
+	newStaged, _, _ := srv.BlueprintVersions(created.Id)
+	require.Greater(t, newStaged, staged)
+
+	// seeded nodes are visible via the nodes API and can be patched
+	nodeId := srv.AddNode(created.Id, map[string]any{"type": "system", "label": "leaf1", "role": "leaf"})
+	var nodes struct {
+		Nodes map[string]map[string]any `json:"nodes"`
+	}
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, bpPath+"/nodes?node_type=system", nil, &nodes))
+	require.Len(t, nodes.Nodes, 1)
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPatch, bpPath+"/nodes/"+nodeId, map[string]any{"label": "leaf2"}, nil))
+	var node map[string]any
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, bpPath+"/nodes/"+nodeId, nil, &node))
+	require.Equal(t, "leaf2", node["label"])
+
+	// the operation graph reflects the most recent deployment
+	require.Equal(t, http.StatusOK, c.do(http.MethodPost, bpPath+"/qe?type=operation", map[string]string{"query": "node(type='system', name='n')"}, &qr))
+	require.Zero(t, qr.Count)
+	require.Equal(t, http.StatusOK, c.do(http.MethodPost, bpPath+"/qe?type=staging", map[string]string{"query": "node(type='system', name='n')"}, &qr))
+	require.Equal(t, 1, qr.Count)
+
+	// deleting the blueprint removes its objects
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodDelete, bpPath, nil, nil))
+	require.Equal(t, http.StatusNotFound, c.do(http.MethodGet, bpPath, nil, nil))
+	require.Equal(t, http.StatusNotFound, c.do(http.MethodGet, bpPath+"/virtual-networks/"+vn.Id, nil, nil))
+}
+
+func TestUserPasswords(t *testing.T) {
+	srv := New(t)
+	c := newTestClient(t, srv)
+	c.login()
+
+	// a password is required when creating a user
+	require.Equal(t, http.StatusUnprocessableEntity, c.do(http.MethodPost, "/api/aaa/users", map[string]any{"username": "a"}, nil))
+
+	var created struct {
+		Id string `json:"id"`
+	}
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, "/api/aaa/users", map[string]any{"username": "a", "password": "p1"}, &created))
+
+	// the password is never returned
+	var got map[string]any
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/api/aaa/users/"+created.Id, nil, &got))
+	require.Equal(t, "a", got["username"])
+	require.NotContains(t, got, "password")
+	password, ok := srv.UserPassword(created.Id)
+	require.True(t, ok)
+	require.Equal(t, "p1", password)
+
+	// an update without a password leaves the password unchanged
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, "/api/aaa/users/"+created.Id, map[string]any{"username": "a", "email": "a@b"}, nil))
+	password, _ = srv.UserPassword(created.Id)
+	require.Equal(t, "p1", password)
+	obj, _ := srv.Object("/api/aaa/users/" + created.Id)
+	require.Equal(t, "a@b", obj["email"])
+
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, "/api/aaa/users/"+created.Id, map[string]any{"username": "a", "password": "p2"}, nil))
+	password, _ = srv.UserPassword(created.Id)
+	require.Equal(t, "p2", password)
+
+	require.Equal(t, http.StatusNotFound, c.do(http.MethodPut, "/api/aaa/users/bogus", map[string]any{"username": "a"}, nil))
+}
+
+func TestProviderSecrets(t *testing.T) {
+	srv := New(t)
+	c := newTestClient(t, srv)
+	c.login()
+
+	provider := func(secret string) map[string]any {
+		config := map[string]any{"hostname": []string{"a"}}
+		if secret != "" {
+			config["key"] = secret
+		}
+		return map[string]any{"label": "p", "provider_type": "radius", "config": config}
+	}
+
+	var created struct {
+		Id string `json:"id"`
+	}
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, "/api/aaa/providers", provider("s1"), &created))
+
+	// the secret is never returned
+	var got map[string]any
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/api/aaa/providers/"+created.Id, nil, &got))
+	require.NotContains(t, got["config"], "key")
+	secret, ok := srv.ProviderSecret(created.Id)
+	require.True(t, ok)
+	require.Equal(t, "s1", secret)
+
+	// an update without a secret leaves the secret unchanged
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, "/api/aaa/providers/"+created.Id, provider(""), nil))
+	secret, _ = srv.ProviderSecret(created.Id)
+	require.Equal(t, "s1", secret)
+
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, "/api/aaa/providers/"+created.Id, provider("s2"), nil))
+	secret, _ = srv.ProviderSecret(created.Id)
+	require.Equal(t, "s2", secret)
+}
+
+func TestPlatformSettings(t *testing.T) {
+	srv := New(t)
+	c := newTestClient(t, srv)
+	c.login()
+
+	// settings which have never been written are empty
+	var got map[string]any
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/api/platform/ntp", nil, &got))
+	require.Empty(t, got)
+	require.Equal(t, http.StatusNotFound, c.do(http.MethodGet, "/api/platform/bogus", nil, nil))
+
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, "/api/platform/ntp", map[string]any{"servers": []string{"a"}}, nil))
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/api/platform/ntp", nil, &got))
+	require.Equal(t, []any{"a"}, got["servers"])
+
+	// the SMTP password is never returned, and is retained when omitted
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, "/api/platform/smtp", map[string]any{"host": "a", "password": "p1"}, nil))
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, "/api/platform/smtp", map[string]any{"host": "b"}, nil))
+	got = nil
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/api/platform/smtp", nil, &got))
+	require.Equal(t, "b", got["host"])
+	require.NotContains(t, got, "password")
+	password, ok := srv.SmtpPassword()
+	require.True(t, ok)
+	require.Equal(t, "p1", password)
+
+	// feature flags not listed in a PUT request are left unchanged
+	flags := func(enabled map[string]bool) map[string]any {
+		var result []map[string]any
+		for _, name := range []string{"a", "b"} {
+			if e, ok := enabled[name]; ok {
+				result = append(result, map[string]any{"name": name, "enabled": e})
+			}
+		}
+		return map[string]any{"feature_flags": result}
+	}
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, "/api/platform/feature-flags", flags(map[string]bool{"a": true, "b": true}), nil))
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, "/api/platform/feature-flags", flags(map[string]bool{"b": false}), nil))
+	setting, ok := srv.PlatformSetting("/api/platform/feature-flags")
+	require.True(t, ok)
+	require.Equal(t, []any{
+		map[string]any{"name": "a", "enabled": true},
+		map[string]any{"name": "b", "enabled": false},
+	}, setting["feature_flags"])
+}
+
+func TestBlueprintPermissions(t *testing.T) {
+	srv := New(t)
+	c := newTestClient(t, srv)
+	c.login()
+
+	bpId := srv.AddBlueprint("test")
+
+	var role struct {
+		Id string `json:"id"`
+	}
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, "/api/aaa/roles", map[string]any{"label": "r"}, &role))
+
+	path := "/api/aaa/roles/" + role.Id + "/blueprint-permissions/" + bpId
+	require.Equal(t, http.StatusNotFound, c.do(http.MethodGet, path, nil, nil))
+	require.Equal(t, http.StatusUnprocessableEntity, c.do(http.MethodPut, path, map[string]string{"permission": "admin"}, nil))
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodPut, path, map[string]string{"permission": "read_only"}, nil))
+
+	var got map[string]string
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, path, nil, &got))
+	require.Equal(t, "read_only", got["permission"])
+	permission, ok := srv.BlueprintPermission(role.Id, bpId)
+	require.True(t, ok)
+	require.Equal(t, "read_only", permission)
+
+	require.Equal(t, http.StatusAccepted, c.do(http.MethodDelete, path, nil, nil))
+	require.Equal(t, http.StatusNotFound, c.do(http.MethodGet, path, nil, nil))
+
+	// unknown roles and blueprints
+	require.Equal(t, http.StatusNotFound, c.do(http.MethodPut, "/api/aaa/roles/bogus/blueprint-permissions/"+bpId, map[string]string{"permission": "read_only"}, nil))
+	require.Equal(t, http.StatusNotFound, c.do(http.MethodPut, "/api/aaa/roles/"+role.Id+"/blueprint-permissions/bogus", map[string]string{"permission": "read_only"}, nil))
+}
+
+func TestParseSimpleNodeQuery(t *testing.T) {
+	type testCase struct {
+		query  string
+		name   string
+		filter map[string]string
+		ok     bool
+	}
+
+	testCases := map[string]testCase{
+		"simple": {
+			query:  "node(type='system', name='n_system')",
+			name:   "n_system",
+			filter: map[string]string{"type": "system"},
+			ok:     true,
+		},
+		"multiple_attributes": {
+			query:  " node( type='system' , role='leaf', name='n' ) ",
+			name:   "n",
+			filter: map[string]string{"type": "system", "role": "leaf"},
+			ok:     true,
+		},
+		"no_name": {
+			query: "node(type='system')",
+		},
+		"traversal": {
+			query: "node(type='system', name='n').out(type='hosted_interfaces')",
+		},
+		"non_string_value": {
+			query: "node(type='system', role=is_in(['leaf','spine']), name='n')",
+		},
+		"match": {
+			query: "match(node(type='system', name='n'))",
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			name, filter, ok := parseSimpleNodeQuery(tCase.query)
+			require.Equal(t, tCase.ok, ok)
+			if !tCase.ok {
+				return
+			}
+			require.Equal(t, tCase.name, name)
+			require.Equal(t, tCase.filter, filter)
+		})
+	}
+}
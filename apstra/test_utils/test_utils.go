@@ -36,6 +36,35 @@ type testConfig struct {
 	Password              string `hcl:"password,optional"`
 	ApiOpsDcId            string `hcl:"api_ops_dc_id,optional"`
 	TlsValidationDisabled bool   `hcl:"tls_validation_disabled,optional"`
+
+	ManagedDevice *ManagedDeviceConfig `hcl:"managed_device,block"`
+}
+
+// ManagedDeviceConfig describes a device which tests may onboard with an
+// offbox Agent. The device must not already be managed by Apstra.
+type ManagedDeviceConfig struct {
+	Ip       string `hcl:"ip"`
+	Platform string `hcl:"platform"`
+	Username string `hcl:"username"`
+	Password string `hcl:"password"`
+}
+
+// GetManagedDeviceConfig returns the device described by the managed_device
+// block of the test configuration file. The test is skipped when no such
+// block exists.
+func GetManagedDeviceConfig(t testing.TB) ManagedDeviceConfig {
+	t.Helper()
+
+	TestCfgFileToEnv(t)
+
+	testCfgMutex.Lock()
+	defer testCfgMutex.Unlock()
+
+	if testCfg.ManagedDevice == nil {
+		t.Skipf("test %s requires a managed_device block in %s", t.Name(), testConfigFile)
+	}
+
+	return *testCfg.ManagedDevice
 }
 
 func GetTestClient(t testing.TB, ctx context.Context) *apstra.Client {
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const mutexLeaseTimeFormat = time.RFC3339
+
+// mutexLeaseRegexp matches the lease suffix which MutexLease.String() appends
+// to the human-readable blueprint mutex message.
+var mutexLeaseRegexp = regexp.MustCompile(`^(?s)(.*?)\s*\[lease acquired=(\S+) ttl=(\S+)]$`)
+
+// MutexLease describes the lifetime of a blueprint mutex. It is embedded in the
+// mutex message (the description of the mutex tag) so that other clients can
+// determine when an abandoned mutex may be safely broken.
+type MutexLease struct {
+	Message  string        // human-readable portion of the mutex message
+	Acquired time.Time     // time at which the mutex was locked
+	TTL      time.Duration // zero indicates the mutex never expires
+}
+
+// String renders the lease as a mutex message. A lease with no TTL renders as
+// the bare message so that mutexes created without a TTL look the same as
+// those created by earlier releases.
+func (o MutexLease) String() string {
+	if o.TTL <= 0 {
+		return o.Message
+	}
+
+	return fmt.Sprintf("%s [lease acquired=%s ttl=%s]", o.Message, o.Acquired.UTC().Format(mutexLeaseTimeFormat), o.TTL)
+}
+
+// Expires returns the time at which the lease expires. The boolean return
+// value is false when the lease has no TTL.
+func (o MutexLease) Expires() (time.Time, bool) {
+	if o.TTL <= 0 {
+		return time.Time{}, false
+	}
+
+	return o.Acquired.Add(o.TTL), true
+}
+
+// Expired returns true when the lease has a TTL which elapsed before now.
+func (o MutexLease) Expired(now time.Time) bool {
+	expires, ok := o.Expires()
+	return ok && now.After(expires)
+}
+
+// ParseMutexLease parses a mutex message. Messages which do not include a lease
+// (because they were created without a TTL, by an earlier release, or by some
+// other automation system) are returned with only the Message field populated.
+func ParseMutexLease(s string) MutexLease {
+	m := mutexLeaseRegexp.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return MutexLease{Message: s}
+	}
+
+	acquired, err := time.Parse(mutexLeaseTimeFormat, m[2])
+	if err != nil {
+		return MutexLease{Message: s}
+	}
+
+	ttl, err := time.ParseDuration(m[3])
+	if err != nil || ttl <= 0 {
+		return MutexLease{Message: s}
+	}
+
+	return MutexLease{
+		Message:  m[1],
+		Acquired: acquired,
+		TTL:      ttl,
+	}
+}
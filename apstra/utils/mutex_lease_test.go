@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutexLeaseRoundTrip(t *testing.T) {
+	acquired := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		lease    MutexLease
+		expected string
+	}
+
+	testCases := map[string]testCase{
+		"with_ttl": {
+			lease:    MutexLease{Message: "locked by terraform", Acquired: acquired, TTL: 30 * time.Minute},
+			expected: "locked by terraform [lease acquired=2026-10-16T12:00:00Z ttl=30m0s]",
+		},
+		"without_ttl": {
+			lease:    MutexLease{Message: "locked by terraform"},
+			expected: "locked by terraform",
+		},
+		"multiline_message": {
+			lease:    MutexLease{Message: "locked by\njenkins", Acquired: acquired, TTL: time.Hour},
+			expected: "locked by\njenkins [lease acquired=2026-10-16T12:00:00Z ttl=1h0m0s]",
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			s := tCase.lease.String()
+			require.Equal(t, tCase.expected, s)
+			require.Equal(t, tCase.lease, ParseMutexLease(s))
+		})
+	}
+}
+
+func TestParseMutexLease(t *testing.T) {
+	type testCase struct {
+		in       string
+		expected MutexLease
+	}
+
+	testCases := map[string]testCase{
+		"legacy_message": {
+			in:       "locked by terraform at 2024-01-01 00:00:00 +0000 UTC",
+			expected: MutexLease{Message: "locked by terraform at 2024-01-01 00:00:00 +0000 UTC"},
+		},
+		"empty": {
+			in:       "",
+			expected: MutexLease{},
+		},
+		"bad_time": {
+			in:       "x [lease acquired=yesterday ttl=1h]",
+			expected: MutexLease{Message: "x [lease acquired=yesterday ttl=1h]"},
+		},
+		"bad_ttl": {
+			in:       "x [lease acquired=2026-10-16T12:00:00Z ttl=forever]",
+			expected: MutexLease{Message: "x [lease acquired=2026-10-16T12:00:00Z ttl=forever]"},
+		},
+		"zero_ttl": {
+			in:       "x [lease acquired=2026-10-16T12:00:00Z ttl=0s]",
+			expected: MutexLease{Message: "x [lease acquired=2026-10-16T12:00:00Z ttl=0s]"},
+		},
+		"lease_only": {
+			in:       "[lease acquired=2026-10-16T12:00:00Z ttl=90s]",
+			expected: MutexLease{Acquired: time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), TTL: 90 * time.Second},
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tCase.expected, ParseMutexLease(tCase.in))
+		})
+	}
+}
+
+func TestMutexLeaseExpired(t *testing.T) {
+	acquired := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	lease := MutexLease{Acquired: acquired, TTL: time.Hour}
+
+	require.False(t, lease.Expired(acquired.Add(59*time.Minute)))
+	require.True(t, lease.Expired(acquired.Add(61*time.Minute)))
+	require.False(t, MutexLease{Acquired: acquired}.Expired(acquired.Add(24*time.Hour)))
+}
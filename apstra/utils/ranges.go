@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxRangeExpansion limits the number of values produced by ExpandIntRanges
+// so that a typo like "1-4000000000" can't exhaust memory.
+const maxRangeExpansion = 65536
+
+// ExpandIntRanges expands a comma-separated list of integers and hyphenated
+// ranges (e.g. "10-12,20") into the individual values ([10, 11, 12, 20]).
+// Whitespace around elements is ignored. Every value must fall between min
+// and max (inclusive). Values are returned in the order specified; duplicates
+// are an error.
+func ExpandIntRanges(s string, min, max int) ([]int, error) {
+	var result []int
+	seen := make(map[int]bool)
+
+	for _, element := range strings.Split(s, ",") {
+		element = strings.TrimSpace(element)
+		if element == "" {
+			return nil, fmt.Errorf("range %q contains an empty element", s)
+		}
+
+		first, last, isRange := strings.Cut(element, "-")
+
+		start, err := strconv.Atoi(strings.TrimSpace(first))
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %q in range %q", element, s)
+		}
+
+		end := start
+		if isRange {
+			end, err = strconv.Atoi(strings.TrimSpace(last))
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse %q in range %q", element, s)
+			}
+		}
+
+		if start > end {
+			return nil, fmt.Errorf("range element %q in %q is descending", element, s)
+		}
+		if start < min || end > max {
+			return nil, fmt.Errorf("range element %q in %q must fall between %d and %d", element, s, min, max)
+		}
+		if len(result)+(end-start+1) > maxRangeExpansion {
+			return nil, fmt.Errorf("range %q expands to more than %d values", s, maxRangeExpansion)
+		}
+
+		for i := start; i <= end; i++ {
+			if seen[i] {
+				return nil, fmt.Errorf("range %q includes %d more than once", s, i)
+			}
+			seen[i] = true
+			result = append(result, i)
+		}
+	}
+
+	return result, nil
+}
+
+// interfaceRangeRegexp splits an interface name range into the fixed prefix
+// and the trailing port specification. The port specification is either a
+// bracketed list (Junos: "ge-0/0/[0-3,5]") or a bare list (EOS:
+// "Ethernet1/1-4,7" or Junos: "xe-0/0/0-3").
+var interfaceRangeRegexp = regexp.MustCompile(`^(.*?)(\[[0-9][0-9,\- ]*\]|[0-9]+(?:\s*-\s*[0-9]+)?(?:\s*,\s*[0-9]+(?:\s*-\s*[0-9]+)?)*)$`)
+
+// ExpandInterfaceNames expands an interface name range in either Junos or EOS
+// style into individual interface names. Only the final numeric component
+// of the name may be a range. Examples:
+//
+//	"ge-0/0/[0-2]"   -> ["ge-0/0/0", "ge-0/0/1", "ge-0/0/2"]
+//	"xe-0/0/4-5"     -> ["xe-0/0/4", "xe-0/0/5"]
+//	"Ethernet1/1-2"  -> ["Ethernet1/1", "Ethernet1/2"]
+//	"Ethernet3,5-6"  -> ["Ethernet3", "Ethernet5", "Ethernet6"]
+//	"et-0/0/1"       -> ["et-0/0/1"]
+func ExpandInterfaceNames(s string) ([]string, error) {
+	m := interfaceRangeRegexp.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || m[1] == "" {
+		return nil, fmt.Errorf("cannot parse %q as an interface name or range", s)
+	}
+
+	prefix, spec := m[1], strings.TrimSuffix(strings.TrimPrefix(m[2], "["), "]")
+
+	ports, err := ExpandIntRanges(spec, 0, maxRangeExpansion)
+	if err != nil {
+		return nil, fmt.Errorf("cannot expand interface range %q: %w", s, err)
+	}
+
+	result := make([]string, len(ports))
+	for i, port := range ports {
+		result[i] = prefix + strconv.Itoa(port)
+	}
+
+	return result, nil
+}
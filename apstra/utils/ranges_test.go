@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandIntRanges(t *testing.T) {
+	type testCase struct {
+		in       string
+		min      int
+		max      int
+		expected []int
+		expErr   bool
+	}
+
+	testCases := map[string]testCase{
+		"single":          {in: "10", min: 1, max: 4094, expected: []int{10}},
+		"range":           {in: "10-12", min: 1, max: 4094, expected: []int{10, 11, 12}},
+		"mixed":           {in: "10-12,20", min: 1, max: 4094, expected: []int{10, 11, 12, 20}},
+		"whitespace":      {in: " 5 , 7 - 8 ", min: 1, max: 4094, expected: []int{5, 7, 8}},
+		"order_preserved": {in: "20,10", min: 1, max: 4094, expected: []int{20, 10}},
+		"degenerate":      {in: "3-3", min: 1, max: 4094, expected: []int{3}},
+		"empty":           {in: "", min: 1, max: 4094, expErr: true},
+		"empty_element":   {in: "1,,2", min: 1, max: 4094, expErr: true},
+		"descending":      {in: "12-10", min: 1, max: 4094, expErr: true},
+		"below_min":       {in: "0-2", min: 1, max: 4094, expErr: true},
+		"above_max":       {in: "4090-4095", min: 1, max: 4094, expErr: true},
+		"duplicate":       {in: "1-3,2", min: 1, max: 4094, expErr: true},
+		"bogus":           {in: "ten", min: 1, max: 4094, expErr: true},
+		"too_many":        {in: "0-70000", min: 0, max: 100000, expErr: true},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := ExpandIntRanges(tCase.in, tCase.min, tCase.max)
+			if tCase.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
+
+func TestExpandInterfaceNames(t *testing.T) {
+	type testCase struct {
+		in       string
+		expected []string
+		expErr   bool
+	}
+
+	testCases := map[string]testCase{
+		"junos_single":      {in: "et-0/0/1", expected: []string{"et-0/0/1"}},
+		"junos_brackets":    {in: "ge-0/0/[0-2]", expected: []string{"ge-0/0/0", "ge-0/0/1", "ge-0/0/2"}},
+		"junos_bracket_set": {in: "ge-0/0/[1,3-4]", expected: []string{"ge-0/0/1", "ge-0/0/3", "ge-0/0/4"}},
+		"junos_bare_range":  {in: "xe-0/0/4-5", expected: []string{"xe-0/0/4", "xe-0/0/5"}},
+		"junos_channelized": {in: "xe-0/0/0:[0-1]", expected: []string{"xe-0/0/0:0", "xe-0/0/0:1"}},
+		"eos_single":        {in: "Ethernet12", expected: []string{"Ethernet12"}},
+		"eos_range":         {in: "Ethernet1/1-2", expected: []string{"Ethernet1/1", "Ethernet1/2"}},
+		"eos_list":          {in: "Ethernet3,5-6", expected: []string{"Ethernet3", "Ethernet5", "Ethernet6"}},
+		"no_number":         {in: "Management", expErr: true},
+		"no_prefix":         {in: "1-4", expErr: true},
+		"descending":        {in: "Ethernet4-1", expErr: true},
+		"empty":             {in: "", expErr: true},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := ExpandInterfaceNames(tCase.in)
+			if tCase.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
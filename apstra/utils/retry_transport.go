@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// retryableConflictRegexp matches the error text Apstra returns when a
+// request is refused because the blueprint is busy being built. The same
+// request is expected to succeed once the build is complete.
+var retryableConflictRegexp = regexp.MustCompile(`(?i)(being|currently) built|build (is )?in progress`)
+
+// RetryPolicy describes how API requests which fail with a transient error are
+// retried. The zero value performs no retries.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a failed request is retried.
+	MaxRetries int
+
+	// WaitMin is the delay before the first retry. Each subsequent delay is
+	// doubled, up to WaitMax. Each delay is randomly reduced by up to half to
+	// avoid synchronized retries from parallel workers.
+	WaitMin time.Duration
+	WaitMax time.Duration
+
+	// Logger, when not nil, records each retry.
+	Logger *log.Logger
+}
+
+// backoff returns the delay before retry number attempt (0-based).
+func (o RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	// honor the server's Retry-After (seconds) header when present
+	if resp != nil {
+		if s := resp.Header.Get("Retry-After"); s != "" {
+			if seconds, err := strconv.Atoi(s); err == nil && seconds >= 0 {
+				return min(time.Duration(seconds)*time.Second, o.WaitMax)
+			}
+		}
+	}
+
+	wait := o.WaitMin
+	for i := 0; i < attempt && wait < o.WaitMax; i++ {
+		wait *= 2
+	}
+	wait = min(wait, o.WaitMax)
+
+	// jitter: random value in [wait/2, wait]
+	if half := int64(wait / 2); half > 0 {
+		wait = time.Duration(half + rand.Int64N(half+1))
+	}
+
+	return wait
+}
+
+// IsRetryableResponse determines whether a request which produced resp might
+// succeed if sent again unchanged. body is the response body, which is only
+// consulted for conflict responses.
+func IsRetryableResponse(method string, statusCode int, body []byte) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		// the server refused the request without acting on it
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		// the request may or may not have been acted upon, so only repeat
+		// requests which are safe to repeat
+		return isIdempotent(method)
+	case http.StatusConflict, http.StatusUnprocessableEntity:
+		return retryableConflictRegexp.Match(body)
+	}
+
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// NewRetryTransport returns an http.RoundTripper which sends requests via next,
+// retrying them according to policy when IsRetryableResponse indicates that
+// the failure is transient.
+func NewRetryTransport(next http.RoundTripper, policy RetryPolicy) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &retryTransport{next: next, policy: policy}
+}
+
+type retryTransport struct {
+	next   http.RoundTripper
+	policy RetryPolicy
+}
+
+// RoundTrip sends req, and sends a fresh clone of req for each retry. req
+// itself is never modified.
+func (o *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// we can only resend a request if its body can be replayed
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	r := req
+	for attempt := 0; ; attempt++ {
+		resp, err := o.next.RoundTrip(r)
+		if err != nil || attempt >= o.policy.MaxRetries {
+			return resp, err
+		}
+
+		var body []byte
+		if resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusUnprocessableEntity {
+			body, err = io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("reading %s %s response body: %w", req.Method, req.URL.Path, err)
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		if !IsRetryableResponse(req.Method, resp.StatusCode, body) {
+			return resp, nil
+		}
+
+		if !replayable {
+			o.logf("%s %s returned %q; not retried because the request body cannot be replayed",
+				req.Method, req.URL.Path, resp.Status)
+			return resp, nil
+		}
+
+		wait := o.policy.backoff(attempt, resp)
+		o.logf("%s %s returned %q; retry %d of %d in %s",
+			req.Method, req.URL.Path, resp.Status, attempt+1, o.policy.MaxRetries, wait)
+
+		// discard the failed response so the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		r = req.Clone(req.Context())
+		if req.GetBody != nil {
+			r.Body, err = req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("rewinding %s %s request body for retry: %w", req.Method, req.URL.Path, err)
+			}
+		}
+	}
+}
+
+func (o *retryTransport) logf(format string, v ...any) {
+	if o.policy.Logger != nil {
+		o.policy.Logger.Printf(format, v...)
+	}
+}
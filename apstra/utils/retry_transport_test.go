@@ -0,0 +1,256 @@
+package utils
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryableResponse(t *testing.T) {
+	type testCase struct {
+		method   string
+		status   int
+		body     string
+		expected bool
+	}
+
+	testCases := map[string]testCase{
+		"ok":                      {method: http.MethodGet, status: http.StatusOK, expected: false},
+		"too_many_requests_post":  {method: http.MethodPost, status: http.StatusTooManyRequests, expected: true},
+		"unavailable_patch":       {method: http.MethodPatch, status: http.StatusServiceUnavailable, expected: true},
+		"bad_gateway_get":         {method: http.MethodGet, status: http.StatusBadGateway, expected: true},
+		"bad_gateway_post":        {method: http.MethodPost, status: http.StatusBadGateway, expected: false},
+		"gateway_timeout_delete":  {method: http.MethodDelete, status: http.StatusGatewayTimeout, expected: true},
+		"gateway_timeout_patch":   {method: http.MethodPatch, status: http.StatusGatewayTimeout, expected: false},
+		"conflict_being_built":    {method: http.MethodPost, status: http.StatusConflict, body: `{"errors":"Blueprint is being built"}`, expected: true},
+		"conflict_build_progress": {method: http.MethodPatch, status: http.StatusUnprocessableEntity, body: `{"errors":"build in progress"}`, expected: true},
+		"conflict_other":          {method: http.MethodPost, status: http.StatusConflict, body: `{"errors":"name already exists"}`, expected: false},
+		"not_found":               {method: http.MethodGet, status: http.StatusNotFound, expected: false},
+		"internal_server_error":   {method: http.MethodGet, status: http.StatusInternalServerError, expected: false},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tCase.expected, IsRetryableResponse(tCase.method, tCase.status, []byte(tCase.body)))
+		})
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	type testCase struct {
+		method       string
+		body         string
+		failures     int
+		failStatus   int
+		failBody     string
+		maxRetries   int
+		expectStatus int
+		expectCalls  int32
+	}
+
+	testCases := map[string]testCase{
+		"success_first_try": {
+			method:       http.MethodGet,
+			maxRetries:   3,
+			expectStatus: http.StatusOK,
+			expectCalls:  1,
+		},
+		"success_after_retries": {
+			method:       http.MethodPost,
+			body:         `{"label":"foo"}`,
+			failures:     2,
+			failStatus:   http.StatusTooManyRequests,
+			maxRetries:   3,
+			expectStatus: http.StatusOK,
+			expectCalls:  3,
+		},
+		"retries_exhausted": {
+			method:       http.MethodGet,
+			failures:     5,
+			failStatus:   http.StatusServiceUnavailable,
+			maxRetries:   2,
+			expectStatus: http.StatusServiceUnavailable,
+			expectCalls:  3,
+		},
+		"retries_disabled": {
+			method:       http.MethodGet,
+			failures:     1,
+			failStatus:   http.StatusServiceUnavailable,
+			maxRetries:   0,
+			expectStatus: http.StatusServiceUnavailable,
+			expectCalls:  1,
+		},
+		"not_retryable": {
+			method:       http.MethodPost,
+			body:         `{"label":"foo"}`,
+			failures:     1,
+			failStatus:   http.StatusBadGateway,
+			maxRetries:   3,
+			expectStatus: http.StatusBadGateway,
+			expectCalls:  1,
+		},
+		"blueprint_being_built": {
+			method:       http.MethodPatch,
+			body:         `{"label":"foo"}`,
+			failures:     1,
+			failStatus:   http.StatusConflict,
+			failBody:     `{"errors":"Blueprint is being built"}`,
+			maxRetries:   3,
+			expectStatus: http.StatusOK,
+			expectCalls:  2,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := calls.Add(1)
+
+				// every attempt must carry the complete request body
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, tCase.body, string(body))
+
+				if int(n) <= tCase.failures {
+					w.WriteHeader(tCase.failStatus)
+					_, _ = w.Write([]byte(tCase.failBody))
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(server.Close)
+
+			client := http.Client{Transport: NewRetryTransport(nil, RetryPolicy{
+				MaxRetries: tCase.maxRetries,
+				WaitMin:    time.Millisecond,
+				WaitMax:    5 * time.Millisecond,
+			})}
+
+			req, err := http.NewRequest(tCase.method, server.URL, strings.NewReader(tCase.body))
+			require.NoError(t, err)
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = resp.Body.Close() })
+
+			require.Equal(t, tCase.expectStatus, resp.StatusCode)
+			require.Equal(t, tCase.expectCalls, calls.Load())
+
+			// the final response body must still be readable
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			if resp.StatusCode != http.StatusOK {
+				require.Equal(t, tCase.failBody, string(body))
+			}
+		})
+	}
+}
+
+func TestRetryTransportRequestNotModified(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	transport := NewRetryTransport(nil, RetryPolicy{MaxRetries: 1, WaitMin: time.Millisecond, WaitMax: time.Millisecond})
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"label":"foo"}`))
+	require.NoError(t, err)
+	originalBody := req.Body
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(2), calls.Load())
+	require.Equal(t, originalBody, req.Body)
+}
+
+func TestRetryTransportBodyNotReplayable(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	var logged strings.Builder
+	transport := NewRetryTransport(nil, RetryPolicy{
+		MaxRetries: 3,
+		WaitMin:    time.Millisecond,
+		WaitMax:    time.Millisecond,
+		Logger:     log.New(&logged, "", 0),
+	})
+
+	// a body without GetBody cannot be replayed
+	req, err := http.NewRequest(http.MethodPost, server.URL, io.NopCloser(strings.NewReader(`{"label":"foo"}`)))
+	require.NoError(t, err)
+	require.Nil(t, req.GetBody)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Equal(t, int32(1), calls.Load())
+	require.Contains(t, logged.String(), "cannot be replayed")
+}
+
+func TestRetryTransportContextCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	client := http.Client{Transport: NewRetryTransport(nil, RetryPolicy{
+		MaxRetries: 10,
+		WaitMin:    time.Hour,
+		WaitMax:    time.Hour,
+	})}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = client.Do(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Minute)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{WaitMin: time.Second, WaitMax: 10 * time.Second}
+
+	for attempt, ceiling := range []time.Duration{1, 2, 4, 8, 10, 10} {
+		ceiling *= time.Second
+		wait := policy.backoff(attempt, nil)
+		require.GreaterOrEqual(t, wait, ceiling/2)
+		require.LessOrEqual(t, wait, ceiling)
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	require.Equal(t, 3*time.Second, policy.backoff(0, resp))
+
+	resp.Header.Set("Retry-After", "3600")
+	require.Equal(t, 10*time.Second, policy.backoff(0, resp))
+}
@@ -0,0 +1,22 @@
+package utils
+
+import "strings"
+
+// WriteOnlyVersionDescription returns the MarkdownDescription of an attribute
+// which triggers re-sending of the named write-only attributes, e.g.
+// "password_wo", whose values are never saved in state.
+func WriteOnlyVersionDescription(writeOnlyAttributes ...string) string {
+	names := make([]string, len(writeOnlyAttributes))
+	for i, attribute := range writeOnlyAttributes {
+		names[i] = "`" + attribute + "`"
+	}
+
+	if len(names) == 1 {
+		return "Because " + names[0] + " is not saved in state, Terraform cannot detect changes to it. Change " +
+			"this value to have the current value of " + names[0] + " sent to Apstra."
+	}
+
+	list := strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+	return "Because " + list + " are not saved in state, Terraform cannot detect changes to them. Change this " +
+		"value to have their current values sent to Apstra."
+}
@@ -2,6 +2,7 @@ package apstravalidator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 
@@ -79,48 +80,27 @@ func (o fallsWithinCidrValidator) ValidateString(ctx context.Context, req valida
 				return
 			}
 
-			var allZeros net.IP
-			var subnet *net.IPNet
-			var err error
-			if mpString, ok := mpVal.(types.String); ok {
-				allZeros, subnet, err = net.ParseCIDR(mpString.ValueString())
-				if err != nil {
-					resp.Diagnostics.AddAttributeError(
-						mp, fmt.Sprintf("error parsing CIDR block %q",
-							mpString.ValueString()), err.Error(),
-					)
-					return
-				}
-			} else {
+			mpString, ok := mpVal.(types.String)
+			if !ok {
 				resp.Diagnostics.Append(validatordiag.BugInProviderDiagnostic(
 					fmt.Sprintf("attribute at %q must be types.String got %t",
 						o.expression, mpVal)))
 				return
 			}
 
-			ip := net.ParseIP(req.ConfigValue.ValueString())
-			if !subnet.Contains(ip) {
-				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-					req.Path,
-					fmt.Sprintf("value must fall within %s", subnet.String()),
-					req.ConfigValue.ValueString()))
-				return
-			}
-
-			if ip.Equal(allZeros) && !o.allZerosOk {
-				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-					req.Path,
-					"value must not be the all-zeros address %s",
-					req.ConfigValue.ValueString()))
-				return
-			}
+			err := IpFallsWithinCidr(net.ParseIP(req.ConfigValue.ValueString()), mpString.ValueString(), o.allZerosOk, o.allOnesOk)
+			if err != nil {
+				var cidrErr cidrParseError
+				if errors.As(err, &cidrErr) {
+					resp.Diagnostics.AddAttributeError(
+						mp, fmt.Sprintf("error parsing CIDR block %q",
+							mpString.ValueString()), cidrErr.err.Error(),
+					)
+					return
+				}
 
-			allOnes := netaddr.BroadcastAddr(subnet)
-			if ip.Equal(allOnes) && !o.allOnesOk {
 				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-					req.Path,
-					"value must not be the all-ones address %s",
-					req.ConfigValue.ValueString()))
+					req.Path, err.Error(), req.ConfigValue.ValueString()))
 				return
 			}
 		}
@@ -138,3 +118,42 @@ func FallsWithinCidr(e path.Expression, allZerosOk bool, allOnesOk bool) validat
 		allOnesOk:  allOnesOk,
 	}
 }
+
+// cidrParseError is returned by IpFallsWithinCidr when the CIDR block cannot
+// be parsed.
+type cidrParseError struct {
+	err error
+}
+
+func (o cidrParseError) Error() string {
+	return o.err.Error()
+}
+
+func (o cidrParseError) Unwrap() error {
+	return o.err
+}
+
+// IpFallsWithinCidr returns an error if ip does not fall within the CIDR block
+// cidr. Arguments allZerosOk and allOnesOk have the same meaning as with
+// FallsWithinCidr. The "all zeros" address is the address portion of cidr.
+func IpFallsWithinCidr(ip net.IP, cidr string, allZerosOk bool, allOnesOk bool) error {
+	allZeros, subnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return cidrParseError{err: err}
+	}
+
+	if !subnet.Contains(ip) {
+		return fmt.Errorf("value must fall within %s", subnet.String())
+	}
+
+	if ip.Equal(allZeros) && !allZerosOk {
+		return fmt.Errorf("value must not be the all-zeros address %s", allZeros.String())
+	}
+
+	allOnes := netaddr.BroadcastAddr(subnet)
+	if ip.Equal(allOnes) && !allOnesOk {
+		return fmt.Errorf("value must not be the all-ones address %s", allOnes.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package apstravalidator
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIpFallsWithinCidr(t *testing.T) {
+	type testCase struct {
+		ip         string
+		cidr       string
+		allZerosOk bool
+		allOnesOk  bool
+		expErr     bool
+	}
+
+	testCases := map[string]testCase{
+		"within":               {ip: "192.0.2.10", cidr: "192.0.2.0/24"},
+		"outside":              {ip: "198.51.100.1", cidr: "192.0.2.0/24", expErr: true},
+		"all_zeros_rejected":   {ip: "192.0.2.0", cidr: "192.0.2.0/24", expErr: true},
+		"all_zeros_permitted":  {ip: "192.0.2.0", cidr: "192.0.2.0/24", allZerosOk: true},
+		"all_ones_rejected":    {ip: "192.0.2.255", cidr: "192.0.2.0/24", expErr: true},
+		"all_ones_permitted":   {ip: "192.0.2.255", cidr: "192.0.2.0/24", allOnesOk: true},
+		"ipv6_within":          {ip: "2001:db8::5", cidr: "2001:db8::/64"},
+		"ipv6_outside":         {ip: "2001:db9::5", cidr: "2001:db8::/64", expErr: true},
+		"bad_ip":               {ip: "bogus", cidr: "192.0.2.0/24", expErr: true},
+		"bad_cidr":             {ip: "192.0.2.1", cidr: "192.0.2.0/33", expErr: true},
+		"address_family_mixup": {ip: "2001:db8::5", cidr: "192.0.2.0/24", expErr: true},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			err := IpFallsWithinCidr(net.ParseIP(tCase.ip), tCase.cidr, tCase.allZerosOk, tCase.allOnesOk)
+			if tCase.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
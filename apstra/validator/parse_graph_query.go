@@ -0,0 +1,91 @@
+package apstravalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = ParseGraphQueryValidator{}
+
+// graphQueryStartRegexp matches the leading function call of a QE graph query,
+// e.g. "node(" or "match(".
+var graphQueryStartRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*\s*\(`)
+
+type ParseGraphQueryValidator struct{}
+
+func (o ParseGraphQueryValidator) Description(_ context.Context) string {
+	return "Ensures that the supplied value is a syntactically valid QE graph query"
+}
+
+func (o ParseGraphQueryValidator) MarkdownDescription(ctx context.Context) string {
+	return o.Description(ctx)
+}
+
+func (o ParseGraphQueryValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := parseGraphQuery(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path, "value must be a valid graph query: "+err.Error(), req.ConfigValue.ValueString()))
+	}
+}
+
+func ParseGraphQuery() validator.String {
+	return ParseGraphQueryValidator{}
+}
+
+// parseGraphQuery performs a shallow syntax check of a QE graph query like
+// `node('system', name='system').out('hosted_interfaces').node('interface')`.
+// It ensures that the query begins with a function call, that string literals
+// are terminated, and that brackets are balanced. It does not ensure that the
+// node types, relationships or properties exist in the graph.
+func parseGraphQuery(s string) error {
+	s = strings.TrimSpace(s)
+	if !graphQueryStartRegexp.MatchString(s) {
+		return fmt.Errorf("query must begin with a function call such as %q or %q", "node(", "match(")
+	}
+
+	closers := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	var stack []rune
+	var quote rune
+	var escaped bool
+	for i, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0 && r == '\\':
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '(' || r == '[' || r == '{':
+			stack = append(stack, r)
+		case closers[r] != 0:
+			if len(stack) == 0 || stack[len(stack)-1] != closers[r] {
+				return fmt.Errorf("unexpected %q at position %d", r, i)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if quote != 0 {
+		return fmt.Errorf("unterminated string literal")
+	}
+	if len(stack) != 0 {
+		return fmt.Errorf("unclosed %q", stack[len(stack)-1])
+	}
+	if !strings.HasSuffix(s, ")") {
+		return fmt.Errorf("query must end with a function call")
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package apstravalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGraphQueryValidator(t *testing.T) {
+	ctx := context.Background()
+
+	type testCase struct {
+		query     string
+		expectErr bool
+	}
+
+	testCases := map[string]testCase{
+		"node": {
+			query: `node('system', name='system')`,
+		},
+		"traversal": {
+			query: `node("system", name="system", role=is_in(["spine", "leaf"])).out("hosted_interfaces").node("interface", name="iface")`,
+		},
+		"match": {
+			query: `match(node('system', name='s'), optional(node('interface', name='i')))`,
+		},
+		"parens_in_string": {
+			query: `node('system', label='(weird]')`,
+		},
+		"escaped_quote": {
+			query: `node('system', label='it\'s')`,
+		},
+		"whitespace": {
+			query: "\n  node('system')\n",
+		},
+		"empty": {
+			query:     "",
+			expectErr: true,
+		},
+		"not_a_call": {
+			query:     "system",
+			expectErr: true,
+		},
+		"unclosed_paren": {
+			query:     `node('system'`,
+			expectErr: true,
+		},
+		"extra_paren": {
+			query:     `node('system'))`,
+			expectErr: true,
+		},
+		"mismatched_bracket": {
+			query:     `node('system', role=is_in(['spine')])`,
+			expectErr: true,
+		},
+		"unterminated_string": {
+			query:     `node('system)`,
+			expectErr: true,
+		},
+		"trailing_garbage": {
+			query:     `node('system').out`,
+			expectErr: true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue(tCase.query),
+			}
+			var response validator.StringResponse
+
+			ParseGraphQuery().ValidateString(ctx, request, &response)
+			if tCase.expectErr {
+				require.True(t, response.Diagnostics.HasError())
+			} else {
+				require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+			}
+		})
+	}
+}
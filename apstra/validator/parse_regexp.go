@@ -0,0 +1,35 @@
+package apstravalidator
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = ParseRegexpValidator{}
+
+type ParseRegexpValidator struct{}
+
+func (o ParseRegexpValidator) Description(_ context.Context) string {
+	return "Ensures that the supplied value is a valid regular expression"
+}
+
+func (o ParseRegexpValidator) MarkdownDescription(ctx context.Context) string {
+	return o.Description(ctx)
+}
+
+func (o ParseRegexpValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path, "value must be a valid regular expression: "+err.Error(), req.ConfigValue.ValueString()))
+	}
+}
+
+func ParseRegexp() validator.String {
+	return ParseRegexpValidator{}
+}
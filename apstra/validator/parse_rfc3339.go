@@ -0,0 +1,35 @@
+package apstravalidator
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = ParseRfc3339Validator{}
+
+type ParseRfc3339Validator struct{}
+
+func (o ParseRfc3339Validator) Description(_ context.Context) string {
+	return "Ensures that the supplied value is an RFC3339 timestamp"
+}
+
+func (o ParseRfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return o.Description(ctx)
+}
+
+func (o ParseRfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path, "value must be an RFC3339 timestamp (e.g. \"2006-01-02T15:04:05Z\")", req.ConfigValue.ValueString()))
+	}
+}
+
+func ParseRfc3339() validator.String {
+	return ParseRfc3339Validator{}
+}
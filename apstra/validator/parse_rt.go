@@ -2,6 +2,7 @@ package apstravalidator
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"net"
 	"strconv"
@@ -20,29 +21,29 @@ const (
 `
 )
 
-var _ validator.String = ParseRtValidator{}
-
-type ParseRtValidator struct{}
+// Route Target types, named for the RFC 4360 "Global Administrator" field.
+const (
+	RouteTargetTypeTwoOctetAs  = "two_octet_as"
+	RouteTargetTypeFourOctetAs = "four_octet_as"
+	RouteTargetTypeIpv4        = "ipv4"
+)
 
-func (o ParseRtValidator) Description(_ context.Context) string {
-	return "Ensures that the supplied can be parsed as a Route Target"
+// RouteTarget is a parsed Route Target string.
+type RouteTarget struct {
+	Type           string
+	Administrator  string
+	AssignedNumber uint32
 }
 
-func (o ParseRtValidator) MarkdownDescription(ctx context.Context) string {
-	return o.Description(ctx)
-}
-
-func (o ParseRtValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
-	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
-		return
-	}
+// ParseRouteTarget parses a string in one of the forms described by
+// rtFormatErr. Leading zeros are not permitted.
+func ParseRouteTarget(s string) (*RouteTarget, error) {
+	formatErr := fmt.Errorf("cannot parse %q as a Route Target: %s", s, rtFormatErr)
 
 	// split the RT string
-	parts := strings.Split(req.ConfigValue.ValueString(), rtSep)
+	parts := strings.Split(s, rtSep)
 	if len(parts) != 2 {
-		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-			req.Path, rtFormatErr, req.ConfigValue.ValueString()))
-		return
+		return nil, formatErr
 	}
 
 	var ipFound bool
@@ -53,58 +54,74 @@ func (o ParseRtValidator) ValidateString(_ context.Context, req validator.String
 
 		// is it IPv4?
 		if len(ip.To4()) != net.IPv4len {
-			resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-				req.Path, rtFormatErr, req.ConfigValue.ValueString()))
-			return
+			return nil, formatErr
 		}
 	}
 
 	// make sure "part 1" has length and no prepended zeros (if we haven't already decided it's an IPv4 address)
 	if !ipFound && (len(parts[0]) == 0 || (len(parts[0]) >= 2 && strings.HasPrefix(parts[0], "0"))) {
-		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-			req.Path, rtFormatErr, req.ConfigValue.ValueString()))
-		return
+		return nil, formatErr
 	}
 
 	// make sure "part 2" has length and no prepended zeros
 	if len(parts[1]) == 0 || (len(parts[1]) >= 2 && strings.HasPrefix(parts[1], "0")) {
-		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-			req.Path, rtFormatErr, req.ConfigValue.ValueString()))
-		return
+		return nil, formatErr
 	}
 
 	// determine whether we've got a 32-bit "first part", and thus require a 16-bit "second part"
-	var firstPartIs32bits bool
+	result := RouteTarget{Type: RouteTargetTypeTwoOctetAs, Administrator: parts[0]}
 	if ipFound {
-		firstPartIs32bits = true
+		result.Type = RouteTargetTypeIpv4
+		result.Administrator = ip.To4().String()
 	} else {
 		// try parsing p1 as a 32-bit value
 		p1, err := strconv.ParseUint(parts[0], 10, 32)
 		if err != nil {
-			resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-				req.Path, rtFormatErr, req.ConfigValue.ValueString()))
-			return
+			return nil, formatErr
 		}
 
 		// does p1 require 32 bits?
 		if p1 > math.MaxUint16 {
-			firstPartIs32bits = true
+			result.Type = RouteTargetTypeFourOctetAs
 		}
 	}
 
 	// try parsing p2 as a 32-bit value
 	p2, err := strconv.ParseUint(parts[1], 10, 32)
 	if err != nil {
-		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-			req.Path, rtFormatErr, req.ConfigValue.ValueString()))
-		return
+		return nil, formatErr
 	}
 
 	// p1 and p2 can't both be 32 bits
-	if firstPartIs32bits && p2 > math.MaxUint16 {
+	if result.Type != RouteTargetTypeTwoOctetAs && p2 > math.MaxUint16 {
+		return nil, formatErr
+	}
+
+	result.AssignedNumber = uint32(p2)
+	return &result, nil
+}
+
+var _ validator.String = ParseRtValidator{}
+
+type ParseRtValidator struct{}
+
+func (o ParseRtValidator) Description(_ context.Context) string {
+	return "Ensures that the supplied can be parsed as a Route Target"
+}
+
+func (o ParseRtValidator) MarkdownDescription(ctx context.Context) string {
+	return o.Description(ctx)
+}
+
+func (o ParseRtValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := ParseRouteTarget(req.ConfigValue.ValueString())
+	if err != nil {
 		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 			req.Path, rtFormatErr, req.ConfigValue.ValueString()))
-		return
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseRtValidator(t *testing.T) {
@@ -76,3 +77,47 @@ func TestParseRtValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRouteTarget(t *testing.T) {
+	type testCase struct {
+		in       string
+		expected *RouteTarget
+	}
+
+	testCases := map[string]testCase{
+		"two_octet_as": {
+			in:       "65535:4294967295",
+			expected: &RouteTarget{Type: RouteTargetTypeTwoOctetAs, Administrator: "65535", AssignedNumber: 4294967295},
+		},
+		"four_octet_as": {
+			in:       "65536:100",
+			expected: &RouteTarget{Type: RouteTargetTypeFourOctetAs, Administrator: "65536", AssignedNumber: 100},
+		},
+		"ipv4": {
+			in:       "192.0.2.1:7",
+			expected: &RouteTarget{Type: RouteTargetTypeIpv4, Administrator: "192.0.2.1", AssignedNumber: 7},
+		},
+		"zeros": {
+			in:       "0:0",
+			expected: &RouteTarget{Type: RouteTargetTypeTwoOctetAs, Administrator: "0", AssignedNumber: 0},
+		},
+		"leading_zero": {in: "01:1"},
+		"ipv6":         {in: "2001:db8::1:1"},
+		"too_big":      {in: "65536:65536"},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := ParseRouteTarget(tCase.in)
+			if tCase.expected == nil {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
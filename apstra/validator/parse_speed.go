@@ -3,12 +3,84 @@ package apstravalidator
 import (
 	"context"
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+// ValidSpeeds lists the link speeds accepted by ParseSpeed, in Apstra's
+// canonical format.
+var ValidSpeeds = []string{
+	"100M",
+	"1G",
+	"10G",
+	"25G",
+	"40G",
+	"50G",
+	"100G",
+	"200G",
+	"400G",
+	"800G",
+}
+
+var speedRegexp = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([MGT])(?:B(?:PS|/S|IT/S)?)?$`)
+
+// NormalizeSpeed converts a loosely formatted link speed (e.g. "10g",
+// "10 Gbps", "10000M", "0.1G") to the canonical format accepted by
+// ParseSpeed (e.g. "10G"). An error is returned if the speed cannot be parsed
+// or is not one of ValidSpeeds.
+func NormalizeSpeed(s string) (string, error) {
+	m := speedRegexp.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(s)))
+	if m == nil {
+		return "", fmt.Errorf("cannot parse %q as a link speed", s)
+	}
+
+	value, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse %q as a link speed: %w", s, err)
+	}
+
+	// convert to Mbps
+	switch m[2] {
+	case "G":
+		value *= 1000
+	case "T":
+		value *= 1000 * 1000
+	}
+
+	for _, valid := range ValidSpeeds {
+		if math.Abs(value-validSpeedMbps(valid)) < 0.001 {
+			return valid, nil
+		}
+	}
+
+	return "", fmt.Errorf("link speed %q must be one of '%s'", s, strings.Join(ValidSpeeds, "', '"))
+}
+
+// SpeedMbps returns the rate in Mbps of a link speed in any format accepted
+// by NormalizeSpeed.
+func SpeedMbps(s string) (int64, error) {
+	normalized, err := NormalizeSpeed(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(validSpeedMbps(normalized)), nil
+}
+
+// validSpeedMbps returns the rate in Mbps of one of ValidSpeeds.
+func validSpeedMbps(valid string) float64 {
+	result, _ := strconv.ParseFloat(valid[:len(valid)-1], 64)
+	if valid[len(valid)-1] == 'G' {
+		result *= 1000
+	}
+	return result
+}
+
 var _ validator.String = ParseSpeedValidator{}
 
 type ParseSpeedValidator struct{}
@@ -27,27 +99,14 @@ func (o ParseSpeedValidator) ValidateString(_ context.Context, req validator.Str
 	}
 
 	validMap := make(map[string]struct{})
-	validStrings := []string{
-		"100M",
-		"1G",
-		"10G",
-		"25G",
-		"40G",
-		"50G",
-		"100G",
-		"200G",
-		"400G",
-		"800G",
-	}
-
-	for _, s := range validStrings {
+	for _, s := range ValidSpeeds {
 		validMap[s] = struct{}{}
 	}
 
 	value := req.ConfigValue.ValueString()
 	if _, ok := validMap[value]; !ok {
 		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-			req.Path, fmt.Sprintf("value must be one of '%s'", strings.Join(validStrings, "', '")), value))
+			req.Path, fmt.Sprintf("value must be one of '%s'", strings.Join(ValidSpeeds, "', '")), value))
 		return
 	}
 }
@@ -0,0 +1,80 @@
+package apstravalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSpeed(t *testing.T) {
+	type testCase struct {
+		in       string
+		expected string
+		expErr   bool
+	}
+
+	testCases := map[string]testCase{
+		"canonical":      {in: "10G", expected: "10G"},
+		"lower_case":     {in: "25g", expected: "25G"},
+		"gbps":           {in: "100 Gbps", expected: "100G"},
+		"gb_per_sec":     {in: "40Gb/s", expected: "40G"},
+		"megabits":       {in: "1000M", expected: "1G"},
+		"fractional":     {in: "0.1G", expected: "100M"},
+		"terabit":        {in: "0.8T", expected: "800G"},
+		"mbps":           {in: "100mbps", expected: "100M"},
+		"whitespace":     {in: " 400G ", expected: "400G"},
+		"unsupported":    {in: "2.5G", expErr: true},
+		"no_unit":        {in: "10", expErr: true},
+		"bogus":          {in: "fast", expErr: true},
+		"empty":          {in: "", expErr: true},
+		"negative":       {in: "-10G", expErr: true},
+		"unknown_suffix": {in: "10Gx", expErr: true},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := NormalizeSpeed(tCase.in)
+			if tCase.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
+
+func TestSpeedMbps(t *testing.T) {
+	type testCase struct {
+		in       string
+		expected int64
+		expErr   bool
+	}
+
+	testCases := map[string]testCase{
+		"10G":         {in: "10G", expected: 10000},
+		"100M":        {in: "100M", expected: 100},
+		"lower_case":  {in: "25g", expected: 25000},
+		"gbps":        {in: "400 Gbps", expected: 400000},
+		"empty":       {in: "", expErr: true},
+		"unsupported": {in: "10T", expErr: true},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := SpeedMbps(tCase.in)
+			if tCase.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tCase.expected, result)
+		})
+	}
+}
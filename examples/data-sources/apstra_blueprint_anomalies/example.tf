@@ -372,3 +372,39 @@ output anomalies { value = data.apstra_blueprint_anomalies.anomalies }
 #    },
 #  ])
 #}
+
+# This example is intended for post-deployment verification. It waits up to
+# five minutes for critical BGP and cabling anomalies on two leaf switches to
+# clear, and fails if they do not.
+data "apstra_blueprint_anomalies" "post_deploy" {
+  blueprint_id = apstra_blueprint_deployment.deploy.blueprint_id
+  filter = {
+    severities = ["critical"]
+    types      = ["bgp", "cabling"]
+    system_ids = ["525400240557", "525400E19D40"]
+  }
+  wait_for_zero_seconds = 300
+}
+
+# This example lists current anomalies, omitting liveness anomalies for
+# generic systems (which are not monitored) and acknowledging BGP anomalies
+# on a leaf switch which is undergoing maintenance. Acknowledged anomalies
+# remain in `details` but are not included in `count`.
+data "apstra_blueprint_anomalies" "actionable" {
+  blueprint_id = "c8fe07d6-f631-46e6-ae2a-9c6f3929e6a3"
+  suppressions = [
+    {
+      action       = "suppress"
+      anomaly_type = "liveness"
+      role         = "generic"
+      comment      = "generic systems are not monitored"
+    },
+    {
+      action       = "acknowledge"
+      anomaly_type = "bgp"
+      identity     = { system_id = "525400ABCDEF" }
+      expires_at   = "2030-01-01T06:00:00Z"
+      comment      = "leaf 3 maintenance, CHG-1234"
+    },
+  ]
+}
@@ -0,0 +1,19 @@
+# When the blueprint is staged by a separate Terraform run (or by hand), this
+# data source previews the changes which will be pushed to devices by the next
+# deployment. Reviewers of `terraform plan` see the incremental configuration
+# for each affected system along with a summary of the staged graph changes.
+data "apstra_blueprint_deployment_preview" "example" {
+  blueprint_id = "a52f3e4b-5c0c-4e23-9c19-cb7a4c5ab1a6"
+}
+
+output "systems_touched_by_deployment" {
+  value = keys(data.apstra_blueprint_deployment_preview.example.pending_config)
+}
+
+output "staged_node_changes" {
+  value = {
+    added   = data.apstra_blueprint_deployment_preview.example.pending_nodes_added
+    removed = data.apstra_blueprint_deployment_preview.example.pending_nodes_removed
+    changed = data.apstra_blueprint_deployment_preview.example.pending_nodes_changed
+  }
+}
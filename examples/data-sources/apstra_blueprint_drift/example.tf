@@ -0,0 +1,26 @@
+# This example reports virtual networks and routing zones which have been
+# changed in the staging Blueprint, but not yet deployed.
+
+data "apstra_blueprint_drift" "example" {
+  blueprint_id = "abc-123"
+  node_types   = ["virtual_network", "security_zone"]
+}
+
+output "undeployed_changes" {
+  value = {
+    for node in coalesce(data.apstra_blueprint_drift.example.nodes, []) :
+    node.id => "${node.type} ${coalesce(node.label, "(no label)")} ${node.status}: ${join(", ", coalesce(node.attributes, []))}"
+  }
+}
+
+# Output looks like:
+# undeployed_changes = {
+#   "AjAuUuVLylXCUgAqaQ" = "virtual_network vn_100 changed: label, vn_id"
+# }
+
+# To have resources managed by Terraform report these changes as plan
+# warnings, enable drift warnings in the provider configuration:
+#
+# provider "apstra" {
+#   blueprint_drift_warnings = true
+# }
@@ -3,18 +3,18 @@
 data "apstra_datacenter_blueprint" "b" {
   name = "test"
 }
-data "apstra_iba_dashboards" "all" {
+data "apstra_blueprint_iba_dashboards" "all" {
   blueprint_id = data.apstra_datacenter_blueprint.b.id
 }
 
-data "apstra_iba_dashboard" "all" {
-  for_each = data.apstra_iba_dashboards.all.ids
+data "apstra_blueprint_iba_dashboard" "all" {
+  for_each = data.apstra_blueprint_iba_dashboards.all.ids
   blueprint_id = data.apstra_datacenter_blueprint.b.id
   id = each.key
 }
 
 output "od" {
-  value = data.apstra_iba_dashboard.all
+  value = data.apstra_blueprint_iba_dashboard.all
 }
 
 # Output looks something like this
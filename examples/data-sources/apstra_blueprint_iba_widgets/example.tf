@@ -1,3 +1,4 @@
+# This example pulls all the iba widgets from a blueprint
 
 data "apstra_datacenter_blueprint" "b" {
   name = "test"
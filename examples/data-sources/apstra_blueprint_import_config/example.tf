@@ -0,0 +1,40 @@
+# This example writes Terraform resource and import blocks for the Routing
+# Zones and Virtual Networks in an existing Datacenter Blueprint to a file.
+# Running `terraform plan` in a directory containing that file should show
+# each object being imported with no changes.
+data "apstra_blueprint_import_config" "example" {
+  blueprint_id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d"
+
+  // optional filter; all supported resource types are discovered when omitted
+  resource_types = [
+    "apstra_datacenter_routing_zone",
+    "apstra_datacenter_virtual_network",
+  ]
+}
+
+resource "local_file" "imports" {
+  filename = "brownfield/imports.tf"
+  content = join("\n", [
+    data.apstra_blueprint_import_config.example.resource_blocks,
+    data.apstra_blueprint_import_config.example.import_blocks,
+  ])
+}
+
+# The `resource_blocks` attribute contains configuration like:
+#
+# resource "apstra_datacenter_routing_zone" "blue" {
+#   blueprint_id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d"
+#   name         = "blue"
+#   vlan_id      = 10
+#   vni          = 10010
+# }
+
+# The `resources` attribute details each discovered object, keyed by the
+# resource address used in the import blocks:
+#
+# "apstra_datacenter_routing_zone.blue" = {
+#   "import_id"     = "007723b7-a387-4bb3-8a5e-b5e9f265de0d:Ns0Y8ZQbVHDnGsPbgVQ"
+#   "label"         = "blue"
+#   "resource_name" = "blue"
+#   "resource_type" = "apstra_datacenter_routing_zone"
+# }
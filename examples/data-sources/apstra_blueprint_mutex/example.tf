@@ -0,0 +1,28 @@
+# This example reports on the mutex which protects a Blueprint, and fails the
+# plan when a stale mutex has been left behind by some other client.
+data "apstra_blueprint_mutex" "example" {
+  blueprint_id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d"
+
+  lifecycle {
+    postcondition {
+      condition     = !self.expired
+      error_message = format("Blueprint mutex %s has an expired lease. It was locked with message: %s", self.tag_name, self.message)
+    }
+  }
+}
+
+output "blueprint_mutex" {
+  value = {
+    locked     = data.apstra_blueprint_mutex.example.locked
+    message    = data.apstra_blueprint_mutex.example.message
+    expires_at = data.apstra_blueprint_mutex.example.expires_at
+  }
+}
+
+# The output will look something like this:
+#
+# blueprint_mutex = {
+#   "expires_at" = "2026-10-16T13:00:00Z"
+#   "locked" = true
+#   "message" = "locked by terraform at 2026-10-16 12:00:00.123456 +0000 UTC"
+# }
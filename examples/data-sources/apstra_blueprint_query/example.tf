@@ -13,3 +13,23 @@ data "apstra_blueprint_query" "query_leafs" {
 locals {
   query_leafs_result = data.apstra_blueprint_query.query_leafs.result
 }
+
+# This example runs a parameterized query against the deployed (active)
+# Blueprint and uses the decoded result to build a map of leaf switch
+# serial numbers keyed by hostname, without calling jsondecode().
+
+data "apstra_blueprint_query" "deployed_leafs" {
+  blueprint_id = local.blueprint_id
+  graph        = "active"
+  query        = "node('system', role=$role, name='n_system')"
+  parameters = {
+    role = "leaf"
+  }
+}
+
+locals {
+  leaf_serial_numbers = {
+    for item in data.apstra_blueprint_query.deployed_leafs.result_decoded :
+    item.n_system.hostname => item.n_system.system_id
+  }
+}
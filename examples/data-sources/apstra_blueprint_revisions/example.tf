@@ -0,0 +1,18 @@
+# This example lists the deployed revisions retained by Apstra for a
+# blueprint and outputs the revision ID and commit comment of each.
+data "apstra_blueprint_revisions" "example" {
+  blueprint_id = "a52f3e4b-5c0c-4e23-9c19-cb7a4c5ab1a6"
+}
+
+output "revisions" {
+  value = {
+    for r in data.apstra_blueprint_revisions.example.revisions :
+    r.revision_id => "${r.created_at} ${r.user}: ${r.description}"
+  }
+}
+
+# The output looks like this:
+# revisions = {
+#   "12" = "2026-10-16T14:02:11.418735Z admin: Terraform 1.9.5, Apstra provider 0.0.0, User jdoe."
+#   "11" = "2026-10-15T09:47:33.175102Z admin: Terraform 1.9.5, Apstra provider 0.0.0, User jdoe."
+# }
@@ -0,0 +1,16 @@
+# This example looks up a Device Profile by name and outputs the names of the
+# interfaces created by the default transformation of each port.
+
+data "apstra_device_profile" "example" {
+  name = "Juniper QFX5120-48Y"
+}
+
+output "default_interface_names" {
+  value = flatten([
+    for port in data.apstra_device_profile.example.ports : [
+      for t in port.transformations : [
+        for i in t.interfaces : i.name
+      ] if t.is_default
+    ]
+  ])
+}
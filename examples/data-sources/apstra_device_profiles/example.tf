@@ -0,0 +1,16 @@
+# This example collects the IDs of all Juniper Device Profiles which run Junos,
+# and then looks up the details of each one.
+
+data "apstra_device_profiles" "junos" {
+  manufacturer = "Juniper"
+  os           = "Junos"
+}
+
+data "apstra_device_profile" "junos" {
+  for_each = data.apstra_device_profiles.junos.ids
+  id       = each.key
+}
+
+output "junos_device_profile_models" {
+  value = { for k, v in data.apstra_device_profile.junos : k => v.selector.model }
+}
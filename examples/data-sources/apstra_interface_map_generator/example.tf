@@ -0,0 +1,33 @@
+# This example generates the interface mappings between the predefined
+# "AOS-7x10-Leaf" Logical Device and "Juniper_vQFX" Device Profile, and then
+# uses them to create an Interface Map. Interface xe-0/0/0 is reserved for
+# other purposes, so it is excluded from the mapping.
+
+data "apstra_interface_map_generator" "example" {
+  logical_device_id  = "AOS-7x10-Leaf"
+  device_profile_id  = "Juniper_vQFX"
+  exclude_interfaces = ["xe-0/0/0"]
+}
+
+resource "apstra_interface_map" "example" {
+  name              = "vQFX 7x10 leaf (generated)"
+  logical_device_id = data.apstra_interface_map_generator.example.logical_device_id
+  device_profile_id = data.apstra_interface_map_generator.example.device_profile_id
+  interfaces        = data.apstra_interface_map_generator.example.interfaces
+}
+
+# The generated mappings look like this:
+#
+#   interfaces = [
+#     {
+#       logical_device_port     = "1/1"
+#       physical_interface_name = "xe-0/0/1"
+#       transformation_id       = 1
+#     },
+#     {
+#       logical_device_port     = "1/2"
+#       physical_interface_name = "xe-0/0/2"
+#       transformation_id       = 1
+#     },
+#     ...
+#   ]
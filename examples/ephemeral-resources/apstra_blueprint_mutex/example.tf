@@ -0,0 +1,20 @@
+# This example holds the Blueprint mutex for the duration of each plan and
+# apply run which references it. The mutex is unlocked when Terraform closes
+# the ephemeral resource at the end of the run.
+provider "apstra" {
+  blueprint_mutex_enabled = true
+  blueprint_mutex_ttl     = 3600
+}
+
+ephemeral "apstra_blueprint_mutex" "example" {
+  blueprint_id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d"
+}
+
+resource "null_resource" "example" {
+  provisioner "local-exec" {
+    command = "echo blueprint locked until $EXPIRES"
+    environment = {
+      EXPIRES = ephemeral.apstra_blueprint_mutex.example.expires_at
+    }
+  }
+}
@@ -0,0 +1,21 @@
+# Check that each configured gateway address belongs to its subnet, excluding
+# the network ("all zeros") and broadcast ("all ones") addresses.
+locals {
+  networks = {
+    blue = { subnet = "192.0.2.0/24", gateway = "192.0.2.1" }
+    red  = { subnet = "198.51.100.0/24", gateway = "198.51.100.255" }
+  }
+}
+
+output "gateway_ok" {
+  value = {
+    for k, v in local.networks :
+    k => provider::apstra::cidr_within(v.gateway, v.subnet, false, false)
+  }
+}
+
+# The output looks like this:
+# gateway_ok = {
+#   "blue" = true
+#   "red" = false
+# }
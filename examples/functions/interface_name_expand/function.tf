@@ -0,0 +1,21 @@
+# Expand Junos and EOS style interface ranges into individual interface names.
+output "junos_interfaces" {
+  value = provider::apstra::interface_name_expand("ge-0/0/[0-3]")
+}
+
+output "eos_interfaces" {
+  value = provider::apstra::interface_name_expand("Ethernet1/1-2,5")
+}
+
+# The output looks like this:
+# eos_interfaces = tolist([
+#   "Ethernet1/1",
+#   "Ethernet1/2",
+#   "Ethernet1/5",
+# ])
+# junos_interfaces = tolist([
+#   "ge-0/0/0",
+#   "ge-0/0/1",
+#   "ge-0/0/2",
+#   "ge-0/0/3",
+# ])
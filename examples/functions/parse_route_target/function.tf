@@ -0,0 +1,11 @@
+# Break a Route Target into its component parts.
+output "rt" {
+  value = provider::apstra::parse_route_target("65536:100")
+}
+
+# The output looks like this:
+# rt = {
+#   "administrator" = "65536"
+#   "assigned_number" = 100
+#   "type" = "four_octet_as"
+# }
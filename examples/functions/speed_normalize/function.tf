@@ -0,0 +1,11 @@
+# Convert loosely formatted link speeds into the form Apstra expects.
+output "speeds" {
+  value = [for s in ["10g", "25 Gbps", "1000M"] : provider::apstra::speed_normalize(s)]
+}
+
+# The output looks like this:
+# speeds = [
+#   "10G",
+#   "25G",
+#   "1G",
+# ]
@@ -0,0 +1,13 @@
+# Expand a compact VLAN range expression into individual VLAN IDs, e.g. for
+# use with `for_each`.
+output "vlans" {
+  value = provider::apstra::vlan_range("10-12,20")
+}
+
+# The output looks like this:
+# vlans = tolist([
+#   10,
+#   11,
+#   12,
+#   20,
+# ])
@@ -3,4 +3,5 @@ provider "apstra" {
   tls_validation_disabled = true                         # optional
   blueprint_mutex_enabled = false                        # optional
   api_timeout             = 0                            # optional; 0 == infinite
+  api_retry_max           = 5                            # optional; 0 == no retries
 }
@@ -0,0 +1,24 @@
+# This example configures Apstra to authenticate users against a pair of LDAP
+# servers. The bind password is supplied by an ephemeral variable, so it never
+# appears in the Terraform plan or state.
+#
+# To rotate the bind password, update the variable and increment
+# `bind_password_wo_version`.
+
+variable "ldap_bind_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "apstra_aaa_ldap_provider" "corp" {
+  name                     = "corp-ldap"
+  hosts                    = ["ldap1.example.com", "ldap2.example.com"]
+  port                     = 636
+  use_tls                  = true
+  bind_dn                  = "cn=apstra,ou=services,dc=example,dc=com"
+  bind_password_wo         = var.ldap_bind_password
+  bind_password_wo_version = 1
+  user_search_base         = "ou=people,dc=example,dc=com"
+  group_search_base        = "ou=groups,dc=example,dc=com"
+}
@@ -0,0 +1,19 @@
+# Importing a apstra_aaa_ldap_provider requires the provider ID:
+#
+#   <id>
+
+# Legacy import:
+
+echo 'resource "apstra_aaa_ldap_provider" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_aaa_ldap_provider.legacy_import' '3zxDY0C8M0Y2m-xQFJQ'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_aaa_ldap_provider.imported
+  id = "3zxDY0C8M0Y2m-xQFJQ"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
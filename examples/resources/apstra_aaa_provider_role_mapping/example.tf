@@ -0,0 +1,16 @@
+# This example grants Apstra Roles to members of LDAP groups. Members of the
+# `netops` group receive both a predefined Role and a Role created by
+# Terraform.
+
+resource "apstra_role" "netops" {
+  name        = "netops"
+  permissions = ["blueprint.view", "device.view"]
+}
+
+resource "apstra_aaa_provider_role_mapping" "corp" {
+  provider_id = apstra_aaa_ldap_provider.corp.id
+  mappings = {
+    "apstra-admins" = ["administrator"]
+    "netops"        = ["device_operator", apstra_role.netops.id]
+  }
+}
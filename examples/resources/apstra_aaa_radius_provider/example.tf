@@ -0,0 +1,19 @@
+# This example configures Apstra to authenticate users against a RADIUS
+# server. The shared secret is supplied by an ephemeral variable, so it never
+# appears in the Terraform plan or state.
+#
+# To rotate the shared secret, update the variable and increment
+# `shared_secret_wo_version`.
+
+variable "radius_shared_secret" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "apstra_aaa_radius_provider" "corp" {
+  name                     = "corp-radius"
+  hosts                    = ["192.0.2.10"]
+  shared_secret_wo         = var.radius_shared_secret
+  shared_secret_wo_version = 1
+}
@@ -0,0 +1,21 @@
+# This example configures Apstra to authenticate users against a pair of
+# TACACS+ servers. The shared secret is supplied by an ephemeral variable, so
+# it never appears in the Terraform plan or state.
+#
+# To rotate the shared secret, update the variable and increment
+# `shared_secret_wo_version`.
+
+variable "tacacs_shared_secret" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "apstra_aaa_tacacs_provider" "corp" {
+  name                     = "corp-tacacs"
+  hosts                    = ["192.0.2.20", "192.0.2.21"]
+  auth_mode                = "chap"
+  timeout_seconds          = 10
+  shared_secret_wo         = var.tacacs_shared_secret
+  shared_secret_wo_version = 1
+}
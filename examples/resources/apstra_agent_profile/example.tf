@@ -1,8 +1,20 @@
 # This example creates an Agent Profile with a couple of options.
-# Note that it is not possible to set device credentials with this
-# resource because (a) the credentials cannot be retrieved, so
-# terraform cannot manage the full lifecycle and (b) keeping
-# secrets in the state file probably isn't great.
+# Device credentials are supplied through the write-only `username_wo`
+# and `password_wo` attributes, so they never appear in the plan or
+# state file. Because Apstra will not reveal the credentials, terraform
+# cannot detect changes to them: increment `credentials_wo_version` to
+# send new values to Apstra.
+variable "switch_username" {
+  type      = string
+  ephemeral = true
+}
+
+variable "switch_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
 resource "apstra_agent_profile" "profile_with_options" {
   name = "spine switches"
   platform = "eos"
@@ -10,4 +22,7 @@ resource "apstra_agent_profile" "profile_with_options" {
     foo = "bar"
     baz = "not bar"
   }
-}
\ No newline at end of file
+  username_wo            = var.switch_username
+  password_wo            = var.switch_password
+  credentials_wo_version = 1
+}
@@ -73,4 +73,37 @@ resource "apstra_blueprint_deployment" "deploy" {
   # may be replaced with this syntax. USER is replaced using values from the
   # environment. Any environment variable may be specified this way.
   comment      = "Deployment by Terraform {{.TerraformVersion}}, Apstra provider {{.ProviderVersion}}, User $USER."
+
+  # Optional deployment policies: Refuse to deploy a blueprint with build
+  # warnings or with BGP or cabling anomalies, and wait up to 10 minutes for
+  # the configuration to reach every system. BGP and cabling anomalies are
+  # checked again once the configuration has been deployed.
+  fail_on_build_warnings    = true
+  blocking_anomaly_types    = ["bgp", "cabling"]
+  wait_for_deployed_seconds = 600
+
+  # BGP anomalies raised against a leaf switch which is undergoing maintenance
+  # do not block deployment until the end of the maintenance window.
+  anomaly_suppressions = [
+    {
+      action       = "acknowledge"
+      anomaly_type = "bgp"
+      identity     = { system_id = "525400ABCDEF" }
+      expires_at   = "2030-01-01T06:00:00Z"
+      comment      = "leaf 3 maintenance, CHG-1234"
+    },
+  ]
+}
+
+# When the blueprint is staged by a separate Terraform run (or by hand), the
+# deployment plan previews the changes which will be pushed to devices.
+# Reviewers of `terraform plan` see the incremental configuration for each
+# affected system along with a summary of the staged graph changes.
+resource "apstra_blueprint_deployment" "staged_elsewhere" {
+  blueprint_id   = "a52f3e4b-5c0c-4e23-9c19-cb7a4c5ab1a6"
+  preview_config = true
+}
+
+output "systems_touched_by_deployment" {
+  value = keys(apstra_blueprint_deployment.staged_elsewhere.pending_config)
 }
@@ -1,45 +1,51 @@
-# The following example creates a Dashboard with Widgets in a blueprint.
-# The dashboards references widgets by Id.
-# These are pulled by using blueprint and widget data sources.
+# The following example instantiates a predefined probe, creates widgets
+# which display two of the probe's stages, and arranges those widgets in a
+# dashboard which is shown on the Blueprint's Analytics landing page.
 
 data "apstra_datacenter_blueprint" "b" {
-  id = "evpn-vqfx_offbox-virtual"
+  name = "test"
 }
 
-data "apstra_blueprint_iba_widget" "i" {
-  blueprint_id = data.apstra_datacenter_blueprint.b.id
-  name = "Device Health Summary"
-}
-
-data "apstra_blueprint_iba_widget" "j" {
-  blueprint_id = data.apstra_datacenter_blueprint.b.id
-  name = "Fabric ECMP Imbalance"
+resource "apstra_blueprint_iba_probe" "p_device_health" {
+  blueprint_id        = data.apstra_datacenter_blueprint.b.id
+  predefined_probe_id = "device_health"
+  probe_config = jsonencode(
+    {
+      "max_cpu_utilization" : 80,
+      "max_memory_utilization" : 80,
+      "max_disk_utilization" : 80,
+      "duration" : 660,
+      "threshold_duration" : 360,
+      "history_duration" : 604800
+    }
+  )
 }
 
-data "apstra_blueprint_iba_widget" "k" {
-  blueprint_id = data.apstra_datacenter_blueprint.b.id
-  name = "LAG Imbalance"
+# Each stage named here must appear in apstra_blueprint_iba_probe.p_device_health.stages
+locals {
+  device_health_stages = toset([
+    "Systems with high CPU utilization",
+    "Systems with high memory utilization",
+  ])
 }
 
-data "apstra_blueprint_iba_widget" "l" {
+resource "apstra_blueprint_iba_widget" "device_health" {
+  for_each     = local.device_health_stages
   blueprint_id = data.apstra_datacenter_blueprint.b.id
-  name = "Systems with high disk utilization"
+  name         = each.key
+  probe_id     = apstra_blueprint_iba_probe.p_device_health.id
+  stage        = each.key
 }
 
-resource "apstra_blueprint_iba_dashboard" "b" {
+resource "apstra_blueprint_iba_dashboard" "device_health" {
   blueprint_id = data.apstra_datacenter_blueprint.b.id
-  default = false
-  description = "Test Dashboard"
-  name = "Test"
-  widget_grid = tolist([
-    tolist([
-      data.apstra_blueprint_iba_widget.i.id, data.apstra_blueprint_iba_widget.j.id
-    ]),
-    tolist([
-      data.apstra_blueprint_iba_widget.k.id
-    ]),
-    tolist([
-      data.apstra_blueprint_iba_widget.l.id
-    ]),
-  ])
+  name         = "Device Health"
+  description  = "CPU and memory utilization"
+  default      = true
+  widget_grid = [
+    # first column
+    [apstra_blueprint_iba_widget.device_health["Systems with high CPU utilization"].id],
+    # second column
+    [apstra_blueprint_iba_widget.device_health["Systems with high memory utilization"].id],
+  ]
 }
@@ -59,3 +59,73 @@ output "o2"{
 #])
 #}
 
+# The following example builds a custom probe from typed processors. Data
+# is collected from a custom telemetry service, the readings are summed
+# per system, and systems whose total exceeds 10 raise anomalies. Stage
+# references and the graph query are validated at plan time.
+
+resource "apstra_telemetry_service_registry_entry" "dot1x" {
+  name                = "dot1x_sessions"
+  description         = "802.1X session state"
+  storage_schema_path = "aos.sdk.telemetry.schemas.iba_integer_data"
+  application_schema = jsonencode({
+    type     = "object"
+    required = ["key", "value"]
+    properties = {
+      key = {
+        type     = "object"
+        required = ["supplicant_mac", "port_status"]
+        properties = {
+          supplicant_mac = { type = "string" }
+          port_status    = { type = "string", enum = ["authorized", "blocked"] }
+        }
+      }
+      value = { type = "integer" }
+    }
+  })
+}
+
+resource "apstra_blueprint_iba_probe" "p_dot1x" {
+  blueprint_id = data.apstra_datacenter_blueprint.b.id
+  name         = "Blocked 802.1X Sessions"
+  description  = "Raises anomalies for leaf switches with many blocked 802.1X sessions"
+  processors = [
+    {
+      name         = "collect sessions"
+      type         = "extensible_data_collector"
+      service_name = apstra_telemetry_service_registry_entry.dot1x.name
+      graph_query  = "node('system', name='system', role='leaf', system_id=not_none())"
+      outputs      = { out = "sessions" }
+      properties = jsonencode({
+        system_id = "system.system_id"
+        data_type = "number"
+        query_tag_filter = {
+          filter    = {}
+          operation = "and"
+        }
+      })
+    },
+    {
+      name     = "sessions per system"
+      type     = "sum"
+      inputs   = { in = { stage = "sessions" } }
+      outputs  = { out = "sessions_per_system" }
+      group_by = ["system_id"]
+    },
+    {
+      name          = "too many sessions"
+      type          = "range_check"
+      inputs        = { in = { stage = "sessions_per_system" } }
+      outputs       = { out = "too_many_sessions" }
+      range_max     = 10
+      raise_anomaly = true
+    },
+  ]
+  stage_settings = {
+    sessions_per_system = {
+      description           = "802.1X sessions per system"
+      retention_duration    = 86400
+      enable_metric_logging = true
+    }
+  }
+}
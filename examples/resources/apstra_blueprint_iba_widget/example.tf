@@ -23,7 +23,7 @@ resource "apstra_blueprint_iba_widget" "w_device_health_high_cpu" {
   blueprint_id = data.apstra_datacenter_blueprint.b.id
   name = "Devices with high CPU Utilization"
   probe_id = apstra_blueprint_iba_probe.p_device_health.id
-  stage = "Systems with high CPU utilization"
+  stage = "Systems with high CPU utilization" # one of apstra_blueprint_iba_probe.p_device_health.stages
   description = "made from terraform"
 }
 
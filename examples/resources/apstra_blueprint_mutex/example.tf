@@ -0,0 +1,26 @@
+# This example locks a Blueprint before making any changes, and relies on the
+# deployment to unlock it. Because the mutex is unlocked by the deployment, the
+# apstra_blueprint_mutex resource is re-created (locking the Blueprint again)
+# at the start of each subsequent apply.
+provider "apstra" {
+  blueprint_mutex_enabled = true
+  blueprint_mutex_timeout = 900  # give up after waiting 15 minutes
+  blueprint_mutex_ttl     = 3600 # others may break our lock after an hour
+}
+
+resource "apstra_blueprint_mutex" "example" {
+  blueprint_id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d"
+  message      = "nightly pipeline run $BUILD_ID"
+}
+
+resource "apstra_datacenter_virtual_network" "example" {
+  blueprint_id    = apstra_blueprint_mutex.example.blueprint_id
+  name            = "example"
+  type            = "vxlan"
+  routing_zone_id = "Zd2xFqLTrSUEx-sqaWA"
+}
+
+resource "apstra_blueprint_deployment" "example" {
+  blueprint_id = apstra_blueprint_mutex.example.blueprint_id
+  depends_on   = [apstra_datacenter_virtual_network.example]
+}
@@ -0,0 +1,15 @@
+# This example grants the "netops" Role read-only access to every Blueprint,
+# and read-write access to the Blueprint named "dc1".
+
+data "apstra_blueprints" "all" {}
+
+data "apstra_datacenter_blueprint" "dc1" {
+  name = "dc1"
+}
+
+resource "apstra_blueprint_permission" "netops" {
+  for_each     = data.apstra_blueprints.all.ids
+  blueprint_id = each.key
+  role_id      = "netops"
+  permission   = each.key == data.apstra_datacenter_blueprint.dc1.id ? "read_write" : "read_only"
+}
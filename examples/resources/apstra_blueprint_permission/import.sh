@@ -0,0 +1,20 @@
+# Importing a apstra_blueprint_permission requires the blueprint ID and the
+# role ID, separated by a colon:
+#
+#   <blueprint_id>:<role_id>
+
+# Legacy import:
+
+echo 'resource "apstra_blueprint_permission" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_blueprint_permission.legacy_import' '007723b7-a387-4bb3-8a5e-b5e9f265de0d:netops'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_blueprint_permission.imported
+  id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d:netops"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
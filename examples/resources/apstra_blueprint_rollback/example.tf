@@ -0,0 +1,15 @@
+# This example rolls a blueprint back to the revision which preceded the
+# most recent deployment and deploys the result. It is intended for use in
+# an incident runbook: the rollback is codified and reviewable in a pull
+# request rather than being performed by hand in the web UI.
+
+data "apstra_blueprint_revisions" "example" {
+  blueprint_id = "a52f3e4b-5c0c-4e23-9c19-cb7a4c5ab1a6"
+}
+
+resource "apstra_blueprint_rollback" "example" {
+  blueprint_id = data.apstra_blueprint_revisions.example.blueprint_id
+  revision_id  = data.apstra_blueprint_revisions.example.revisions[1].revision_id
+  deploy       = true
+  comment      = "Rollback for incident INC-1234 by $USER via Terraform {{.TerraformVersion}}"
+}
@@ -0,0 +1,20 @@
+# Importing a apstra_datacenter_connectivity_template_svi requires the
+# blueprint ID and the connectivity template ID, separated by a colon:
+#
+#   <blueprint_id>:<id>
+
+# Legacy import:
+
+echo 'resource "apstra_datacenter_connectivity_template_svi" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_datacenter_connectivity_template_svi.legacy_import' '007723b7-a387-4bb3-8a5e-b5e9f265de0d:3zxDY0C8M0Y2m-xQFJQ'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_datacenter_connectivity_template_svi.imported
+  id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d:3zxDY0C8M0Y2m-xQFJQ"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
@@ -0,0 +1,20 @@
+# Importing a apstra_datacenter_device_allocation requires the blueprint ID
+# and the node name, separated by a colon:
+#
+#   <blueprint_id>:<node_name>
+
+# Legacy import:
+
+echo 'resource "apstra_datacenter_device_allocation" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_datacenter_device_allocation.legacy_import' '007723b7-a387-4bb3-8a5e-b5e9f265de0d:spine1'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_datacenter_device_allocation.imported
+  id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d:spine1"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
@@ -0,0 +1,25 @@
+# Importing a apstra_datacenter_resource_pool_allocation requires the
+# blueprint ID and the role, separated by a colon:
+#
+#   <blueprint_id>:<role>
+#
+# Allocations scoped to a routing zone (e.g. `virtual_network_svi_subnets`)
+# require the routing zone ID as a third element:
+#
+#   <blueprint_id>:<role>:<routing_zone_id>
+
+# Legacy import:
+
+echo 'resource "apstra_datacenter_resource_pool_allocation" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_datacenter_resource_pool_allocation.legacy_import' '007723b7-a387-4bb3-8a5e-b5e9f265de0d:spine_asns'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_datacenter_resource_pool_allocation.imported
+  id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d:spine_asns"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
@@ -0,0 +1,24 @@
+# Importing a apstra_datacenter_tag requires the blueprint ID and the tag ID,
+# separated by a colon:
+#
+#   <blueprint_id>:<id>
+#
+# Tags may also be imported by name using a JSON document:
+#
+#   {"blueprint_id":"007723b7-a387-4bb3-8a5e-b5e9f265de0d","name":"my_tag"}
+
+# Legacy import:
+
+echo 'resource "apstra_datacenter_tag" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_datacenter_tag.legacy_import' '007723b7-a387-4bb3-8a5e-b5e9f265de0d:3zxDY0C8M0Y2m-xQFJQ'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_datacenter_tag.imported
+  id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d:3zxDY0C8M0Y2m-xQFJQ"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
@@ -0,0 +1,26 @@
+# This example onboards a switch and then upgrades it to the OS image
+# registered by an `apstra_os_image` resource. The upgrade is repeated
+# whenever `image_id` changes, so moving the switch to a new release is a
+# matter of registering a new image and referencing it here.
+
+resource "apstra_managed_device" "leaf_1" {
+  agent_profile_id = "WZ6SkQSTXOImnEV9GdQ"
+  management_ip    = "192.0.2.101"
+  device_key       = "XN3124150062"
+}
+
+resource "apstra_os_image" "junos_23_4" {
+  platform  = "junos"
+  image_url = "https://images.example.com/junos/jinstall-host-qfx-5e-x86-64-23.4R2.13-secure-signed.tgz"
+  checksum  = "0c3e7d8d6b1f0ad4c4e3fcf7f7b6f3a1"
+}
+
+resource "apstra_device_os_upgrade" "leaf_1" {
+  system_id       = apstra_managed_device.leaf_1.system_id
+  image_id        = apstra_os_image.junos_23_4.id
+  timeout_seconds = 2700
+}
+
+output "leaf_1_os_version" {
+  value = apstra_device_os_upgrade.leaf_1.os_version
+}
@@ -0,0 +1,109 @@
+# This example creates a Device Profile for a (hypothetical) custom 4-port
+# switch. Ports 1-2 are 10G SFP+ ports. Ports 3-4 are 100G QSFP28 ports which
+# can alternatively be broken out to 4x25G. A Logical Device and an Interface
+# Map tie the new Device Profile into the design.
+
+locals {
+  sfp_ports  = [1, 2]
+  qsfp_ports = [3, 4]
+  port_names = { for p in concat(local.sfp_ports, local.qsfp_ports) : p => format("%s-0/0/%d", p < 3 ? "xe" : "et", p - 1) }
+}
+
+resource "apstra_device_profile" "example" {
+  name = "custom 4-port switch"
+  selector = {
+    manufacturer = "Juniper"
+    model        = "EXAMPLE-4"
+    os           = "Junos"
+    os_version   = ".*"
+  }
+  hardware_capabilities = {
+    cpu         = "x86"
+    ram         = 16
+    form_factor = "1RU"
+    userland    = 64
+    ecmp_limit  = 64
+  }
+  ports = concat(
+    [
+      for p in local.sfp_ports : {
+        port_id        = p
+        panel_id       = 1
+        row_id         = 1
+        column_id      = p
+        connector_type = "sfp"
+        transformations = [
+          {
+            transformation_id = 1
+            is_default        = true
+            interfaces = [
+              { interface_id = 1, name = local.port_names[p], speed = "10G" },
+            ]
+          },
+        ]
+      }
+    ],
+    [
+      for p in local.qsfp_ports : {
+        port_id        = p
+        panel_id       = 1
+        row_id         = 1
+        column_id      = p
+        connector_type = "qsfp28"
+        transformations = [
+          {
+            transformation_id = 1
+            is_default        = true
+            interfaces = [
+              { interface_id = 1, name = local.port_names[p], speed = "100G" },
+            ]
+          },
+          {
+            transformation_id = 2
+            interfaces = [
+              for i in range(4) : {
+                interface_id = i + 1
+                name         = format("%s:%d", local.port_names[p], i)
+                speed        = "25G"
+              }
+            ]
+          },
+        ]
+      }
+    ],
+  )
+}
+
+resource "apstra_logical_device" "example" {
+  name = "2x10G + 2x100G"
+  panels = [
+    {
+      rows    = 1
+      columns = 4
+      port_groups = [
+        {
+          port_count = 2
+          port_speed = "10G"
+          port_roles = ["leaf", "access", "generic"]
+        },
+        {
+          port_count = 2
+          port_speed = "100G"
+          port_roles = ["spine", "peer"]
+        },
+      ]
+    }
+  ]
+}
+
+resource "apstra_interface_map" "example" {
+  name              = "custom 4-port switch"
+  logical_device_id = apstra_logical_device.example.id
+  device_profile_id = apstra_device_profile.example.id
+  interfaces = [
+    for p in concat(local.sfp_ports, local.qsfp_ports) : {
+      logical_device_port     = format("1/%d", p)
+      physical_interface_name = local.port_names[p]
+    }
+  ]
+}
@@ -0,0 +1,19 @@
+# Importing a apstra_freeform_blueprint requires the blueprint ID:
+#
+#   <id>
+
+# Legacy import:
+
+echo 'resource "apstra_freeform_blueprint" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_freeform_blueprint.legacy_import' '007723b7-a387-4bb3-8a5e-b5e9f265de0d'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_freeform_blueprint.imported
+  id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
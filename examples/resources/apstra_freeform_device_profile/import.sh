@@ -0,0 +1,21 @@
+# Importing a apstra_freeform_device_profile requires the blueprint ID, the
+# device profile ID and the global catalog device profile ID, separated by
+# colons:
+#
+#   <blueprint_id>:<id>:<device_profile_id>
+
+# Legacy import:
+
+echo 'resource "apstra_freeform_device_profile" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_freeform_device_profile.legacy_import' '007723b7-a387-4bb3-8a5e-b5e9f265de0d:3zxDY0C8M0Y2m-xQFJQ:Juniper_vEX'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_freeform_device_profile.imported
+  id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d:3zxDY0C8M0Y2m-xQFJQ:Juniper_vEX"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
@@ -36,4 +36,44 @@ resource "apstra_managed_device" "example" {
   device_key = "52540057C718"
   management_ip = "172.20.84.15"
   off_box = true
-}
\ No newline at end of file
+}
+
+# Credentials may also be supplied per device. They override the
+# credentials of the Agent Profile, and are never saved in the plan or
+# state. Increment `credentials_wo_version` to rotate them.
+variable "lab_switch_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "apstra_managed_device" "with_credentials" {
+  agent_profile_id       = local.agent_profiles_id_with_credentials_and_qfx_in_name[0]
+  management_ip          = "172.20.84.16"
+  off_box                = true
+  username_wo            = "lab-admin"
+  password_wo            = var.lab_switch_password
+  credentials_wo_version = 1
+}
+
+# When the serial number isn't known in advance, set `acknowledge` to
+# accept whichever System the Agent discovers. Terraform waits up to
+# `timeout_seconds` for the Agent to be installed and for the device to
+# connect before reporting its status.
+resource "apstra_managed_device" "acknowledged" {
+  agent_profile_id = local.agent_profiles_id_with_credentials_and_qfx_in_name[0]
+  management_ip    = "172.20.84.17"
+  off_box          = true
+  acknowledge      = true
+  timeout_seconds  = 900
+}
+
+output "acknowledged_device_status" {
+  value = {
+    system_id      = apstra_managed_device.acknowledged.system_id
+    os_version     = apstra_managed_device.acknowledged.os_version
+    platform       = apstra_managed_device.acknowledged.platform
+    agent_state    = apstra_managed_device.acknowledged.agent_state
+    last_heartbeat = apstra_managed_device.acknowledged.last_heartbeat
+  }
+}
@@ -0,0 +1,9 @@
+# This example registers a Junos image hosted on an internal web server. The
+# image can then be installed with the `apstra_device_os_upgrade` resource.
+
+resource "apstra_os_image" "junos_23_4" {
+  platform    = "junos"
+  description = "Junos 23.4R2 for QFX5120"
+  image_url   = "https://images.example.com/junos/jinstall-host-qfx-5e-x86-64-23.4R2.13-secure-signed.tgz"
+  checksum    = "0c3e7d8d6b1f0ad4c4e3fcf7f7b6f3a1"
+}
@@ -0,0 +1,6 @@
+# This example configures the DNS resolvers used by the Apstra server itself.
+
+resource "apstra_platform_dns" "example" {
+  nameservers    = ["192.0.2.53", "198.51.100.53"]
+  search_domains = ["example.com"]
+}
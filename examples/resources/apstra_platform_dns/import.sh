@@ -0,0 +1,19 @@
+# Importing a apstra_platform_dns requires the fixed ID of the setting:
+#
+#   dns
+
+# Legacy import:
+
+echo 'resource "apstra_platform_dns" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_platform_dns.legacy_import' 'dns'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_platform_dns.imported
+  id = "dns"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
@@ -0,0 +1,13 @@
+# This example enables one feature flag and disables another. Feature flags
+# not named here are not changed.
+#
+# The flag names below are illustrative. The names of the flags supported by
+# an Apstra server can be found by importing this resource with the ID
+# `feature_flags`.
+
+resource "apstra_platform_feature_flags" "example" {
+  flags = {
+    "enable_ipv6_routing" = true
+    "intent_based_trust"  = false
+  }
+}
@@ -0,0 +1,19 @@
+# Importing a apstra_platform_feature_flags requires the fixed ID of the setting:
+#
+#   feature_flags
+
+# Legacy import:
+
+echo 'resource "apstra_platform_feature_flags" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_platform_feature_flags.legacy_import' 'feature_flags'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_platform_feature_flags.imported
+  id = "feature_flags"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
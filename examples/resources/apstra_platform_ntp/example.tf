@@ -0,0 +1,8 @@
+# This example configures the NTP servers used by the Apstra server itself.
+
+resource "apstra_platform_ntp" "example" {
+  servers = [
+    "0.pool.ntp.org",
+    "1.pool.ntp.org",
+  ]
+}
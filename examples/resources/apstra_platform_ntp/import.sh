@@ -0,0 +1,19 @@
+# Importing a apstra_platform_ntp requires the fixed ID of the setting:
+#
+#   ntp
+
+# Legacy import:
+
+echo 'resource "apstra_platform_ntp" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_platform_ntp.legacy_import' 'ntp'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_platform_ntp.imported
+  id = "ntp"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
@@ -0,0 +1,7 @@
+# This example shortens the API and web UI session timeouts of the Apstra
+# server.
+
+resource "apstra_platform_session_timeouts" "example" {
+  api_timeout_minutes = 60
+  ui_timeout_minutes  = 15
+}
@@ -0,0 +1,19 @@
+# Importing a apstra_platform_session_timeouts requires the fixed ID of the setting:
+#
+#   session_timeouts
+
+# Legacy import:
+
+echo 'resource "apstra_platform_session_timeouts" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_platform_session_timeouts.legacy_import' 'session_timeouts'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_platform_session_timeouts.imported
+  id = "session_timeouts"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
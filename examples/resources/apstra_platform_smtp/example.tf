@@ -0,0 +1,21 @@
+# This example configures the mail server used by Apstra to send alert
+# notifications. The password is supplied by an ephemeral variable, so it
+# never appears in the Terraform plan or state.
+#
+# To rotate the password, update the variable and increment
+# `password_wo_version`.
+
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "apstra_platform_smtp" "example" {
+  host                = "smtp.example.com"
+  username            = "apstra"
+  password_wo         = var.smtp_password
+  password_wo_version = 1
+  sender              = "apstra@example.com"
+  recipients          = ["netops@example.com"]
+}
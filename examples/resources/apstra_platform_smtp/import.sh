@@ -0,0 +1,19 @@
+# Importing a apstra_platform_smtp requires the fixed ID of the setting:
+#
+#   smtp
+
+# Legacy import:
+
+echo 'resource "apstra_platform_smtp" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_platform_smtp.legacy_import' 'smtp'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_platform_smtp.imported
+  id = "smtp"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
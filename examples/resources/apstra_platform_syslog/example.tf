@@ -0,0 +1,19 @@
+# This example forwards the Apstra server's own logs to a pair of syslog
+# servers. Because the configuration is read back from Apstra on every
+# refresh, changes made outside of Terraform are reported as drift.
+
+resource "apstra_platform_syslog" "example" {
+  facility = "local3"
+  receivers = [
+    {
+      address  = "192.0.2.50"
+      port     = 514
+      protocol = "udp"
+    },
+    {
+      address  = "syslog.example.com"
+      port     = 6514
+      protocol = "tcp"
+    },
+  ]
+}
@@ -0,0 +1,19 @@
+# Importing a apstra_platform_syslog requires the fixed ID of the setting:
+#
+#   syslog
+
+# Legacy import:
+
+echo 'resource "apstra_platform_syslog" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_platform_syslog.legacy_import' 'syslog'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_platform_syslog.imported
+  id = "syslog"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
@@ -0,0 +1,17 @@
+# This example creates a Role for network engineers who may view Blueprints
+# and devices, and then grants that Role write access to a single Blueprint.
+
+resource "apstra_role" "netops" {
+  name        = "netops"
+  description = "network engineers"
+  permissions = [
+    "blueprint.view",
+    "device.view",
+  ]
+}
+
+resource "apstra_blueprint_permission" "netops_dc1" {
+  blueprint_id = "007723b7-a387-4bb3-8a5e-b5e9f265de0d"
+  role_id      = apstra_role.netops.id
+  permission   = "read_write"
+}
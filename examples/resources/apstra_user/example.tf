@@ -0,0 +1,19 @@
+# This example creates a local Apstra User with a password generated by the
+# ephemeral `random_password` resource. Neither the password nor the
+# ephemeral resource appear in the Terraform plan or state.
+#
+# To rotate the password, increment `password_wo_version`.
+
+ephemeral "random_password" "jdoe" {
+  length = 20
+}
+
+resource "apstra_user" "jdoe" {
+  username            = "jdoe"
+  first_name          = "Jane"
+  last_name           = "Doe"
+  email               = "jdoe@example.com"
+  role_ids            = ["device_operator"]
+  password_wo         = ephemeral.random_password.jdoe.result
+  password_wo_version = 1
+}
@@ -0,0 +1,19 @@
+# Importing a apstra_user requires the User ID:
+#
+#   <id>
+
+# Legacy import:
+
+echo 'resource "apstra_user" "legacy_import" {}' >> legacy_import.tf
+terraform import 'apstra_user.legacy_import' '3zxDY0C8M0Y2m-xQFJQ'
+
+# Terraform 1.5+ block import:
+
+cat >> block_import.tf << EOF
+import {
+  to = apstra_user.imported
+  id = "3zxDY0C8M0Y2m-xQFJQ"
+}
+EOF
+terraform plan -generate-config-out=generated.tf
+terraform apply
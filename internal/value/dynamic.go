@@ -0,0 +1,105 @@
+package value
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DynamicFromJson returns a types.Dynamic representing the supplied JSON
+// document. JSON objects become Terraform objects. JSON arrays become lists
+// when every element has the same type, and tuples otherwise, because a
+// Terraform list cannot hold elements of differing types. JSON null becomes a
+// null string.
+func DynamicFromJson(ctx context.Context, in []byte, diags *diag.Diagnostics) types.Dynamic {
+	decoder := json.NewDecoder(bytes.NewReader(in))
+	decoder.UseNumber()
+
+	var v any
+	err := decoder.Decode(&v)
+	if err != nil {
+		diags.AddError("failed to parse JSON", err.Error())
+		return types.DynamicNull()
+	}
+
+	result, err := attrValueFromJson(ctx, v)
+	if err != nil {
+		diags.AddError("failed to convert JSON to a Terraform value", err.Error())
+		return types.DynamicNull()
+	}
+
+	return types.DynamicValue(result)
+}
+
+func attrValueFromJson(ctx context.Context, in any) (attr.Value, error) {
+	switch in := in.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(in), nil
+	case string:
+		return types.StringValue(in), nil
+	case json.Number:
+		f, _, err := big.ParseFloat(in.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse number %q: %w", in, err)
+		}
+		return types.NumberValue(f), nil
+	case []any:
+		elementTypes := make([]attr.Type, len(in))
+		elements := make([]attr.Value, len(in))
+		for i, e := range in {
+			v, err := attrValueFromJson(ctx, e)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			elementTypes[i] = v.Type(ctx)
+			elements[i] = v
+		}
+		if len(elements) > 0 && allTypesEqual(elementTypes) {
+			result, d := types.ListValue(elementTypes[0], elements)
+			if d.HasError() {
+				return nil, fmt.Errorf("cannot create list: %v", d.Errors())
+			}
+			return result, nil
+		}
+		result, d := types.TupleValue(elementTypes, elements)
+		if d.HasError() {
+			return nil, fmt.Errorf("cannot create tuple: %v", d.Errors())
+		}
+		return result, nil
+	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(in))
+		attributes := make(map[string]attr.Value, len(in))
+		for k, e := range in {
+			v, err := attrValueFromJson(ctx, e)
+			if err != nil {
+				return nil, fmt.Errorf("attribute %q: %w", k, err)
+			}
+			attrTypes[k] = v.Type(ctx)
+			attributes[k] = v
+		}
+		result, d := types.ObjectValue(attrTypes, attributes)
+		if d.HasError() {
+			return nil, fmt.Errorf("cannot create object: %v", d.Errors())
+		}
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("unexpected JSON element type %T", in)
+}
+
+func allTypesEqual(in []attr.Type) bool {
+	for _, t := range in[1:] {
+		if !t.Equal(in[0]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+package value
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicFromJson(t *testing.T) {
+	type testCase struct {
+		json     string
+		expected attr.Value
+		expErr   bool
+	}
+
+	testCases := map[string]testCase{
+		"string": {
+			json:     `"foo"`,
+			expected: types.StringValue("foo"),
+		},
+		"number": {
+			json:     `1.5`,
+			expected: types.NumberValue(big.NewFloat(1.5)),
+		},
+		"null": {
+			json:     `null`,
+			expected: types.StringNull(),
+		},
+		"heterogeneous_array": {
+			json: `[true, "a"]`,
+			expected: types.TupleValueMust(
+				[]attr.Type{types.BoolType, types.StringType},
+				[]attr.Value{types.BoolValue(true), types.StringValue("a")},
+			),
+		},
+		"homogeneous_array": {
+			json: `["a", "b"]`,
+			expected: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{types.StringValue("a"), types.StringValue("b")},
+			),
+		},
+		"empty_array": {
+			json:     `[]`,
+			expected: types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+		},
+		"query_result": {
+			json: `[{"n_system": {"id": "abc", "label": "leaf1", "system_id": null}}]`,
+			expected: types.ListValueMust(
+				types.ObjectType{AttrTypes: map[string]attr.Type{
+					"n_system": types.ObjectType{AttrTypes: map[string]attr.Type{
+						"id":        types.StringType,
+						"label":     types.StringType,
+						"system_id": types.StringType,
+					}},
+				}},
+				[]attr.Value{types.ObjectValueMust(
+					map[string]attr.Type{
+						"n_system": types.ObjectType{AttrTypes: map[string]attr.Type{
+							"id":        types.StringType,
+							"label":     types.StringType,
+							"system_id": types.StringType,
+						}},
+					},
+					map[string]attr.Value{
+						"n_system": types.ObjectValueMust(
+							map[string]attr.Type{
+								"id":        types.StringType,
+								"label":     types.StringType,
+								"system_id": types.StringType,
+							},
+							map[string]attr.Value{
+								"id":        types.StringValue("abc"),
+								"label":     types.StringValue("leaf1"),
+								"system_id": types.StringNull(),
+							},
+						),
+					},
+				)},
+			),
+		},
+		"invalid": {
+			json:   `{"foo":`,
+			expErr: true,
+		},
+	}
+
+	for tName, tCase := range testCases {
+		t.Run(tName, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			result := DynamicFromJson(context.Background(), []byte(tCase.json), &diags)
+			if tCase.expErr {
+				require.True(t, diags.HasError())
+				require.True(t, result.IsNull())
+				return
+			}
+
+			require.False(t, diags.HasError(), diags)
+			require.True(t, tCase.expected.Equal(result.UnderlyingValue()), "expected %s, got %s", tCase.expected, result.UnderlyingValue())
+		})
+	}
+}